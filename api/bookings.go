@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/service/booking"
 	"github.com/gin-gonic/gin"
 )
@@ -16,15 +17,44 @@ type createBookingRequest struct {
 	FlightID   int64  `json:"flight_id"`
 	SeatNumber int    `json:"seat_number"`
 	Email      string `json:"email"`
+	Channel    string `json:"channel"`
+	FareClass  string `json:"fare_class"`
+	PromoCode  string `json:"promo_code"`
 }
 
 type bookingResponse struct {
-	Token      string `json:"token"`
-	Status     string `json:"status"`
-	ExpiresAt  string `json:"expires_at"`
-	FlightID   int64  `json:"flight_id"`
-	SeatNumber int    `json:"seat_number"`
-	Email      string `json:"email"`
+	Token      string       `json:"token"`
+	Status     string       `json:"status"`
+	ExpiresAt  string       `json:"expires_at"`
+	FlightID   int64        `json:"flight_id"`
+	SeatNumber int          `json:"seat_number"`
+	Email      string       `json:"email"`
+	Fare       fareResponse `json:"fare"`
+	Currency   string       `json:"currency"`
+}
+
+type fareResponse struct {
+	BaseFareCents      int64              `json:"base_fare_cents"`
+	DiscountCents      int64              `json:"discount_cents"`
+	Taxes              []domain.TaxCharge `json:"taxes"`
+	TaxCents           int64              `json:"tax_cents"`
+	FuelSurchargeCents int64              `json:"fuel_surcharge_cents"`
+	BookingFeeCents    int64              `json:"booking_fee_cents"`
+	SeatFeeCents       int64              `json:"seat_fee_cents"`
+	TotalCents         int64              `json:"total_cents"`
+}
+
+func newFareResponse(f domain.FareBreakdown) fareResponse {
+	return fareResponse{
+		BaseFareCents:      f.BaseFareCents,
+		DiscountCents:      f.DiscountCents,
+		Taxes:              f.Taxes,
+		TaxCents:           f.TaxCents(),
+		FuelSurchargeCents: f.FuelSurchargeCents,
+		BookingFeeCents:    f.BookingFeeCents,
+		SeatFeeCents:       f.SeatFeeCents,
+		TotalCents:         f.TotalCents,
+	}
 }
 
 func NewBookingHandler(service booking.BookingUseCase) *BookingHandler {
@@ -48,9 +78,12 @@ func (h *BookingHandler) create(c *gin.Context) {
 		FlightID:   req.FlightID,
 		SeatNumber: req.SeatNumber,
 		Email:      req.Email,
+		Channel:    req.Channel,
+		FareClass:  req.FareClass,
+		PromoCode:  req.PromoCode,
 	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
@@ -61,6 +94,8 @@ func (h *BookingHandler) create(c *gin.Context) {
 		FlightID:   booking.FlightID,
 		SeatNumber: booking.SeatNumber,
 		Email:      booking.Email,
+		Fare:       newFareResponse(booking.Fare()),
+		Currency:   booking.Currency,
 	})
 }
 
@@ -68,7 +103,7 @@ func (h *BookingHandler) confirm(c *gin.Context) {
 	token := c.Param("token")
 	booking, err := h.service.ConfirmBooking(c.Request.Context(), token)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
@@ -79,6 +114,8 @@ func (h *BookingHandler) confirm(c *gin.Context) {
 		FlightID:   booking.FlightID,
 		SeatNumber: booking.SeatNumber,
 		Email:      booking.Email,
+		Fare:       newFareResponse(booking.Fare()),
+		Currency:   booking.Currency,
 	})
 }
 
@@ -86,7 +123,7 @@ func (h *BookingHandler) cancel(c *gin.Context) {
 	token := c.Param("token")
 	booking, err := h.service.CancelBooking(c.Request.Context(), token)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 
@@ -97,5 +134,7 @@ func (h *BookingHandler) cancel(c *gin.Context) {
 		FlightID:   booking.FlightID,
 		SeatNumber: booking.SeatNumber,
 		Email:      booking.Email,
+		Fare:       newFareResponse(booking.Fare()),
+		Currency:   booking.Currency,
 	})
 }
@@ -49,6 +49,73 @@ func (m *MockBookingUseCase) ExpirePendingBookings(ctx context.Context) ([]domai
 	return args.Get(0).([]domain.Booking), args.Error(1)
 }
 
+func (m *MockBookingUseCase) JoinWaitlist(ctx context.Context, input booking.JoinWaitlistInput) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, input)
+	entry, _ := args.Get(0).(*domain.WaitlistEntry)
+	return entry, args.Error(1)
+}
+
+func (m *MockBookingUseCase) ExtendHold(ctx context.Context, token string) (*domain.Booking, error) {
+	args := m.Called(ctx, token)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingUseCase) ChangeSeat(ctx context.Context, token string, seatNumber int) (*domain.Booking, error) {
+	args := m.Called(ctx, token, seatNumber)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingUseCase) ChangeFlight(ctx context.Context, input booking.ChangeFlightInput) (*domain.Booking, *domain.FlightChange, error) {
+	args := m.Called(ctx, input)
+	updated, _ := args.Get(0).(*domain.Booking)
+	change, _ := args.Get(1).(*domain.FlightChange)
+	return updated, change, args.Error(2)
+}
+
+func (m *MockBookingUseCase) GetBookingHistory(ctx context.Context, token string) ([]domain.BookingTransition, error) {
+	args := m.Called(ctx, token)
+	history, _ := args.Get(0).([]domain.BookingTransition)
+	return history, args.Error(1)
+}
+
+func (m *MockBookingUseCase) GetBooking(ctx context.Context, token string) (*domain.Booking, error) {
+	args := m.Called(ctx, token)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingUseCase) ListCustomerBookings(ctx context.Context, customerID int64, input booking.ListBookingsInput) ([]domain.Booking, string, error) {
+	args := m.Called(ctx, customerID, input)
+	bookings, _ := args.Get(0).([]domain.Booking)
+	return bookings, args.String(1), args.Error(2)
+}
+
+func (m *MockBookingUseCase) OpenManageLink(ctx context.Context, link domain.ManageLink) (*booking.ManageSession, error) {
+	args := m.Called(ctx, link)
+	session, _ := args.Get(0).(*booking.ManageSession)
+	return session, args.Error(1)
+}
+
+func (m *MockBookingUseCase) GetBookingByID(ctx context.Context, id int64) (*domain.Booking, error) {
+	args := m.Called(ctx, id)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingUseCase) ReservePayment(ctx context.Context, input booking.ReservePaymentInput) (*domain.PaymentReservation, error) {
+	args := m.Called(ctx, input)
+	res, _ := args.Get(0).(*domain.PaymentReservation)
+	return res, args.Error(1)
+}
+
+func (m *MockBookingUseCase) CheckIn(ctx context.Context, token string) (*domain.Booking, error) {
+	args := m.Called(ctx, token)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
 func TestBookingHandler_create(t *testing.T) {
 	mockService := &MockBookingUseCase{}
 	handler := NewBookingHandler(mockService)
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+type errorResponse struct {
+	Error      string           `json:"error"`
+	Reason     string           `json:"reason,omitempty"`
+	Violations []fieldViolation `json:"violations,omitempty"`
+}
+
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// writeError answers with the HTTP status of a service error, the same one
+// the grpc-gateway uses: 422 for validation errors, 404 for missing
+// entities, 409 when the seat or the state of the booking or flight does not
+// allow the operation, 401 for wrong credentials. Other errors are logged
+// and answered with 500.
+func writeError(c *gin.Context, err error) {
+	var (
+		notFound    *domain.NotFoundError
+		unavailable *domain.SeatUnavailableError
+		invalid     *domain.InvalidStateError
+		validation  *domain.ValidationError
+		unauth      *domain.UnauthenticatedError
+	)
+	switch {
+	case errors.As(err, &validation):
+		resp := errorResponse{Error: err.Error(), Reason: validation.Reason()}
+		for _, v := range domain.Violations(err) {
+			resp.Violations = append(resp.Violations, fieldViolation{Field: v.Field, Description: v.Description})
+		}
+		c.JSON(http.StatusUnprocessableEntity, resp)
+	case errors.As(err, &notFound):
+		c.JSON(http.StatusNotFound, errorResponse{Error: err.Error(), Reason: notFound.Reason()})
+	case errors.As(err, &unavailable):
+		c.JSON(http.StatusConflict, errorResponse{Error: err.Error(), Reason: unavailable.Reason()})
+	case errors.As(err, &invalid):
+		c.JSON(http.StatusConflict, errorResponse{Error: err.Error(), Reason: invalid.Reason()})
+	case errors.As(err, &unauth):
+		c.JSON(http.StatusUnauthorized, errorResponse{Error: err.Error(), Reason: unauth.Reason()})
+	default:
+		log.Printf("%s %s failed: %v", c.Request.Method, c.Request.URL.Path, err)
+		c.JSON(http.StatusInternalServerError, errorResponse{Error: "internal error"})
+	}
+}
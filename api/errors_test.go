@@ -0,0 +1,46 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		want   errorResponse
+	}{
+		{name: "not found", err: domain.ErrBookingNotFound, status: http.StatusNotFound, want: errorResponse{Error: "booking not found", Reason: "BOOKING_NOT_FOUND"}},
+		{name: "seat unavailable", err: domain.ErrNoSeatsAvailable, status: http.StatusConflict, want: errorResponse{Error: "no available seats", Reason: "NO_SEATS_AVAILABLE"}},
+		{name: "invalid state", err: domain.ErrBookingNotPending, status: http.StatusConflict, want: errorResponse{Error: "booking is not pending", Reason: "BOOKING_NOT_PENDING"}},
+		{name: "validation", err: domain.NewValidationError("email", "email is required"), status: http.StatusUnprocessableEntity, want: errorResponse{
+			Error: "email is required", Reason: "VALIDATION_FAILED", Violations: []fieldViolation{{Field: "email", Description: "email is required"}},
+		}},
+		{name: "unauthenticated", err: domain.ErrInvalidCredentials, status: http.StatusUnauthorized, want: errorResponse{Error: "invalid email or password", Reason: "INVALID_CREDENTIALS"}},
+		{name: "internal", err: errors.New("connection refused"), status: http.StatusInternalServerError, want: errorResponse{Error: "internal error"}},
+	}
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("POST", "/bookings", nil)
+
+			writeError(c, tt.err)
+
+			assert.Equal(t, tt.status, w.Code)
+			var got errorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -24,7 +24,7 @@ func (h *FlightHandler) Register(router *gin.RouterGroup) {
 func (h *FlightHandler) list(c *gin.Context) {
 	flights, err := h.service.List(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, flights)
@@ -38,7 +38,7 @@ func (h *FlightHandler) get(c *gin.Context) {
 	}
 	flight, err := h.service.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		writeError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, flight)
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Domenick1991/airbooking/config"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/Domenick1991/airbooking/internal/service/apikeys"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// apikey manages the API keys of partners: it creates a key, printing it
+// once, lists the keys or revokes one.
+func main() {
+	var (
+		create    = flag.String("create", "", "create a key with this name")
+		scopes    = flag.String("scopes", "flights:read", "comma-separated scopes of the new key, e.g. \"flights:read,bookings:create\"")
+		rateLimit = flag.Int("rate-limit", 0, "calls per minute of the new key; 0 keeps rate_limit.api_key_per_minute")
+		list      = flag.Bool("list", false, "list the keys")
+		revoke    = flag.Int64("revoke", 0, "revoke the key with this id")
+	)
+	flag.Parse()
+	if *create == "" && !*list && *revoke == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfgPath := os.Getenv("CONFIG_PATH")
+	if cfgPath == "" {
+		cfgPath = "config.yaml"
+	}
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, cfg.Database.DSN())
+	if err != nil {
+		log.Fatalf("connect postgres: %v", err)
+	}
+	defer pool.Close()
+
+	svc := apikeys.NewService(repository.NewAPIKeyRepository(pool))
+	switch {
+	case *create != "":
+		var scopeList []string
+		for _, s := range strings.Split(*scopes, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				scopeList = append(scopeList, s)
+			}
+		}
+		plain, key, err := svc.Create(ctx, apikeys.CreateInput{Name: *create, Scopes: scopeList, RateLimitPerMinute: *rateLimit})
+		if err != nil {
+			log.Fatalf("create key: %v", err)
+		}
+		fmt.Printf("key %d (%s) created, it is not shown again:\n%s\n", key.ID, key.Name, plain)
+	case *revoke != 0:
+		key, err := svc.Revoke(ctx, *revoke)
+		if err != nil {
+			log.Fatalf("revoke key: %v", err)
+		}
+		fmt.Printf("key %d (%s) revoked at %s\n", key.ID, key.Name, key.RevokedAt.Format(time.RFC3339))
+	default:
+		keys, err := svc.List(ctx)
+		if err != nil {
+			log.Fatalf("list keys: %v", err)
+		}
+		for _, k := range keys {
+			state := "active"
+			if k.Revoked() {
+				state = "revoked " + k.RevokedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%d\t%s\t%s…\t%s\t%d/min\t%s\n", k.ID, k.Name, k.Prefix, strings.Join(k.Scopes, ","), k.RateLimitPerMinute, state)
+		}
+	}
+}
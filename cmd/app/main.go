@@ -2,20 +2,35 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/Domenick1991/airbooking/config"
 	"github.com/Domenick1991/airbooking/internal/bootstrap"
 	"github.com/Domenick1991/airbooking/internal/cache"
+	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/ratelimit"
 	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/Domenick1991/airbooking/internal/service/apikeys"
+	"github.com/Domenick1991/airbooking/internal/service/availability"
 	"github.com/Domenick1991/airbooking/internal/service/booking"
+	"github.com/Domenick1991/airbooking/internal/service/customers"
+	"github.com/Domenick1991/airbooking/internal/service/exchangerates"
 	"github.com/Domenick1991/airbooking/internal/service/flights"
+	"github.com/Domenick1991/airbooking/internal/service/loyalty"
+	"github.com/Domenick1991/airbooking/internal/service/operations"
+	"github.com/Domenick1991/airbooking/internal/service/promotions"
+	"github.com/Domenick1991/airbooking/internal/service/wallet"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	kafkaGo "github.com/segmentio/kafka-go"
 )
 
 func main() {
@@ -44,6 +59,31 @@ func main() {
 	flightRepo := repository.NewFlightRepository(pool)
 	bookingRepo := repository.NewBookingRepository(pool)
 	flightService := flights.NewFlightService(flightRepo, redisCache, time.Duration(cfg.Booking.FlightsCacheTTL)*time.Second)
+	adminFlightService := flights.NewAdminService(flightRepo, redisCache, producer, cfg.Kafka.FlightEventsTopic)
+	holdPolicies, err := cfg.Booking.HoldPolicies()
+	if err != nil {
+		log.Fatalf("invalid hold policies: %v", err)
+	}
+	fareRules, err := cfg.Booking.FareRules()
+	if err != nil {
+		log.Fatalf("invalid fare rules: %v", err)
+	}
+	tokenIssuer, err := cfg.Auth.TokenIssuer()
+	if err != nil {
+		log.Fatalf("auth: %v", err)
+	}
+	redemptionRules, err := cfg.Wallet.Rules()
+	if err != nil {
+		log.Fatalf("invalid wallet rules: %v", err)
+	}
+	pricingRules, err := cfg.Pricing.Rules()
+	if err != nil {
+		log.Fatalf("invalid pricing rules: %v", err)
+	}
+	walletRepo := repository.NewWalletRepository(pool)
+	promotionRepo := repository.NewPromotionRepository(pool)
+	loyaltyRepo := repository.NewLoyaltyRepository(pool)
+	exchangeRateService := exchangerates.NewService(repository.NewExchangeRateRepository(pool))
 	bookingService := booking.NewBookingService(
 		bookingRepo,
 		flightRepo,
@@ -54,9 +94,80 @@ func main() {
 		time.Duration(cfg.Booking.HoldTTLMinutes)*time.Minute,
 		time.Duration(cfg.Booking.ConfirmationTTL)*time.Minute,
 		booking.WithNotificationsTopic(cfg.Kafka.NotificationsTopic),
+		booking.WithWaitlist(repository.NewWaitlistRepository(pool), time.Duration(cfg.Booking.WaitlistHoldMinutes)*time.Minute),
+		booking.WithHoldPolicies(holdPolicies),
+		booking.WithWallet(walletRepo, redemptionRules),
+		booking.WithPricing(pricingRules),
+		booking.WithExchangeRates(exchangeRateService),
+		booking.WithPromotions(promotionRepo),
+		booking.WithLoyalty(loyaltyRepo),
+		booking.WithFareRules(fareRules),
+		booking.WithManageLinks(cfg.ManageLinks.Signer(), tokenIssuer, cfg.ManageLinks.SessionTTL()),
 	)
 
-	if err := bootstrap.Run(ctx, cfg, flightService, bookingService); err != nil {
+	opsService := operations.NewService(
+		flightRepo,
+		bookingRepo,
+		redisCache,
+		producer,
+		operations.Topics{
+			FlightEvents:  cfg.Kafka.FlightEventsTopic,
+			BookingEvents: cfg.Kafka.BookingTopic,
+			Notifications: cfg.Kafka.NotificationsTopic,
+		},
+		time.Duration(cfg.Ops.RebookingWindowHours)*time.Hour,
+		cfg.Ops.MaxRebookingAlternatives,
+		operations.WithDeniedBoarding(repository.NewDeniedBoardingRepository(pool), domain.DeniedBoardingCompensation{
+			VoluntaryCents:   cfg.Ops.VoluntaryCompensationCents,
+			InvoluntaryCents: cfg.Ops.InvoluntaryCompensationCents,
+		}),
+		operations.WithOversoldWindow(time.Duration(cfg.Ops.OversoldReportHours)*time.Hour),
+		operations.WithPricing(pricingRules, exchangeRateService),
+	)
+
+	availabilityService := availability.NewService(flightRepo, availability.NewHub(32))
+	// Every instance serves its own watchers, so each one reads the whole
+	// booking events topic in its own consumer group.
+	hostname, _ := os.Hostname()
+	availabilityConsumer := kafka.NewConsumer(cfg.Kafka.Brokers, fmt.Sprintf("%s-availability-%s", cfg.Kafka.GroupID, hostname), cfg.Kafka.BookingTopic)
+	defer availabilityConsumer.Close()
+	go func() {
+		if err := availabilityConsumer.Consume(ctx, func(ctx context.Context, msg kafkaGo.Message) error {
+			var event kafka.BookingEvent
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				log.Printf("decode booking event error: %v", err)
+				return nil
+			}
+			if err := availabilityService.HandleBookingEvent(ctx, event); err != nil {
+				log.Printf("availability update for flight %d failed: %v", event.FlightID, err)
+			}
+			return nil
+		}); err != nil {
+			log.Printf("availability consumer stopped: %v", err)
+		}
+	}()
+
+	customerService := customers.NewService(
+		repository.NewCustomerRepository(pool),
+		tokenIssuer,
+		producer,
+		cfg.Kafka.NotificationsTopic,
+		time.Duration(cfg.Customers.VerificationTTLHours)*time.Hour,
+	)
+
+	loyaltyRules, err := cfg.Loyalty.Rules()
+	if err != nil {
+		log.Fatalf("invalid loyalty rules: %v", err)
+	}
+	loyaltyService := loyalty.NewService(loyaltyRepo, bookingRepo, flightRepo, loyaltyRules)
+
+	walletService := wallet.NewService(walletRepo)
+	promotionService := promotions.NewService(promotionRepo)
+
+	apiKeyService := apikeys.NewService(repository.NewAPIKeyRepository(pool))
+	limiter := ratelimit.NewRedisLimiter(redis.NewClient(&redis.Options{Addr: cfg.Redis.Addr, Password: cfg.Redis.Password, DB: cfg.Redis.DB}))
+
+	if err := bootstrap.Run(ctx, cfg, flightService, bookingService, adminFlightService, opsService, availabilityService, customerService, loyaltyService, walletService, promotionService, exchangeRateService, apiKeyService, limiter); err != nil {
 		log.Fatalf("server error: %v", err)
 	}
 }
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Domenick1991/airbooking/config"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/Domenick1991/airbooking/internal/service/exchangerates"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// rates-import sets exchange rates from a CSV file of base,quote,rate lines,
+// e.g. "EUR,RUB,95.25". Pairs missing from the file keep their rates.
+func main() {
+	var (
+		file   = flag.String("file", "", "path to the CSV file")
+		dryRun = flag.Bool("dry-run", false, "only print the rates")
+	)
+	flag.Parse()
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfgPath := os.Getenv("CONFIG_PATH")
+	if cfgPath == "" {
+		cfgPath = "config.yaml"
+	}
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatalf("open %s: %v", *file, err)
+	}
+	rates, err := exchangerates.ParseCSV(f)
+	f.Close()
+	if err != nil {
+		log.Fatalf("parse %s: %v", *file, err)
+	}
+
+	if *dryRun {
+		for i := range rates {
+			if err := rates[i].Validate(); err != nil {
+				fmt.Printf("! %s/%s: %v\n", rates[i].Base, rates[i].Quote, err)
+				continue
+			}
+			fmt.Printf("%s/%s %s\n", rates[i].Base, rates[i].Quote, domain.FormatRate(rates[i].Rate))
+		}
+		fmt.Printf("%d rates read\n", len(rates))
+		fmt.Println("dry run, nothing written")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, cfg.Database.DSN())
+	if err != nil {
+		log.Fatalf("connect postgres: %v", err)
+	}
+	defer pool.Close()
+
+	set, err := exchangerates.NewService(repository.NewExchangeRateRepository(pool)).SetRates(ctx, rates)
+	if err != nil {
+		log.Fatalf("set rates: %v", err)
+	}
+	for _, r := range set {
+		fmt.Printf("%s/%s %s\n", r.Base, r.Quote, domain.FormatRate(r.Rate))
+	}
+	fmt.Printf("%d rates set\n", len(set))
+}
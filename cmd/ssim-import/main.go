@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Domenick1991/airbooking/config"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/Domenick1991/airbooking/internal/service/schedules"
+	"github.com/Domenick1991/airbooking/internal/ssim"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ssim-import loads SSIM type 3 records into the schedules table. The worker
+// materializes flights from schedules on its next sweep.
+func main() {
+	var (
+		file         = flag.String("file", "", "path to the SSIM file")
+		dryRun       = flag.Bool("dry-run", false, "only print the differences")
+		prune        = flag.Bool("prune", false, "delete stored schedules of the imported airlines that are missing from the file")
+		defaultSeats = flag.Int("default-seats", 150, "seats used when a leg has no aircraft configuration")
+		serviceTypes = flag.String("service-types", "J", "service types to import, e.g. \"JS\"")
+	)
+	flag.Parse()
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfgPath := os.Getenv("CONFIG_PATH")
+	if cfgPath == "" {
+		cfgPath = "config.yaml"
+	}
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatalf("open %s: %v", *file, err)
+	}
+	legs, err := ssim.Parse(f)
+	f.Close()
+	if err != nil {
+		log.Fatalf("parse %s: %v", *file, err)
+	}
+
+	var (
+		incoming []domain.Schedule
+		airlines []string
+		seen     = map[string]bool{}
+	)
+	for _, leg := range legs {
+		if !strings.Contains(*serviceTypes, leg.ServiceType) {
+			continue
+		}
+		incoming = append(incoming, leg.Schedule(*defaultSeats))
+		if !seen[leg.Airline] {
+			seen[leg.Airline] = true
+			airlines = append(airlines, leg.Airline)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, cfg.Database.DSN())
+	if err != nil {
+		log.Fatalf("connect postgres: %v", err)
+	}
+	defer pool.Close()
+
+	importer := schedules.NewImporter(repository.NewScheduleRepository(pool))
+	plan, err := importer.Plan(ctx, incoming, airlines)
+	if err != nil {
+		log.Fatalf("plan import: %v", err)
+	}
+
+	for _, c := range plan.Changes {
+		fmt.Println(c)
+	}
+	for _, inv := range plan.Invalid {
+		fmt.Printf("! %s %s-%s: %v\n", inv.Schedule.FlightNumber, inv.Schedule.FromAirport, inv.Schedule.ToAirport, inv.Err)
+	}
+	fmt.Printf("%d legs read: %d to add, %d to update, %d to remove, %d unchanged, %d invalid\n",
+		len(legs), plan.Count(schedules.ChangeAdd), plan.Count(schedules.ChangeUpdate), plan.Count(schedules.ChangeRemove), plan.Unchanged, len(plan.Invalid))
+
+	if *dryRun {
+		fmt.Println("dry run, nothing written")
+		return
+	}
+	if plan.Count(schedules.ChangeRemove) > 0 && !*prune {
+		fmt.Println("removals skipped, pass -prune to apply them")
+	}
+	if err := importer.Apply(ctx, plan, *prune); err != nil {
+		log.Fatalf("apply import: %v", err)
+	}
+	fmt.Println("import applied")
+}
@@ -8,13 +8,20 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/Domenick1991/airbooking/config"
+	"github.com/Domenick1991/airbooking/internal/audit"
 	"github.com/Domenick1991/airbooking/internal/cache"
+	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/email"
 	"github.com/Domenick1991/airbooking/internal/kafka"
 	"github.com/Domenick1991/airbooking/internal/repository"
 	"github.com/Domenick1991/airbooking/internal/service/booking"
+	"github.com/Domenick1991/airbooking/internal/service/exchangerates"
+	"github.com/Domenick1991/airbooking/internal/service/loyalty"
+	"github.com/Domenick1991/airbooking/internal/service/operations"
+	"github.com/Domenick1991/airbooking/internal/service/schedules"
 	"github.com/jackc/pgx/v5/pgxpool"
 	kafkaGo "github.com/segmentio/kafka-go"
 )
@@ -30,7 +37,7 @@ func main() {
 		log.Fatalf("load config: %v", err)
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(audit.WithActor(context.Background(), "worker"))
 	defer cancel()
 
 	pool, err := pgxpool.New(ctx, cfg.Database.DSN())
@@ -44,21 +51,71 @@ func main() {
 
 	flightRepo := repository.NewFlightRepository(pool)
 	bookingRepo := repository.NewBookingRepository(pool)
+	holdPolicies, err := cfg.Booking.HoldPolicies()
+	if err != nil {
+		log.Fatalf("invalid hold policies: %v", err)
+	}
+	redemptionRules, err := cfg.Wallet.Rules()
+	if err != nil {
+		log.Fatalf("invalid wallet rules: %v", err)
+	}
+	pricingRules, err := cfg.Pricing.Rules()
+	if err != nil {
+		log.Fatalf("invalid pricing rules: %v", err)
+	}
+	walletRepo := repository.NewWalletRepository(pool)
+	exchangeRateService := exchangerates.NewService(repository.NewExchangeRateRepository(pool))
 	bookingService := booking.NewBookingService(
 		bookingRepo,
 		flightRepo,
 		redisCache,
 		producer,
-		cfg.Kafka.BookingEventsTopic,
+		// cfg.Kafka.BookingEventsTopic,
+		cfg.Kafka.BookingTopic,
 		time.Duration(cfg.Booking.HoldTTLMinutes)*time.Minute,
 		time.Duration(cfg.Booking.ConfirmationTTL)*time.Minute,
 		booking.WithNotificationsTopic(cfg.Kafka.NotificationsTopic),
+		booking.WithWaitlist(repository.NewWaitlistRepository(pool), time.Duration(cfg.Booking.WaitlistHoldMinutes)*time.Minute),
+		booking.WithHoldPolicies(holdPolicies),
+		booking.WithWallet(walletRepo, redemptionRules),
+		booking.WithPricing(pricingRules),
+		booking.WithExchangeRates(exchangeRateService),
 	)
 
 	consumer := kafka.NewConsumer(cfg.Kafka.Brokers, cfg.Kafka.GroupID, cfg.Kafka.NotificationsTopic)
 	defer consumer.Close()
 
-	emailSender := email.NewSender()
+	scheduleService := schedules.NewScheduleService(
+		repository.NewScheduleRepository(pool),
+		redisCache,
+		time.Duration(cfg.Worker.ScheduleHorizonDays)*24*time.Hour,
+	)
+
+	opsService := operations.NewService(
+		flightRepo,
+		bookingRepo,
+		redisCache,
+		producer,
+		operations.Topics{
+			FlightEvents:  cfg.Kafka.FlightEventsTopic,
+			BookingEvents: cfg.Kafka.BookingTopic,
+			Notifications: cfg.Kafka.NotificationsTopic,
+		},
+		time.Duration(cfg.Ops.RebookingWindowHours)*time.Hour,
+		cfg.Ops.MaxRebookingAlternatives,
+	)
+
+	loyaltyRules, err := cfg.Loyalty.Rules()
+	if err != nil {
+		log.Fatalf("invalid loyalty rules: %v", err)
+	}
+	loyaltyService := loyalty.NewService(repository.NewLoyaltyRepository(pool), bookingRepo, flightRepo, loyaltyRules)
+	// Miles are accrued from booking_flown events; a booking accrues once,
+	// so redelivered events are harmless.
+	loyaltyConsumer := kafka.NewConsumer(cfg.Kafka.Brokers, cfg.Kafka.GroupID+"-loyalty", cfg.Kafka.BookingTopic)
+	defer loyaltyConsumer.Close()
+
+	emailSender := email.NewSender(email.WithManageLinks(cfg.ManageLinks.Signer()))
 
 	go func() {
 		if err := consumer.Consume(ctx, func(ctx context.Context, msg kafkaGo.Message) error {
@@ -73,9 +130,43 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := loyaltyConsumer.Consume(ctx, func(ctx context.Context, msg kafkaGo.Message) error {
+			var event kafka.BookingEvent
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				log.Printf("decode booking event error: %v", err)
+				return nil
+			}
+			if err := loyaltyService.HandleBookingEvent(ctx, event); err != nil {
+				log.Printf("loyalty accrual for booking %d failed: %v", event.BookingID, err)
+			}
+			return nil
+		}); err != nil {
+			log.Printf("loyalty consumer stopped: %v", err)
+		}
+	}()
+
+	if cfg.Kafka.OpsStatusTopic != "" {
+		statusConsumer := kafka.NewConsumer(cfg.Kafka.Brokers, cfg.Kafka.GroupID+"-ops-status", cfg.Kafka.OpsStatusTopic)
+		defer statusConsumer.Close()
+
+		go func() {
+			if err := statusConsumer.Consume(ctx, func(ctx context.Context, msg kafkaGo.Message) error {
+				applyStatusReport(ctx, opsService, msg.Value)
+				return nil
+			}); err != nil {
+				log.Printf("ops status consumer stopped: %v", err)
+			}
+		}()
+	}
+
 	expireTicker := time.NewTicker(time.Duration(cfg.Worker.ExpirationSweepMinutes) * time.Minute)
 	defer expireTicker.Stop()
 
+	scheduleTicker := time.NewTicker(time.Duration(cfg.Worker.ScheduleSweepMinutes) * time.Minute)
+	defer scheduleTicker.Stop()
+	materializeSchedules(ctx, scheduleService)
+
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
 
@@ -90,9 +181,48 @@ func main() {
 			if len(expired) > 0 {
 				log.Printf("expired %d bookings", len(expired))
 			}
+		case <-scheduleTicker.C:
+			materializeSchedules(ctx, scheduleService)
 		case s := <-sig:
 			log.Printf("received signal %v, shutting down", s)
 			return
 		}
 	}
 }
+
+func materializeSchedules(ctx context.Context, scheduleService schedules.ScheduleUseCase) {
+	result, err := scheduleService.MaterializeFlights(ctx)
+	if err != nil {
+		log.Printf("materialize schedules error: %v", err)
+		return
+	}
+	if result.Created+result.Updated+result.Removed > 0 {
+		log.Printf("schedules: %d processed, %d flights created, %d updated, %d removed", result.Schedules, result.Created, result.Updated, result.Removed)
+	}
+}
+
+// applyStatusReport applies one message from the ops status topic. Invalid and
+// stale reports are logged and skipped so that they do not block the topic.
+func applyStatusReport(ctx context.Context, opsService operations.OperationsUseCase, value []byte) {
+	var report kafka.FlightStatusMessage
+	if err := json.Unmarshal(value, &report); err != nil {
+		log.Printf("decode ops status error: %v", err)
+		return
+	}
+	result, err := opsService.UpdateFlightStatus(ctx, domain.FlightStatusUpdate{
+		FlightID:           report.FlightID,
+		Status:             domain.FlightStatus(report.Status),
+		EstimatedDeparture: report.EstimatedDeparture,
+		Gate:               report.Gate,
+		Terminal:           report.Terminal,
+		DivertedTo:         report.DivertedTo,
+		ReportedAt:         report.ReportedAt,
+	})
+	if err != nil {
+		log.Printf("ops status for flight %d skipped: %v", report.FlightID, err)
+		return
+	}
+	if len(result.Change.Changes) > 0 {
+		log.Printf("flight %d: %v, %d passengers notified", report.FlightID, result.Change.Changes, result.Notified)
+	}
+}
@@ -1,9 +1,14 @@
 package config
 
 import (
+	"crypto/rsa"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
 	"gopkg.in/yaml.v3"
 )
 
@@ -15,6 +20,15 @@ type Config struct {
 	Kafka   KafkaConfig   `yaml:"kafka"`
 	Booking BookingConfig `yaml:"booking"`
 	Worker  WorkerConfig  `yaml:"worker"`
+	Admin   AdminConfig   `yaml:"admin"`
+	Ops     OpsConfig     `yaml:"ops"`
+	Auth    AuthConfig    `yaml:"auth"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Customers CustomersConfig `yaml:"customers"`
+	ManageLinks ManageLinksConfig `yaml:"manage_links"`
+	Loyalty LoyaltyConfig `yaml:"loyalty"`
+	Wallet  WalletConfig  `yaml:"wallet"`
+	Pricing PricingConfig `yaml:"pricing"`
 }
 
 type HTTPConfig struct {
@@ -50,6 +64,8 @@ type KafkaConfig struct {
 	BookingTopic       string   `yaml:"booking_topic"`
 	BookingEventsTopic string   `yaml:"booking_events_topic"`
 	NotificationsTopic string   `yaml:"notifications_topic"`
+	FlightEventsTopic  string   `yaml:"flight_events_topic"`
+	OpsStatusTopic     string   `yaml:"ops_status_topic"`
 	GroupID            string   `yaml:"group_id"`
 }
 
@@ -57,10 +73,358 @@ type BookingConfig struct {
 	HoldTTLMinutes    int `yaml:"hold_ttl_minutes"`
 	FlightsCacheTTL   int `yaml:"flights_cache_ttl_seconds"`
 	ConfirmationTTL   int `yaml:"confirmation_ttl_minutes"`
+	WaitlistHoldMinutes int `yaml:"waitlist_hold_minutes"`
+	// Extension of the default hold policy; the default TTL is
+	// confirmation_ttl_minutes, or hold_ttl_minutes when that is not set.
+	HoldExtendMinutes int                `yaml:"hold_extend_minutes"`
+	HoldMaxExtensions int                `yaml:"hold_max_extensions"`
+	HoldPolicyRules   []HoldPolicyConfig `yaml:"hold_policies"`
+	// Change rules by fare class; classes not listed keep the defaults.
+	FareRuleConfigs map[string]FareRuleConfig `yaml:"fare_rules"`
+}
+
+// FareRuleConfig holds the flight change conditions of a fare class.
+type FareRuleConfig struct {
+	Changeable     bool  `yaml:"changeable"`
+	ChangeFeeCents int64 `yaml:"change_fee_cents"`
+	MinNoticeHours int   `yaml:"min_notice_hours"`
+}
+
+// HoldPolicyConfig overrides the default hold policy for a channel, a fare
+// class or both. Zero ttl_minutes and extend_minutes keep the default.
+type HoldPolicyConfig struct {
+	Channel       string `yaml:"channel"`
+	FareClass     string `yaml:"fare_class"`
+	TTLMinutes    int    `yaml:"ttl_minutes"`
+	ExtendMinutes int    `yaml:"extend_minutes"`
+	MaxExtensions int    `yaml:"max_extensions"`
+}
+
+// HoldPolicies builds the hold policies of the booking service.
+func (c BookingConfig) HoldPolicies() (domain.HoldPolicies, error) {
+	ttl := c.ConfirmationTTL
+	if ttl == 0 {
+		ttl = c.HoldTTLMinutes
+	}
+	policies := domain.HoldPolicies{Default: domain.HoldPolicy{
+		TTL:           time.Duration(ttl) * time.Minute,
+		ExtendBy:      time.Duration(c.HoldExtendMinutes) * time.Minute,
+		MaxExtensions: c.HoldMaxExtensions,
+	}}
+	for _, rule := range c.HoldPolicyRules {
+		policy := domain.HoldPolicy{
+			TTL:           time.Duration(rule.TTLMinutes) * time.Minute,
+			ExtendBy:      time.Duration(rule.ExtendMinutes) * time.Minute,
+			MaxExtensions: rule.MaxExtensions,
+		}
+		if rule.Channel != "" {
+			channel, err := domain.ParseChannel(rule.Channel)
+			if err != nil {
+				return domain.HoldPolicies{}, fmt.Errorf("hold policy %q/%q: %w", rule.Channel, rule.FareClass, err)
+			}
+			policy.Channel = channel
+		}
+		if rule.FareClass != "" {
+			class, err := domain.ParseFareClass(rule.FareClass)
+			if err != nil {
+				return domain.HoldPolicies{}, fmt.Errorf("hold policy %q/%q: %w", rule.Channel, rule.FareClass, err)
+			}
+			policy.FareClass = class
+		}
+		policies.Rules = append(policies.Rules, policy)
+	}
+	return policies, policies.Validate()
+}
+
+// FareRules builds the flight change rules of the booking service on top of
+// domain.DefaultFareRules.
+func (c BookingConfig) FareRules() (domain.FareRules, error) {
+	rules := domain.DefaultFareRules()
+	for name, rule := range c.FareRuleConfigs {
+		class, err := domain.ParseFareClass(name)
+		if err != nil {
+			return nil, fmt.Errorf("fare rule %q: %w", name, err)
+		}
+		if rule.ChangeFeeCents < 0 || rule.MinNoticeHours < 0 {
+			return nil, fmt.Errorf("fare rule %q: fee and notice must not be negative", name)
+		}
+		rules[class] = domain.FareRule{
+			Changeable:     rule.Changeable,
+			ChangeFeeCents: rule.ChangeFeeCents,
+			MinNotice:      time.Duration(rule.MinNoticeHours) * time.Hour,
+		}
+	}
+	return rules, nil
+}
+
+// AdminConfig holds the static bearer token for AdminFlightsService.
+type AdminConfig struct {
+	Token string `yaml:"token"`
+}
+
+// AuthConfig configures the JWT authentication of API callers: HS256 tokens
+// are checked with hs256_secret, RS256 tokens with the keys of jwks_file.
+// With neither set bearer tokens are not checked. Customer tokens are signed
+// with the RSA key of signing_key_file when set, with hs256_secret otherwise.
+type AuthConfig struct {
+	Issuer        string `yaml:"issuer"`
+	Audience      string `yaml:"audience"`
+	HS256Secret   string `yaml:"hs256_secret"`
+	JWKSFile      string `yaml:"jwks_file"`
+	LeewaySeconds int    `yaml:"leeway_seconds"`
+	// SigningKeyFile is a PEM RSA private key; its public key is accepted
+	// by the verifier under SigningKeyID.
+	SigningKeyFile    string `yaml:"signing_key_file"`
+	SigningKeyID      string `yaml:"signing_key_id"`
+	SessionTTLMinutes int    `yaml:"session_ttl_minutes"`
+}
+
+// Verifier builds the JWT verifier of the API servers.
+func (c AuthConfig) Verifier() (*auth.Verifier, error) {
+	opts := []auth.VerifierOption{
+		auth.WithIssuer(c.Issuer),
+		auth.WithAudience(c.Audience),
+		auth.WithLeeway(time.Duration(c.LeewaySeconds) * time.Second),
+	}
+	if c.HS256Secret != "" {
+		opts = append(opts, auth.WithHS256Secret([]byte(c.HS256Secret)))
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	if c.JWKSFile != "" {
+		jwks, err := auth.LoadJWKS(c.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = jwks
+	}
+	if c.SigningKeyFile != "" {
+		key, err := auth.LoadRSAPrivateKey(c.SigningKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		keys[c.SigningKeyID] = &key.PublicKey
+	}
+	if len(keys) > 0 {
+		opts = append(opts, auth.WithRSAKeys(keys))
+	}
+	return auth.NewVerifier(opts...), nil
+}
+
+// TokenIssuer builds the issuer of customer access tokens.
+func (c AuthConfig) TokenIssuer() (*auth.Issuer, error) {
+	ttl := time.Duration(c.SessionTTLMinutes) * time.Minute
+	if ttl <= 0 {
+		ttl = time.Hour
+	}
+	var opts []auth.IssuerOption
+	switch {
+	case c.SigningKeyFile != "":
+		key, err := auth.LoadRSAPrivateKey(c.SigningKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, auth.SignWithRS256(c.SigningKeyID, key))
+	case c.HS256Secret != "":
+		opts = append(opts, auth.SignWithHS256([]byte(c.HS256Secret)))
+	}
+	return auth.NewIssuer(c.Issuer, c.Audience, ttl, opts...), nil
+}
+
+// CustomersConfig controls customer accounts.
+type CustomersConfig struct {
+	// VerificationTTLHours is how long emailed verification codes are valid.
+	VerificationTTLHours int `yaml:"verification_ttl_hours"`
+}
+
+// ManageLinksConfig controls the manage-booking links emailed by the
+// notifications worker. Without a secret no links are sent and none are
+// accepted.
+type ManageLinksConfig struct {
+	Secret  string `yaml:"secret"`
+	BaseURL string `yaml:"base_url"`
+	// TTLMinutes is how long a link is valid, 24 hours by default.
+	TTLMinutes int `yaml:"ttl_minutes"`
+	// SessionTTLMinutes is how long the session a link is exchanged for
+	// lasts, 15 minutes by default.
+	SessionTTLMinutes int `yaml:"session_ttl_minutes"`
+}
+
+// Signer builds the signer of manage links.
+func (c ManageLinksConfig) Signer() *auth.LinkSigner {
+	ttl := time.Duration(c.TTLMinutes) * time.Minute
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	return auth.NewLinkSigner([]byte(c.Secret), c.BaseURL, ttl)
+}
+
+// SessionTTL is how long manage sessions last.
+func (c ManageLinksConfig) SessionTTL() time.Duration {
+	if c.SessionTTLMinutes <= 0 {
+		return 15 * time.Minute
+	}
+	return time.Duration(c.SessionTTLMinutes) * time.Minute
+}
+
+// LoyaltyConfig overrides domain.DefaultLoyaltyRules. Zero values and an
+// empty tier list keep the defaults.
+type LoyaltyConfig struct {
+	// FareMultipliers are percentages of the flown distance by fare class.
+	FareMultipliers         map[string]int64   `yaml:"fare_multipliers"`
+	MinimumMiles            int64              `yaml:"minimum_miles"`
+	Tiers                   []LoyaltyTierConfig `yaml:"tiers"`
+	QualificationWindowDays int                `yaml:"qualification_window_days"`
+	TierValidityDays        int                `yaml:"tier_validity_days"`
+}
+
+// LoyaltyTierConfig is what it takes to reach a tier and its accrual bonus.
+type LoyaltyTierConfig struct {
+	Tier            string `yaml:"tier"`
+	QualifyingMiles int64  `yaml:"qualifying_miles"`
+	BonusPercent    int64  `yaml:"bonus_percent"`
+}
+
+// Rules builds the accrual and tier rules of the loyalty program.
+func (c LoyaltyConfig) Rules() (domain.LoyaltyRules, error) {
+	rules := domain.DefaultLoyaltyRules()
+	for name, percent := range c.FareMultipliers {
+		class, err := domain.ParseFareClass(name)
+		if err != nil {
+			return domain.LoyaltyRules{}, fmt.Errorf("fare multiplier %q: %w", name, err)
+		}
+		rules.FareMultipliers[class] = percent
+	}
+	if c.MinimumMiles != 0 {
+		rules.MinimumMiles = c.MinimumMiles
+	}
+	if len(c.Tiers) > 0 {
+		rules.Tiers = nil
+		for _, t := range c.Tiers {
+			rules.Tiers = append(rules.Tiers, domain.TierRule{Tier: domain.LoyaltyTier(t.Tier), QualifyingMiles: t.QualifyingMiles, BonusPercent: t.BonusPercent})
+		}
+	}
+	if c.QualificationWindowDays != 0 {
+		rules.QualificationWindow = time.Duration(c.QualificationWindowDays) * 24 * time.Hour
+	}
+	if c.TierValidityDays != 0 {
+		rules.TierValidity = time.Duration(c.TierValidityDays) * 24 * time.Hour
+	}
+	return rules, rules.Validate()
+}
+
+// WalletConfig controls paying bookings with miles and travel credit.
+type WalletConfig struct {
+	// CentsPerHundredMiles is what 100 miles pay for, 100 by default.
+	CentsPerHundredMiles int64 `yaml:"cents_per_hundred_miles"`
+	// CancellationCreditPercent of the part of the price not paid with
+	// miles or credit is issued as travel credit when such a booking is
+	// cancelled, none when not set.
+	CancellationCreditPercent int64 `yaml:"cancellation_credit_percent"`
+	// SettlementCurrency payments, travel credit and miles are valued in,
+	// RUB when not set.
+	SettlementCurrency string `yaml:"settlement_currency"`
+}
+
+// Rules builds the redemption rules of the booking service.
+func (c WalletConfig) Rules() (domain.RedemptionRules, error) {
+	rules := domain.DefaultRedemptionRules()
+	if c.CentsPerHundredMiles != 0 {
+		rules.CentsPerHundredMiles = c.CentsPerHundredMiles
+	}
+	rules.CancellationCreditPercent = c.CancellationCreditPercent
+	if c.SettlementCurrency != "" {
+		rules.SettlementCurrency = strings.ToUpper(strings.TrimSpace(c.SettlementCurrency))
+	}
+	return rules, rules.Validate()
+}
+
+// PricingConfig sets the taxes and fees charged on top of flight fares.
+// Nothing is charged when it is empty.
+type PricingConfig struct {
+	// Currency of the amounts below, RUB when not set. They are converted to
+	// the currency of the flight.
+	Currency           string `yaml:"currency"`
+	FuelSurchargeCents int64  `yaml:"fuel_surcharge_cents"`
+	// BookingFees by sales channel and SeatFees by fare class.
+	BookingFees map[string]int64 `yaml:"booking_fees"`
+	SeatFees    map[string]int64 `yaml:"seat_fees"`
+	Taxes       []TaxRuleConfig  `yaml:"taxes"`
+}
+
+// TaxRuleConfig is a tax levied at the departure or arrival airport of a
+// flight. Airport and country restrict the airports it is levied at.
+type TaxRuleConfig struct {
+	Code        string `yaml:"code"`
+	LeviedOn    string `yaml:"levied_on"`
+	Airport     string `yaml:"airport"`
+	Country     string `yaml:"country"`
+	AmountCents int64  `yaml:"amount_cents"`
+	Percent     int64  `yaml:"percent"`
+}
+
+// Rules builds the pricing rules of the booking service.
+func (c PricingConfig) Rules() (domain.PricingRules, error) {
+	rules := domain.PricingRules{
+		Currency:           domain.DefaultCurrency,
+		FuelSurchargeCents: c.FuelSurchargeCents,
+		BookingFees:        make(map[domain.Channel]int64),
+		SeatFees:           make(map[domain.FareClass]int64),
+	}
+	for name, fee := range c.BookingFees {
+		channel, err := domain.ParseChannel(name)
+		if err != nil {
+			return domain.PricingRules{}, fmt.Errorf("booking fee %q: %w", name, err)
+		}
+		rules.BookingFees[channel] = fee
+	}
+	for name, fee := range c.SeatFees {
+		class, err := domain.ParseFareClass(name)
+		if err != nil {
+			return domain.PricingRules{}, fmt.Errorf("seat fee %q: %w", name, err)
+		}
+		rules.SeatFees[class] = fee
+	}
+	if c.Currency != "" {
+		rules.Currency = strings.ToUpper(strings.TrimSpace(c.Currency))
+	}
+	for _, t := range c.Taxes {
+		rules.Taxes = append(rules.Taxes, domain.TaxRule{
+			Code:        t.Code,
+			LevyOn:      domain.TaxLevy(strings.ToUpper(t.LeviedOn)),
+			Airport:     t.Airport,
+			Country:     t.Country,
+			AmountCents: t.AmountCents,
+			Percent:     t.Percent,
+		})
+	}
+	return rules, rules.Validate()
+}
+
+// RateLimitConfig sets the token buckets of API callers: per API key for
+// partners, per client IP for everyone else. A zero per-minute rate turns
+// the limit off; a zero burst allows a minute's worth of calls at once.
+type RateLimitConfig struct {
+	IPPerMinute     int `yaml:"ip_per_minute"`
+	IPBurst         int `yaml:"ip_burst"`
+	APIKeyPerMinute int `yaml:"api_key_per_minute"`
+	APIKeyBurst     int `yaml:"api_key_burst"`
+}
+
+// OpsConfig controls automatic rebooking when a flight is cancelled and the
+// handling of oversold flights.
+type OpsConfig struct {
+	RebookingWindowHours     int `yaml:"rebooking_window_hours"`
+	MaxRebookingAlternatives int `yaml:"max_rebooking_alternatives"`
+	OversoldReportHours      int `yaml:"oversold_report_hours"`
+	// Default compensation paid to passengers denied boarding.
+	VoluntaryCompensationCents   int64 `yaml:"voluntary_compensation_cents"`
+	InvoluntaryCompensationCents int64 `yaml:"involuntary_compensation_cents"`
 }
 
 type WorkerConfig struct {
 	ExpirationSweepMinutes int `yaml:"expiration_sweep_minutes"`
+	ScheduleSweepMinutes   int `yaml:"schedule_sweep_minutes"`
+	ScheduleHorizonDays    int `yaml:"schedule_horizon_days"`
 }
 
 func LoadConfig(path string) (*Config, error) {
@@ -73,6 +437,21 @@ func LoadConfig(path string) (*Config, error) {
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
+	if _, err := cfg.Booking.HoldPolicies(); err != nil {
+		return nil, fmt.Errorf("invalid booking config: %w", err)
+	}
+	if _, err := cfg.Booking.FareRules(); err != nil {
+		return nil, fmt.Errorf("invalid booking config: %w", err)
+	}
+	if _, err := cfg.Loyalty.Rules(); err != nil {
+		return nil, fmt.Errorf("invalid loyalty config: %w", err)
+	}
+	if _, err := cfg.Wallet.Rules(); err != nil {
+		return nil, fmt.Errorf("invalid wallet config: %w", err)
+	}
+	if _, err := cfg.Pricing.Rules(); err != nil {
+		return nil, fmt.Errorf("invalid pricing config: %w", err)
+	}
 
 	return &cfg, nil
 }
@@ -0,0 +1,77 @@
+package admin_exchange_rates_service_api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_exchange_rates_api"
+	"github.com/Domenick1991/airbooking/internal/service/exchangerates"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server implements the generated gRPC interface for exchange rates.
+type Server struct {
+	rates exchangerates.ExchangeRateUseCase
+	admin_exchange_rates_api.UnimplementedAdminExchangeRatesServiceServer
+}
+
+func NewServer(rates exchangerates.ExchangeRateUseCase) *Server {
+	return &Server{rates: rates}
+}
+
+func (s *Server) ListExchangeRates(ctx context.Context, _ *emptypb.Empty) (*admin_exchange_rates_api.ExchangeRates, error) {
+	list, err := s.rates.ListRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toPBExchangeRates(list), nil
+}
+
+func (s *Server) SetExchangeRates(ctx context.Context, req *admin_exchange_rates_api.ExchangeRates) (*admin_exchange_rates_api.ExchangeRates, error) {
+	rates := make([]domain.ExchangeRate, 0, len(req.GetRates()))
+	for i, r := range req.GetRates() {
+		rate, err := domain.ParseRate(r.GetRate())
+		if err != nil {
+			return nil, domain.NewValidationError(fmt.Sprintf("rates[%d].rate", i), err.Error())
+		}
+		rates = append(rates, domain.ExchangeRate{Base: r.GetBase(), Quote: r.GetQuote(), Rate: rate})
+	}
+	set, err := s.rates.SetRates(ctx, rates)
+	if err != nil {
+		return nil, err
+	}
+	return toPBExchangeRates(set), nil
+}
+
+func (s *Server) ImportExchangeRates(ctx context.Context, req *admin_exchange_rates_api.ImportExchangeRatesRequest) (*admin_exchange_rates_api.ExchangeRates, error) {
+	rates, err := exchangerates.ParseCSV(strings.NewReader(req.GetCsv()))
+	if err != nil {
+		return nil, err
+	}
+	set, err := s.rates.SetRates(ctx, rates)
+	if err != nil {
+		return nil, err
+	}
+	return toPBExchangeRates(set), nil
+}
+
+func toPBExchangeRates(rates []domain.ExchangeRate) *admin_exchange_rates_api.ExchangeRates {
+	resp := &admin_exchange_rates_api.ExchangeRates{
+		Rates: make([]*admin_exchange_rates_api.ExchangeRate, 0, len(rates)),
+	}
+	for _, r := range rates {
+		pb := &admin_exchange_rates_api.ExchangeRate{
+			Base:  r.Base,
+			Quote: r.Quote,
+			Rate:  domain.FormatRate(r.Rate),
+		}
+		if !r.UpdatedAt.IsZero() {
+			pb.UpdatedAt = r.UpdatedAt.UTC().Format(time.RFC3339)
+		}
+		resp.Rates = append(resp.Rates, pb)
+	}
+	return resp
+}
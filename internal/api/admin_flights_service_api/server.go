@@ -0,0 +1,75 @@
+package admin_flights_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/api/pbconv"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_flights_api"
+	"github.com/Domenick1991/airbooking/internal/pb/models"
+	"github.com/Domenick1991/airbooking/internal/service/flights"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server implements the generated gRPC interface for flight administration.
+type Server struct {
+	admin flights.FlightAdminUseCase
+	admin_flights_api.UnimplementedAdminFlightsServiceServer
+}
+
+func NewServer(admin flights.FlightAdminUseCase) *Server {
+	return &Server{admin: admin}
+}
+
+func (s *Server) CreateFlight(ctx context.Context, req *admin_flights_api.CreateFlightRequest) (*models.Flight, error) {
+	input, err := toFlightInput(req.GetFromAirport(), req.GetToAirport(), req.GetDepartureTime(), req.GetArrivalTime(), req.GetTotalSeats(), req.GetPriceCents(), req.GetCurrency(), req.GetOverbookingLimit())
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.admin.CreateFlight(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return pbconv.AdminFlight(created), nil
+}
+
+func (s *Server) UpdateFlight(ctx context.Context, req *admin_flights_api.UpdateFlightRequest) (*models.Flight, error) {
+	input, err := toFlightInput(req.GetFromAirport(), req.GetToAirport(), req.GetDepartureTime(), req.GetArrivalTime(), req.GetTotalSeats(), req.GetPriceCents(), req.GetCurrency(), req.GetOverbookingLimit())
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.admin.UpdateFlight(ctx, req.GetId(), input)
+	if err != nil {
+		return nil, err
+	}
+	return pbconv.AdminFlight(updated), nil
+}
+
+func (s *Server) DeleteFlight(ctx context.Context, req *admin_flights_api.DeleteFlightRequest) (*emptypb.Empty, error) {
+	if err := s.admin.DeleteFlight(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toFlightInput(from, to, departure, arrival string, totalSeats int32, priceCents int64, currency string, overbookingLimit int32) (flights.FlightInput, error) {
+	dep, err := time.Parse(time.RFC3339, departure)
+	if err != nil {
+		return flights.FlightInput{}, domain.NewValidationError("departure_time", "invalid departure_time: "+err.Error())
+	}
+	arr, err := time.Parse(time.RFC3339, arrival)
+	if err != nil {
+		return flights.FlightInput{}, domain.NewValidationError("arrival_time", "invalid arrival_time: "+err.Error())
+	}
+	return flights.FlightInput{
+		FromAirport:      from,
+		ToAirport:        to,
+		DepartureTime:    dep,
+		ArrivalTime:      arr,
+		TotalSeats:       int(totalSeats),
+		PriceCents:       priceCents,
+		Currency:         currency,
+		OverbookingLimit: int(overbookingLimit),
+	}, nil
+}
@@ -0,0 +1,152 @@
+package admin_promotions_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_promotions_api"
+	"github.com/Domenick1991/airbooking/internal/service/promotions"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server implements the generated gRPC interface for promo code campaigns.
+type Server struct {
+	promotions promotions.PromotionUseCase
+	admin_promotions_api.UnimplementedAdminPromotionsServiceServer
+}
+
+func NewServer(promotions promotions.PromotionUseCase) *Server {
+	return &Server{promotions: promotions}
+}
+
+func (s *Server) CreatePromotion(ctx context.Context, req *admin_promotions_api.Promotion) (*admin_promotions_api.Promotion, error) {
+	input, err := toPromotion(req)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.promotions.CreatePromotion(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return toPBPromotion(created), nil
+}
+
+func (s *Server) UpdatePromotion(ctx context.Context, req *admin_promotions_api.UpdatePromotionRequest) (*admin_promotions_api.Promotion, error) {
+	input, err := toPromotion(req.GetPromotion())
+	if err != nil {
+		return nil, err
+	}
+	input.ID = req.GetId()
+	updated, err := s.promotions.UpdatePromotion(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return toPBPromotion(updated), nil
+}
+
+func (s *Server) GetPromotion(ctx context.Context, req *admin_promotions_api.PromotionRequest) (*admin_promotions_api.Promotion, error) {
+	p, err := s.promotions.GetPromotion(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return toPBPromotion(p), nil
+}
+
+func (s *Server) ListPromotions(ctx context.Context, _ *emptypb.Empty) (*admin_promotions_api.ListPromotionsResponse, error) {
+	list, err := s.promotions.ListPromotions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin_promotions_api.ListPromotionsResponse{}
+	for i := range list {
+		resp.Promotions = append(resp.Promotions, toPBPromotion(&list[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetPromotionUsage(ctx context.Context, req *admin_promotions_api.PromotionRequest) (*admin_promotions_api.PromotionUsage, error) {
+	usage, err := s.promotions.GetUsage(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin_promotions_api.PromotionUsage{
+		Promotion:     toPBPromotion(&usage.Promotion),
+		Redemptions:   int32(usage.Redemptions),
+		Uses:          int32(usage.Uses),
+		Customers:     int32(usage.Customers),
+		DiscountCents: usage.DiscountCents,
+		ByStatus:      make(map[string]int32, len(usage.ByStatus)),
+	}
+	for status, count := range usage.ByStatus {
+		resp.ByStatus[string(status)] = int32(count)
+	}
+	return resp, nil
+}
+
+func toPromotion(p *admin_promotions_api.Promotion) (domain.Promotion, error) {
+	promotion := domain.Promotion{
+		Code:               p.GetCode(),
+		Description:        p.GetDescription(),
+		Type:               domain.DiscountType(p.GetType()),
+		PercentOff:         p.GetPercentOff(),
+		AmountOffCents:     p.GetAmountOffCents(),
+		Currency:           p.GetCurrency(),
+		FromAirport:        p.GetFromAirport(),
+		ToAirport:          p.GetToAirport(),
+		MaxUses:            int(p.GetMaxUses()),
+		MaxUsesPerCustomer: int(p.GetMaxUsesPerCustomer()),
+		Active:             p.GetActive(),
+	}
+	times := []struct {
+		field string
+		value string
+		dst   *time.Time
+	}{
+		{"valid_from", p.GetValidFrom(), &promotion.ValidFrom},
+		{"valid_until", p.GetValidUntil(), &promotion.ValidUntil},
+		{"travel_from", p.GetTravelFrom(), &promotion.TravelFrom},
+		{"travel_until", p.GetTravelUntil(), &promotion.TravelUntil},
+	}
+	for _, t := range times {
+		if t.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, t.value)
+		if err != nil {
+			return domain.Promotion{}, domain.NewValidationError(t.field, "invalid "+t.field+": "+err.Error())
+		}
+		*t.dst = parsed
+	}
+	return promotion, nil
+}
+
+func toPBPromotion(p *domain.Promotion) *admin_promotions_api.Promotion {
+	return &admin_promotions_api.Promotion{
+		Id:                 p.ID,
+		Code:               p.Code,
+		Description:        p.Description,
+		Type:               string(p.Type),
+		PercentOff:         p.PercentOff,
+		AmountOffCents:     p.AmountOffCents,
+		Currency:           p.Currency,
+		ValidFrom:          formatTime(p.ValidFrom),
+		ValidUntil:         formatTime(p.ValidUntil),
+		FromAirport:        p.FromAirport,
+		ToAirport:          p.ToAirport,
+		TravelFrom:         formatTime(p.TravelFrom),
+		TravelUntil:        formatTime(p.TravelUntil),
+		MaxUses:            int32(p.MaxUses),
+		MaxUsesPerCustomer: int32(p.MaxUsesPerCustomer),
+		Active:             p.Active,
+		CreatedAt:          formatTime(p.CreatedAt),
+		UpdatedAt:          formatTime(p.UpdatedAt),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -4,32 +4,55 @@ import (
 	"context"
 	"time"
 
+	"github.com/Domenick1991/airbooking/internal/api/pbconv"
+	"github.com/Domenick1991/airbooking/internal/auth"
 	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/pb/bookings_api"
 	"github.com/Domenick1991/airbooking/internal/pb/models"
 	"github.com/Domenick1991/airbooking/internal/service/booking"
+	"github.com/Domenick1991/airbooking/internal/service/flights"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // Server implements the generated gRPC interface for bookings.
 type Server struct {
 	bookings booking.BookingUseCase
+	flights  flights.FlightUseCase
 	bookings_api.UnimplementedBookingsServiceServer
 }
 
-func NewServer(bookings booking.BookingUseCase) *Server {
-	return &Server{bookings: bookings}
+func NewServer(bookings booking.BookingUseCase, flights flights.FlightUseCase) *Server {
+	return &Server{bookings: bookings, flights: flights}
 }
 
 func (s *Server) CreateBooking(ctx context.Context, req *bookings_api.CreateBookingRequest) (*models.Booking, error) {
-	created, err := s.bookings.CreateBooking(ctx, booking.CreateBookingInput{
+	input := booking.CreateBookingInput{
 		FlightID:   req.GetFlightId(),
 		SeatNumber: int(req.GetSeatNumber()),
 		Email:      req.GetEmail(),
-	})
+		Channel:    req.GetChannel(),
+		FareClass:  req.GetFareClass(),
+		PromoCode:  req.GetPromoCode(),
+	}
+	// Bookings of logged-in customers belong to their account, and are made
+	// for the account email unless another one is given. Bookings of
+	// partners belong to their API key.
+	if claims, ok := auth.FromContext(ctx); ok {
+		if id, ok := claims.CustomerID(); ok {
+			input.CustomerID = id
+			if input.Email == "" {
+				input.Email = claims.Email
+			}
+		}
+		if id, ok := claims.APIKeyID(); ok {
+			input.APIKeyID = id
+		}
+	}
+	created, err := s.bookings.CreateBooking(ctx, input)
 	if err != nil {
 		return nil, err
 	}
-	return toPBBooking(created), nil
+	return s.toPBBooking(ctx, created), nil
 }
 
 func (s *Server) ConfirmBooking(ctx context.Context, req *bookings_api.BookingTokenRequest) (*models.Booking, error) {
@@ -37,7 +60,7 @@ func (s *Server) ConfirmBooking(ctx context.Context, req *bookings_api.BookingTo
 	if err != nil {
 		return nil, err
 	}
-	return toPBBooking(booking), nil
+	return s.toPBBooking(ctx, booking), nil
 }
 
 func (s *Server) CancelBooking(ctx context.Context, req *bookings_api.BookingTokenRequest) (*models.Booking, error) {
@@ -45,35 +68,230 @@ func (s *Server) CancelBooking(ctx context.Context, req *bookings_api.BookingTok
 	if err != nil {
 		return nil, err
 	}
-	return toPBBooking(booking), nil
+	return s.toPBBooking(ctx, booking), nil
+}
+
+func (s *Server) ExtendHold(ctx context.Context, req *bookings_api.BookingTokenRequest) (*models.Booking, error) {
+	booking, err := s.bookings.ExtendHold(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return s.toPBBooking(ctx, booking), nil
+}
+
+func (s *Server) ReservePayment(ctx context.Context, req *bookings_api.ReservePaymentRequest) (*bookings_api.PaymentReservation, error) {
+	res, err := s.bookings.ReservePayment(ctx, booking.ReservePaymentInput{
+		Token:       req.GetToken(),
+		Miles:       req.GetMiles(),
+		CreditCents: req.GetCreditCents(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bookings_api.PaymentReservation{
+		Miles:           res.Miles,
+		MilesValueCents: res.MilesValueCents,
+		CreditCents:     res.CreditCents,
+		PriceCents:      res.PriceCents,
+		AmountDueCents:  res.AmountDueCents(),
+		Status:          string(res.Status),
+		Currency:        res.Currency,
+	}, nil
+}
+
+func (s *Server) ChangeSeat(ctx context.Context, req *bookings_api.ChangeSeatRequest) (*models.Booking, error) {
+	booking, err := s.bookings.ChangeSeat(ctx, req.GetToken(), int(req.GetSeatNumber()))
+	if err != nil {
+		return nil, err
+	}
+	return s.toPBBooking(ctx, booking), nil
+}
+
+func (s *Server) ChangeFlight(ctx context.Context, req *bookings_api.ChangeFlightRequest) (*bookings_api.ChangeFlightResponse, error) {
+	updated, change, err := s.bookings.ChangeFlight(ctx, booking.ChangeFlightInput{
+		Token:      req.GetToken(),
+		FlightID:   req.GetFlightId(),
+		SeatNumber: int(req.GetSeatNumber()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bookings_api.ChangeFlightResponse{
+		Booking:             s.toPBBooking(ctx, updated),
+		PreviousFareCents:   change.PreviousFareCents,
+		NewFareCents:        change.NewFareCents,
+		FareDifferenceCents: change.FareDifferenceCents,
+		ChangeFeeCents:      change.ChangeFeeCents,
+		AmountDueCents:      change.AmountDueCents(),
+	}, nil
+}
+
+func (s *Server) CheckIn(ctx context.Context, req *bookings_api.BookingTokenRequest) (*models.Booking, error) {
+	booking, err := s.bookings.CheckIn(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return s.toPBBooking(ctx, booking), nil
+}
+
+func (s *Server) GetBookingHistory(ctx context.Context, req *bookings_api.BookingTokenRequest) (*bookings_api.GetBookingHistoryResponse, error) {
+	history, err := s.bookings.GetBookingHistory(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	resp := &bookings_api.GetBookingHistoryResponse{Transitions: make([]*models.BookingTransition, 0, len(history))}
+	for _, t := range history {
+		resp.Transitions = append(resp.Transitions, &models.BookingTransition{
+			FromStatus: pbconv.BookingStatus(t.From),
+			ToStatus:   pbconv.BookingStatus(t.To),
+			Actor:      t.Actor,
+			Reason:     t.Reason,
+			RequestId:  t.RequestID,
+			CreatedAt:  t.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) JoinWaitlist(ctx context.Context, req *bookings_api.JoinWaitlistRequest) (*models.WaitlistEntry, error) {
+	input := booking.JoinWaitlistInput{
+		FlightID:  req.GetFlightId(),
+		Email:     req.GetEmail(),
+		FareClass: req.GetFareClass(),
+	}
+	// The loyalty tier of logged-in customers comes from their account;
+	// the one in the request is not trusted.
+	if claims, ok := auth.FromContext(ctx); ok {
+		if id, ok := claims.CustomerID(); ok {
+			input.CustomerID = id
+			if input.Email == "" {
+				input.Email = claims.Email
+			}
+		}
+	}
+	entry, err := s.bookings.JoinWaitlist(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return toPBWaitlistEntry(entry), nil
+}
+
+func (s *Server) ListMyBookings(ctx context.Context, req *bookings_api.ListMyBookingsRequest) (*bookings_api.ListMyBookingsResponse, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	bookings, next, err := s.bookings.ListCustomerBookings(ctx, customerID, booking.ListBookingsInput{
+		Statuses:  req.GetStatuses(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &bookings_api.ListMyBookingsResponse{Bookings: make([]*models.Booking, 0, len(bookings)), NextPageToken: next}
+	for i := range bookings {
+		resp.Bookings = append(resp.Bookings, s.toPBBooking(ctx, &bookings[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) OpenManageLink(ctx context.Context, req *bookings_api.OpenManageLinkRequest) (*bookings_api.ManageSession, error) {
+	session, err := s.bookings.OpenManageLink(ctx, domain.ManageLink{
+		BookingID: req.GetBookingId(),
+		ExpiresAt: time.Unix(req.GetExpires(), 0),
+		Signature: req.GetSignature(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bookings_api.ManageSession{
+		AccessToken: session.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresAt:   session.ExpiresAt.UTC().Format(time.RFC3339),
+		Booking:     s.toPBManagedBooking(ctx, session.Booking),
+	}, nil
+}
+
+func (s *Server) GetManagedBooking(ctx context.Context, _ *emptypb.Empty) (*models.Booking, error) {
+	b, err := s.managedBooking(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.toPBManagedBooking(ctx, b), nil
+}
+
+func (s *Server) ConfirmManagedBooking(ctx context.Context, _ *emptypb.Empty) (*models.Booking, error) {
+	b, err := s.managedBooking(ctx)
+	if err != nil {
+		return nil, err
+	}
+	confirmed, err := s.bookings.ConfirmBooking(ctx, b.Token)
+	if err != nil {
+		return nil, err
+	}
+	return s.toPBManagedBooking(ctx, confirmed), nil
+}
+
+func (s *Server) CancelManagedBooking(ctx context.Context, _ *emptypb.Empty) (*models.Booking, error) {
+	b, err := s.managedBooking(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cancelled, err := s.bookings.CancelBooking(ctx, b.Token)
+	if err != nil {
+		return nil, err
+	}
+	return s.toPBManagedBooking(ctx, cancelled), nil
+}
+
+// managedBooking returns the booking of the manage session of the caller.
+func (s *Server) managedBooking(ctx context.Context) (*domain.Booking, error) {
+	id, ok := auth.BookingSessionFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrBookingSessionRequired
+	}
+	return s.bookings.GetBookingByID(ctx, id)
+}
+
+// toPBManagedBooking is toPBBooking without the token: a manage session must
+// not outlive its link as the emailed token used to.
+func (s *Server) toPBManagedBooking(ctx context.Context, b *domain.Booking) *models.Booking {
+	pb := s.toPBBooking(ctx, b)
+	if pb != nil {
+		pb.Token = ""
+	}
+	return pb
+}
+
+// toPBBooking converts a booking and decorates it with the flight schedule
+// rendered in the airport time zones. Schedule lookup failures are not fatal.
+func (s *Server) toPBBooking(ctx context.Context, b *domain.Booking) *models.Booking {
+	pb := pbconv.Booking(b)
+	if pb == nil || s.flights == nil {
+		return pb
+	}
+	if f, err := s.flights.GetByID(ctx, b.FlightID); err == nil && f != nil {
+		pb.Departure = pbconv.LocalTime(f.DepartureTime, f.DepartureTimeZone)
+		pb.Arrival = pbconv.LocalTime(f.ArrivalTime, f.ArrivalTimeZone)
+	}
+	return pb
 }
 
-func toPBBooking(b *domain.Booking) *models.Booking {
-	if b == nil {
+func toPBWaitlistEntry(e *domain.WaitlistEntry) *models.WaitlistEntry {
+	if e == nil {
 		return nil
 	}
 
-	return &models.Booking{
-		Token:      b.Token,
-		Status:     toPBStatus(b.Status),
-		ExpiresAt:  b.ExpiresAt.Format(time.RFC3339),
-		FlightId:   b.FlightID,
-		SeatNumber: int32(b.SeatNumber),
-		Email:      b.Email,
-	}
-}
-
-func toPBStatus(status domain.BookingStatus) models.BookingStatus {
-	switch status {
-	case domain.BookingStatusPending:
-		return models.BookingStatus_BOOKING_STATUS_PENDING
-	case domain.BookingStatusConfirmed:
-		return models.BookingStatus_BOOKING_STATUS_CONFIRMED
-	case domain.BookingStatusCancelled:
-		return models.BookingStatus_BOOKING_STATUS_CANCELLED
-	case domain.BookingStatusExpired:
-		return models.BookingStatus_BOOKING_STATUS_EXPIRED
-	default:
-		return models.BookingStatus_BOOKING_STATUS_UNSPECIFIED
+	return &models.WaitlistEntry{
+		Id:          e.ID,
+		FlightId:    e.FlightID,
+		Email:       e.Email,
+		FareClass:   string(e.FareClass),
+		LoyaltyTier: string(e.LoyaltyTier),
+		Priority:    int32(e.Priority),
+		Status:      string(e.Status),
+		Position:    int32(e.Position),
+		CreatedAt:   e.CreatedAt.UTC().Format(time.RFC3339),
 	}
 }
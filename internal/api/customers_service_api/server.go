@@ -0,0 +1,94 @@
+package customers_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/customers_api"
+	"github.com/Domenick1991/airbooking/internal/service/customers"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server implements the generated gRPC interface for customer accounts.
+type Server struct {
+	customers customers.CustomerUseCase
+	customers_api.UnimplementedCustomersServiceServer
+}
+
+func NewServer(customers customers.CustomerUseCase) *Server {
+	return &Server{customers: customers}
+}
+
+func (s *Server) Register(ctx context.Context, req *customers_api.RegisterRequest) (*customers_api.Customer, error) {
+	customer, err := s.customers.Register(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return toPBCustomer(customer), nil
+}
+
+func (s *Server) ResendVerification(ctx context.Context, req *customers_api.ResendVerificationRequest) (*emptypb.Empty, error) {
+	if err := s.customers.ResendVerification(ctx, req.GetEmail()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) VerifyEmail(ctx context.Context, req *customers_api.VerifyEmailRequest) (*customers_api.VerifyEmailResponse, error) {
+	customer, claimed, err := s.customers.VerifyEmail(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &customers_api.VerifyEmailResponse{Customer: toPBCustomer(customer), ClaimedBookings: int32(claimed)}, nil
+}
+
+func (s *Server) Login(ctx context.Context, req *customers_api.LoginRequest) (*customers_api.LoginResponse, error) {
+	session, err := s.customers.Login(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return &customers_api.LoginResponse{
+		AccessToken: session.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresAt:   session.ExpiresAt.UTC().Format(time.RFC3339),
+		Customer:    toPBCustomer(session.Customer),
+	}, nil
+}
+
+func (s *Server) RequestBookingClaim(ctx context.Context, req *customers_api.RequestBookingClaimRequest) (*emptypb.Empty, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	if err := s.customers.RequestClaim(ctx, customerID, req.GetEmail()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ClaimBookings(ctx context.Context, req *customers_api.ClaimBookingsRequest) (*customers_api.ClaimBookingsResponse, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	claimed, err := s.customers.ClaimBookings(ctx, customerID, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &customers_api.ClaimBookingsResponse{ClaimedBookings: int32(claimed)}, nil
+}
+
+func toPBCustomer(c *domain.Customer) *customers_api.Customer {
+	if c == nil {
+		return nil
+	}
+
+	return &customers_api.Customer{
+		Id:            c.ID,
+		Email:         c.Email,
+		EmailVerified: c.Verified(),
+		CreatedAt:     c.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
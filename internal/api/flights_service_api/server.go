@@ -2,26 +2,33 @@ package flights_service_api
 
 import (
 	"context"
-	"time"
 
+	"github.com/Domenick1991/airbooking/internal/api/pbconv"
 	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/pb/flights_api"
 	"github.com/Domenick1991/airbooking/internal/pb/models"
+	"github.com/Domenick1991/airbooking/internal/service/availability"
+	"github.com/Domenick1991/airbooking/internal/service/exchangerates"
 	"github.com/Domenick1991/airbooking/internal/service/flights"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // Server implements the generated gRPC interface for flights.
 type Server struct {
-	flights flights.FlightUseCase
+	flights      flights.FlightUseCase
+	availability availability.AvailabilityUseCase
+	rates        exchangerates.ExchangeRateUseCase
 	flights_api.UnimplementedFlightsServiceServer
 }
 
-func NewServer(flights flights.FlightUseCase) *Server {
-	return &Server{flights: flights}
+func NewServer(flights flights.FlightUseCase, availability availability.AvailabilityUseCase, rates exchangerates.ExchangeRateUseCase) *Server {
+	return &Server{flights: flights, availability: availability, rates: rates}
 }
 
-func (s *Server) ListFlights(ctx context.Context, _ *emptypb.Empty) (*flights_api.ListFlightsResponse, error) {
+func (s *Server) ListFlights(ctx context.Context, req *flights_api.ListFlightsRequest) (*flights_api.ListFlightsResponse, error) {
+	display, err := s.displayPrice(ctx, req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
 	list, err := s.flights.List(ctx)
 	if err != nil {
 		return nil, err
@@ -30,31 +37,71 @@ func (s *Server) ListFlights(ctx context.Context, _ *emptypb.Empty) (*flights_ap
 		Flights: make([]*models.Flight, 0, len(list)),
 	}
 	for _, f := range list {
-		resp.Flights = append(resp.Flights, toPBFlight(&f))
+		pb := pbconv.Flight(&f)
+		if pb.DisplayPrice, err = display(f); err != nil {
+			return nil, err
+		}
+		resp.Flights = append(resp.Flights, pb)
 	}
 	return resp, nil
 }
 
 func (s *Server) GetFlight(ctx context.Context, req *flights_api.GetFlightRequest) (*flights_api.GetFlightResponse, error) {
+	display, err := s.displayPrice(ctx, req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	flight, err := s.flights.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	pb := pbconv.Flight(flight)
+	if pb.DisplayPrice, err = display(*flight); err != nil {
+		return nil, err
+	}
+	return &flights_api.GetFlightResponse{Flight: pb}, nil
+}
+
+// displayPrice returns the converter of flight prices to the display
+// currency asked for, which leaves display_price unset when none was.
+func (s *Server) displayPrice(ctx context.Context, currency string) (func(domain.Flight) (*models.Money, error), error) {
+	if currency == "" {
+		return func(domain.Flight) (*models.Money, error) { return nil, nil }, nil
+	}
+	code, err := domain.ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := s.rates.Rates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(f domain.Flight) (*models.Money, error) {
+		price, err := rates.Convert(f.Price(), code)
+		if err != nil {
+			return nil, err
+		}
+		return pbconv.Money(price), nil
+	}, nil
+}
+
+func (s *Server) GetFlightStatus(ctx context.Context, req *flights_api.GetFlightStatusRequest) (*models.FlightStatus, error) {
 	flight, err := s.flights.GetByID(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
-	return &flights_api.GetFlightResponse{Flight: toPBFlight(flight)}, nil
+	return pbconv.FlightStatus(flight), nil
 }
 
-func toPBFlight(f *domain.Flight) *models.Flight {
-	if f == nil {
-		return nil
+func (s *Server) WatchFlightAvailability(req *flights_api.WatchFlightAvailabilityRequest, stream flights_api.FlightsService_WatchFlightAvailabilityServer) error {
+	updates, err := s.availability.Watch(stream.Context(), req.GetId())
+	if err != nil {
+		return err
 	}
-	return &models.Flight{
-		Id:             f.ID,
-		FromAirport:    f.FromAirport,
-		ToAirport:      f.ToAirport,
-		DepartureTime:  f.DepartureTime.Format(time.RFC3339),
-		ArrivalTime:    f.ArrivalTime.Format(time.RFC3339),
-		TotalSeats:     int32(f.TotalSeats),
-		AvailableSeats: int32(f.AvailableSeats),
-		PriceCents:     f.PriceCents,
+	for u := range updates {
+		if err := stream.Send(pbconv.FlightAvailability(u)); err != nil {
+			return err
+		}
 	}
+	return nil
 }
@@ -0,0 +1,94 @@
+package loyalty_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/loyalty_api"
+	"github.com/Domenick1991/airbooking/internal/service/loyalty"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server implements the generated gRPC interface for the loyalty program.
+type Server struct {
+	loyalty loyalty.LoyaltyUseCase
+	loyalty_api.UnimplementedLoyaltyServiceServer
+}
+
+func NewServer(loyalty loyalty.LoyaltyUseCase) *Server {
+	return &Server{loyalty: loyalty}
+}
+
+func (s *Server) Enroll(ctx context.Context, _ *emptypb.Empty) (*loyalty_api.LoyaltyAccount, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	account, err := s.loyalty.Enroll(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	return toPBAccount(account), nil
+}
+
+func (s *Server) GetLoyaltyAccount(ctx context.Context, _ *emptypb.Empty) (*loyalty_api.LoyaltyAccount, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	account, err := s.loyalty.GetAccount(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	return toPBAccount(account), nil
+}
+
+func (s *Server) ListLoyaltyHistory(ctx context.Context, req *loyalty_api.ListLoyaltyHistoryRequest) (*loyalty_api.ListLoyaltyHistoryResponse, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	entries, next, err := s.loyalty.ListHistory(ctx, customerID, loyalty.ListHistoryInput{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &loyalty_api.ListLoyaltyHistoryResponse{NextPageToken: next}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &loyalty_api.LedgerEntry{
+			Id:              e.ID,
+			Kind:            string(e.Kind),
+			Miles:           e.Miles,
+			QualifyingMiles: e.QualifyingMiles,
+			BookingId:       e.BookingID,
+			Description:     e.Description,
+			CreatedAt:       e.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func toPBAccount(a *loyalty.Account) *loyalty_api.LoyaltyAccount {
+	if a == nil {
+		return nil
+	}
+
+	pb := &loyalty_api.LoyaltyAccount{
+		MemberId:        a.Member.ID,
+		CustomerId:      a.Member.CustomerID,
+		Tier:            string(a.Tier),
+		Balance:         a.Balance,
+		QualifyingMiles: a.QualifyingMiles,
+		NextTier:        string(a.NextTier),
+		MilesToNextTier: a.MilesToNextTier,
+		MemberSince:     a.Member.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if a.Tier != domain.LoyaltyTierNone {
+		pb.TierExpiresAt = a.Member.TierExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return pb
+}
@@ -0,0 +1,158 @@
+package ops_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/api/pbconv"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/models"
+	"github.com/Domenick1991/airbooking/internal/pb/ops_api"
+	"github.com/Domenick1991/airbooking/internal/service/operations"
+)
+
+// Server implements the generated gRPC interface for airline operations.
+type Server struct {
+	ops operations.OperationsUseCase
+	ops_api.UnimplementedOpsServiceServer
+}
+
+func NewServer(ops operations.OperationsUseCase) *Server {
+	return &Server{ops: ops}
+}
+
+func (s *Server) CancelFlight(ctx context.Context, req *ops_api.CancelFlightRequest) (*ops_api.CancelFlightResponse, error) {
+	result, err := s.ops.CancelFlight(ctx, operations.CancelFlightInput{
+		FlightID:  req.GetId(),
+		Reason:    req.GetReason(),
+		OfferOnly: req.GetOfferOnly(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ops_api.CancelFlightResponse{Flight: pbconv.AdminFlight(result.Flight)}
+	for _, r := range result.Rebookings {
+		rebooking := &ops_api.Rebooking{
+			Token:              r.Booking.Token,
+			Outcome:            string(r.Outcome),
+			PreviousFlightId:   r.PreviousFlightID,
+			PreviousSeatNumber: int32(r.PreviousSeatNumber),
+			FlightId:           r.Booking.FlightID,
+			SeatNumber:         int32(r.Booking.SeatNumber),
+		}
+		for i := range r.Alternatives {
+			rebooking.Alternatives = append(rebooking.Alternatives, pbconv.Flight(&r.Alternatives[i]))
+		}
+		resp.Rebookings = append(resp.Rebookings, rebooking)
+	}
+	for _, b := range result.CancelledPending {
+		resp.CancelledPendingTokens = append(resp.CancelledPendingTokens, b.Token)
+	}
+	return resp, nil
+}
+
+func (s *Server) UpdateFlightStatus(ctx context.Context, req *ops_api.UpdateFlightStatusRequest) (*ops_api.UpdateFlightStatusResponse, error) {
+	estimated, err := parseOptionalTime("estimated_departure_time", req.GetEstimatedDepartureTime())
+	if err != nil {
+		return nil, err
+	}
+	reported, err := parseOptionalTime("reported_at", req.GetReportedAt())
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.ops.UpdateFlightStatus(ctx, domain.FlightStatusUpdate{
+		FlightID:           req.GetId(),
+		Status:             domain.FlightStatus(req.GetStatus()),
+		EstimatedDeparture: estimated,
+		Gate:               req.GetGate(),
+		Terminal:           req.GetTerminal(),
+		DivertedTo:         req.GetDivertedTo(),
+		ReportedAt:         reported,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ops_api.UpdateFlightStatusResponse{
+		Status:             pbconv.FlightStatus(result.Flight),
+		Changes:            result.Change.Changes,
+		NotifiedPassengers: int32(result.Notified),
+	}, nil
+}
+
+func (s *Server) ListOversoldFlights(ctx context.Context, req *ops_api.ListOversoldFlightsRequest) (*ops_api.ListOversoldFlightsResponse, error) {
+	flights, err := s.ops.OversoldFlights(ctx, time.Duration(req.GetWithinHours())*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ops_api.ListOversoldFlightsResponse{}
+	for i := range flights {
+		resp.Flights = append(resp.Flights, pbconv.AdminFlight(&flights[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) DenyBoarding(ctx context.Context, req *ops_api.DenyBoardingRequest) (*ops_api.DenyBoardingResponse, error) {
+	result, err := s.ops.DenyBoarding(ctx, operations.DenyBoardingInput{
+		FlightID:                   req.GetId(),
+		VolunteerTokens:            req.GetVolunteerTokens(),
+		VoluntaryCompensationCents: req.GetVoluntaryCompensationCents(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ops_api.DenyBoardingResponse{
+		Flight: pbconv.AdminFlight(result.Flight),
+		Denied: toPBDeniedBoardings(result.Denied),
+	}, nil
+}
+
+func (s *Server) ListDeniedBoardings(ctx context.Context, req *ops_api.ListDeniedBoardingsRequest) (*ops_api.ListDeniedBoardingsResponse, error) {
+	denied, err := s.ops.ListDeniedBoardings(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &ops_api.ListDeniedBoardingsResponse{Denied: toPBDeniedBoardings(denied)}, nil
+}
+
+func (s *Server) SetBookingStatus(ctx context.Context, req *ops_api.SetBookingStatusRequest) (*models.Booking, error) {
+	status, err := domain.ParseBookingStatus(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.ops.SetBookingStatus(ctx, operations.SetBookingStatusInput{
+		Token:  req.GetToken(),
+		Status: status,
+		Reason: req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbconv.Booking(updated), nil
+}
+
+func toPBDeniedBoardings(denied []domain.DeniedBoarding) []*ops_api.DeniedBoarding {
+	out := make([]*ops_api.DeniedBoarding, 0, len(denied))
+	for _, d := range denied {
+		out = append(out, &ops_api.DeniedBoarding{
+			Token:             d.Token,
+			Email:             d.Email,
+			SeatNumber:        int32(d.SeatNumber),
+			Kind:              string(d.Kind),
+			CompensationCents: d.CompensationCents,
+			CreatedAt:         d.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return out
+}
+
+func parseOptionalTime(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, domain.NewValidationError(field, "invalid "+field+": "+err.Error())
+	}
+	return t, nil
+}
@@ -0,0 +1,20 @@
+package pbconv
+
+import (
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/pb/flights_api"
+	"github.com/Domenick1991/airbooking/internal/service/availability"
+)
+
+func FlightAvailability(u availability.Update) *flights_api.FlightAvailability {
+	return &flights_api.FlightAvailability{
+		FlightId:       u.FlightID,
+		TotalSeats:     int32(u.TotalSeats),
+		AvailableSeats: int32(max(u.AvailableSeats, 0)),
+		Event:          u.Event,
+		SeatNumber:     int32(u.SeatNumber),
+		SeatState:      string(u.SeatState),
+		At:             u.At.UTC().Format(time.RFC3339),
+	}
+}
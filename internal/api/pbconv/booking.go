@@ -0,0 +1,73 @@
+package pbconv
+
+import (
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/models"
+)
+
+func Booking(b *domain.Booking) *models.Booking {
+	if b == nil {
+		return nil
+	}
+
+	return &models.Booking{
+		Token:          b.Token,
+		Status:         BookingStatus(b.Status),
+		ExpiresAt:      b.ExpiresAt.UTC().Format(time.RFC3339),
+		FlightId:       b.FlightID,
+		SeatNumber:     int32(b.SeatNumber),
+		Email:          b.Email,
+		Channel:        string(b.Channel),
+		FareClass:      string(b.FareClass),
+		HoldExtensions: int32(b.HoldExtensions),
+		PriceCents:     b.PriceCents,
+		PromoCode:      b.PromoCode,
+		DiscountCents:  b.DiscountCents,
+		Fare:           FareBreakdown(b.Fare()),
+		Currency:       b.Currency,
+	}
+}
+
+func FareBreakdown(f domain.FareBreakdown) *models.FareBreakdown {
+	taxes := make([]*models.TaxCharge, 0, len(f.Taxes))
+	for _, t := range f.Taxes {
+		taxes = append(taxes, &models.TaxCharge{Code: t.Code, Airport: t.Airport, AmountCents: t.AmountCents})
+	}
+	return &models.FareBreakdown{
+		BaseFareCents:      f.BaseFareCents,
+		DiscountCents:      f.DiscountCents,
+		Taxes:              taxes,
+		TaxCents:           f.TaxCents(),
+		FuelSurchargeCents: f.FuelSurchargeCents,
+		BookingFeeCents:    f.BookingFeeCents,
+		SeatFeeCents:       f.SeatFeeCents,
+		TotalCents:         f.TotalCents,
+	}
+}
+
+func BookingStatus(status domain.BookingStatus) models.BookingStatus {
+	switch status {
+	case domain.BookingStatusPending:
+		return models.BookingStatus_BOOKING_STATUS_PENDING
+	case domain.BookingStatusConfirmed:
+		return models.BookingStatus_BOOKING_STATUS_CONFIRMED
+	case domain.BookingStatusCancelled:
+		return models.BookingStatus_BOOKING_STATUS_CANCELLED
+	case domain.BookingStatusExpired:
+		return models.BookingStatus_BOOKING_STATUS_EXPIRED
+	case domain.BookingStatusDeniedBoarding:
+		return models.BookingStatus_BOOKING_STATUS_DENIED_BOARDING
+	case domain.BookingStatusCheckedIn:
+		return models.BookingStatus_BOOKING_STATUS_CHECKED_IN
+	case domain.BookingStatusFlown:
+		return models.BookingStatus_BOOKING_STATUS_FLOWN
+	case domain.BookingStatusNoShow:
+		return models.BookingStatus_BOOKING_STATUS_NO_SHOW
+	case domain.BookingStatusRefunded:
+		return models.BookingStatus_BOOKING_STATUS_REFUNDED
+	default:
+		return models.BookingStatus_BOOKING_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,73 @@
+package pbconv
+
+import (
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/models"
+)
+
+func Flight(f *domain.Flight) *models.Flight {
+	if f == nil {
+		return nil
+	}
+	return &models.Flight{
+		Id:             f.ID,
+		FlightNumber:   f.FlightNumber,
+		FromAirport:    f.FromAirport,
+		ToAirport:      f.ToAirport,
+		DepartureTime:  f.DepartureTime.UTC().Format(time.RFC3339),
+		ArrivalTime:    f.ArrivalTime.UTC().Format(time.RFC3339),
+		TotalSeats:     int32(f.TotalSeats),
+		AvailableSeats: int32(max(f.AvailableSeats, 0)),
+		PriceCents:     f.PriceCents,
+		Departure:      LocalTime(f.DepartureTime, f.DepartureTimeZone),
+		Arrival:        LocalTime(f.ArrivalTime, f.ArrivalTimeZone),
+		Status:         string(f.Status),
+		Currency:       f.Currency,
+	}
+}
+
+func Money(m domain.Money) *models.Money {
+	return &models.Money{
+		Currency:    m.Currency,
+		AmountCents: m.Cents,
+		Amount:      m.Amount(),
+	}
+}
+
+// AdminFlight is Flight with the overbooking figures that are kept out of
+// public responses.
+func AdminFlight(f *domain.Flight) *models.Flight {
+	pb := Flight(f)
+	if pb == nil {
+		return nil
+	}
+	pb.OverbookingLimit = int32(f.OverbookingLimit)
+	pb.OversoldSeats = int32(f.Oversold())
+	return pb
+}
+
+func FlightStatus(f *domain.Flight) *models.FlightStatus {
+	if f == nil {
+		return nil
+	}
+	status := &models.FlightStatus{
+		FlightId:           f.ID,
+		FlightNumber:       f.FlightNumber,
+		Status:             string(f.Status),
+		ScheduledDeparture: LocalTime(f.DepartureTime, f.DepartureTimeZone),
+		EstimatedDeparture: LocalTime(f.EstimatedDeparture, f.DepartureTimeZone),
+		ActualDeparture:    LocalTime(f.ActualDeparture, f.DepartureTimeZone),
+		ScheduledArrival:   LocalTime(f.ArrivalTime, f.ArrivalTimeZone),
+		ActualArrival:      LocalTime(f.ActualArrival, f.ArrivalTimeZone),
+		DelayMinutes:       int32(f.DepartureDelay() / time.Minute),
+		Gate:               f.Gate,
+		Terminal:           f.Terminal,
+		DivertedTo:         f.DivertedTo,
+	}
+	if !f.StatusUpdatedAt.IsZero() {
+		status.UpdatedAt = f.StatusUpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return status
+}
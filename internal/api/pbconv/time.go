@@ -0,0 +1,24 @@
+package pbconv
+
+import (
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/models"
+)
+
+// LocalTime renders t in UTC and in the given IANA airport time zone.
+func LocalTime(t time.Time, zone string) *models.LocalTime {
+	if t.IsZero() {
+		return nil
+	}
+	local := domain.InTimeZone(t, zone)
+	name := local.Location().String()
+	return &models.LocalTime{
+		Utc:              t.UTC().Format(time.RFC3339),
+		Local:            local.Format(time.RFC3339),
+		UtcOffset:        local.Format("-07:00"),
+		TimeZone:         name,
+		ZoneAbbreviation: local.Format("MST"),
+	}
+}
@@ -0,0 +1,67 @@
+package wallet_service_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/wallet_api"
+	"github.com/Domenick1991/airbooking/internal/service/wallet"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Server implements the generated gRPC interface for customer wallets.
+type Server struct {
+	wallet wallet.WalletUseCase
+	wallet_api.UnimplementedWalletServiceServer
+}
+
+func NewServer(wallet wallet.WalletUseCase) *Server {
+	return &Server{wallet: wallet}
+}
+
+func (s *Server) GetWallet(ctx context.Context, _ *emptypb.Empty) (*wallet_api.Wallet, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	b, err := s.wallet.GetBalance(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	return &wallet_api.Wallet{
+		CreditCents:          b.CreditCents,
+		ReservedCreditCents:  b.ReservedCreditCents,
+		AvailableCreditCents: b.AvailableCreditCents(),
+		Miles:                b.Miles,
+		ReservedMiles:        b.ReservedMiles,
+		AvailableMiles:       b.AvailableMiles(),
+	}, nil
+}
+
+func (s *Server) ListWalletHistory(ctx context.Context, req *wallet_api.ListWalletHistoryRequest) (*wallet_api.ListWalletHistoryResponse, error) {
+	customerID, ok := auth.CustomerFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrCustomerRequired
+	}
+	entries, next, err := s.wallet.ListHistory(ctx, customerID, wallet.ListHistoryInput{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &wallet_api.ListWalletHistoryResponse{NextPageToken: next}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &wallet_api.WalletEntry{
+			Id:          e.ID,
+			Kind:        string(e.Kind),
+			AmountCents: e.AmountCents,
+			BookingId:   e.BookingID,
+			Description: e.Description,
+			CreatedAt:   e.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
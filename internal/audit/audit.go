@@ -0,0 +1,46 @@
+// Package audit carries who changed a booking, and why, through the context
+// down to the repositories that record the booking status history.
+package audit
+
+import "context"
+
+// ActorSystem is recorded when no actor was put in the context.
+const ActorSystem = "system"
+
+// Info describes the origin of a change.
+type Info struct {
+	Actor     string
+	Reason    string
+	RequestID string
+}
+
+type contextKey struct{}
+
+// FromContext returns the audit info of ctx. Actor defaults to ActorSystem.
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	if info.Actor == "" {
+		info.Actor = ActorSystem
+	}
+	return info
+}
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	info.Actor = actor
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// WithReason sets the reason of the changes made with ctx, replacing any
+// reason set by a caller.
+func WithReason(ctx context.Context, reason string) context.Context {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	info.Reason = reason
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	info, _ := ctx.Value(contextKey{}).(Info)
+	info.RequestID = requestID
+	return context.WithValue(ctx, contextKey{}, info)
+}
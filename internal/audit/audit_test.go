@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, Info{Actor: ActorSystem}, FromContext(ctx))
+
+	ctx = WithRequestID(WithActor(ctx, "admin"), "req-1")
+	ctx = WithReason(ctx, "flight cancelled")
+	assert.Equal(t, Info{Actor: "admin", Reason: "flight cancelled", RequestID: "req-1"}, FromContext(ctx))
+}
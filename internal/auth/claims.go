@@ -0,0 +1,52 @@
+// Package auth verifies the JWTs of API callers and carries their claims
+// through the context.
+package auth
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the claims of an authenticated caller. The subject is the
+// customer or staff member id, or the booking of a manage-booking session.
+type Claims struct {
+	Email string   `json:"email,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+	// Scopes are permissions granted directly, as to API keys.
+	Scopes []string `json:"scopes,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type contextKey struct{}
+
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, contextKey{}, claims)
+}
+
+// FromContext returns the claims of the caller, or false for an anonymous
+// call.
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(contextKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
+
+// CustomerFromContext returns the customer account of the caller, or false
+// when the caller is anonymous or not a customer account.
+func CustomerFromContext(ctx context.Context) (int64, bool) {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	return claims.CustomerID()
+}
+
+// BookingSessionFromContext returns the booking of a manage-booking session,
+// or false when the caller is anonymous or has another kind of token.
+func BookingSessionFromContext(ctx context.Context) (int64, bool) {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	return claims.BookingID()
+}
@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Subject prefixes of the tokens issued to customer accounts and to
+// manage-booking sessions, and of the claims of API keys.
+const (
+	customerSubjectPrefix = "customer:"
+	bookingSubjectPrefix  = "booking:"
+	apiKeySubjectPrefix   = "api-key:"
+)
+
+// CustomerSubject is the subject of the tokens of customer id.
+func CustomerSubject(id int64) string {
+	return customerSubjectPrefix + strconv.FormatInt(id, 10)
+}
+
+// CustomerID returns the customer account of the caller, or false when the
+// token was not issued to a customer account.
+func (c *Claims) CustomerID() (int64, bool) {
+	return subjectID(c.Subject, customerSubjectPrefix)
+}
+
+// BookingSubject is the subject of the manage-booking sessions of booking
+// id.
+func BookingSubject(id int64) string {
+	return bookingSubjectPrefix + strconv.FormatInt(id, 10)
+}
+
+// BookingID returns the booking of a manage-booking session, or false when
+// the token was not issued for a manage link.
+func (c *Claims) BookingID() (int64, bool) {
+	return subjectID(c.Subject, bookingSubjectPrefix)
+}
+
+// APIKeySubject is the subject of the claims of API key id.
+func APIKeySubject(id int64) string {
+	return apiKeySubjectPrefix + strconv.FormatInt(id, 10)
+}
+
+// APIKeyID returns the API key of the caller, or false when the caller did
+// not authenticate with an API key.
+func (c *Claims) APIKeyID() (int64, bool) {
+	return subjectID(c.Subject, apiKeySubjectPrefix)
+}
+
+func subjectID(subject, prefix string) (int64, bool) {
+	if !strings.HasPrefix(subject, prefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(subject, prefix), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// Issuer signs the access tokens of customers who log in with a password
+// and of manage-booking sessions.
+// It signs with an RSA key when one is set and with the HS256 secret
+// otherwise; the Verifier must accept the same key.
+type Issuer struct {
+	issuer   string
+	audience string
+	ttl      time.Duration
+	secret   []byte
+	key      *rsa.PrivateKey
+	keyID    string
+	now      func() time.Time
+}
+
+type IssuerOption func(*Issuer)
+
+func SignWithHS256(secret []byte) IssuerOption {
+	return func(i *Issuer) {
+		i.secret = secret
+	}
+}
+
+// SignWithRS256 signs with key, naming it keyID in the kid header.
+func SignWithRS256(keyID string, key *rsa.PrivateKey) IssuerOption {
+	return func(i *Issuer) {
+		i.keyID = keyID
+		i.key = key
+	}
+}
+
+// NewIssuer returns an Issuer of tokens valid for ttl, with the iss and aud
+// claims the Verifier checks.
+func NewIssuer(issuer, audience string, ttl time.Duration, opts ...IssuerOption) *Issuer {
+	i := &Issuer{issuer: issuer, audience: audience, ttl: ttl, now: time.Now}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// Enabled reports whether a signing key is configured.
+func (i *Issuer) Enabled() bool {
+	return i != nil && (i.key != nil || len(i.secret) > 0)
+}
+
+// IssueCustomerToken signs a customer token for the account id with email.
+func (i *Issuer) IssueCustomerToken(id int64, email string) (string, time.Time, error) {
+	return i.issue(&Claims{
+		Email:            email,
+		Roles:            []string{string(RoleCustomer)},
+		RegisteredClaims: jwt.RegisteredClaims{Subject: CustomerSubject(id)},
+	}, i.ttl)
+}
+
+// IssueBookingToken signs a manage-booking session for the booking id,
+// valid for ttl. The session has no roles or email: it reaches that booking
+// only.
+func (i *Issuer) IssueBookingToken(id int64, ttl time.Duration) (string, time.Time, error) {
+	return i.issue(&Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: BookingSubject(id)}}, ttl)
+}
+
+// issue fills the registered claims other than the subject and signs claims.
+func (i *Issuer) issue(claims *Claims, ttl time.Duration) (string, time.Time, error) {
+	if !i.Enabled() {
+		return "", time.Time{}, errors.New("no signing key configured")
+	}
+	now := i.now()
+	expiresAt := now.Add(ttl)
+	claims.Issuer = i.issuer
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(expiresAt)
+	if i.audience != "" {
+		claims.Audience = jwt.ClaimStrings{i.audience}
+	}
+
+	var (
+		signed string
+		err    error
+	)
+	if i.key != nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		if i.keyID != "" {
+			token.Header["kid"] = i.keyID
+		}
+		signed, err = token.SignedString(i.key)
+	} else {
+		signed, err = jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+	}
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// LoadRSAPrivateKey reads a PEM encoded PKCS#1 or PKCS#8 RSA private key.
+func LoadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read signing key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("signing key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse signing key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not an RSA key")
+	}
+	return key, nil
+}
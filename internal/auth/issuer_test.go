@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuerHS256(t *testing.T) {
+	secret := []byte("s3cret")
+	issuer := NewIssuer("airbooking", "airbooking-api", time.Hour, SignWithHS256(secret))
+	require.True(t, issuer.Enabled())
+
+	token, expiresAt, err := issuer.IssueCustomerToken(42, "me@example.com")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	claims, err := NewVerifier(WithHS256Secret(secret), WithIssuer("airbooking"), WithAudience("airbooking-api")).Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, "me@example.com", claims.Email)
+	assert.True(t, claims.HasRole(RoleCustomer))
+	id, ok := claims.CustomerID()
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), id)
+}
+
+func TestIssuerRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "signing.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0o600))
+	loaded, err := LoadRSAPrivateKey(path)
+	require.NoError(t, err)
+
+	token, _, err := NewIssuer("airbooking", "", time.Hour, SignWithRS256("k1", loaded)).IssueCustomerToken(7, "me@example.com")
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &Claims{})
+	require.NoError(t, err)
+	assert.Equal(t, "k1", parsed.Header["kid"])
+
+	_, err = NewVerifier(WithRSAKeys(map[string]*rsa.PublicKey{"k1": &key.PublicKey})).Verify(token)
+	assert.NoError(t, err)
+}
+
+func TestIssuerDisabled(t *testing.T) {
+	issuer := NewIssuer("airbooking", "airbooking-api", time.Hour)
+	assert.False(t, issuer.Enabled())
+	_, _, err := issuer.IssueCustomerToken(1, "me@example.com")
+	assert.Error(t, err)
+}
+
+func TestCustomerID(t *testing.T) {
+	for subject, want := range map[string]int64{
+		"customer:12":  12,
+		"customer:0":   0,
+		"customer:x":   0,
+		"api-key:12":   0,
+		"12":           0,
+		"customer:-12": 0,
+	} {
+		claims := &Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: subject}}
+		id, ok := claims.CustomerID()
+		assert.Equal(t, want, id, subject)
+		assert.Equal(t, want != 0, ok, subject)
+	}
+
+	id, ok := (&Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: APIKeySubject(12)}}).APIKeyID()
+	assert.True(t, ok)
+	assert.Equal(t, int64(12), id)
+
+	_, ok = CustomerFromContext(context.Background())
+	assert.False(t, ok)
+	id, ok = CustomerFromContext(WithClaims(context.Background(), &Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: CustomerSubject(3)}}))
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), id)
+}
+
+func TestIssueBookingToken(t *testing.T) {
+	secret := []byte("s3cret")
+	token, expiresAt, err := NewIssuer("airbooking", "airbooking-api", time.Hour, SignWithHS256(secret)).IssueBookingToken(5, 15*time.Minute)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), expiresAt, time.Minute)
+
+	claims, err := NewVerifier(WithHS256Secret(secret), WithIssuer("airbooking"), WithAudience("airbooking-api")).Verify(token)
+	require.NoError(t, err)
+	id, ok := claims.BookingID()
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), id)
+	_, ok = claims.CustomerID()
+	assert.False(t, ok)
+	assert.Empty(t, claims.Email)
+	assert.Empty(t, claims.Roles)
+
+	id, ok = BookingSessionFromContext(WithClaims(context.Background(), claims))
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), id)
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+)
+
+// LinkSigner signs the manage-booking links emailed to passengers with an
+// HMAC-SHA256 secret, and checks them when they are opened.
+type LinkSigner struct {
+	secret  []byte
+	baseURL string
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// NewLinkSigner returns a LinkSigner of links to baseURL valid for ttl.
+func NewLinkSigner(secret []byte, baseURL string, ttl time.Duration) *LinkSigner {
+	return &LinkSigner{secret: secret, baseURL: baseURL, ttl: ttl, now: time.Now}
+}
+
+// Enabled reports whether a secret is configured.
+func (s *LinkSigner) Enabled() bool {
+	return s != nil && len(s.secret) > 0
+}
+
+// Sign returns the manage link of the booking with id and token: the base
+// URL with the booking, expires and sig query parameters.
+func (s *LinkSigner) Sign(bookingID int64, token string) (string, domain.ManageLink, error) {
+	if !s.Enabled() {
+		return "", domain.ManageLink{}, errors.New("no link secret configured")
+	}
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return "", domain.ManageLink{}, fmt.Errorf("parse manage link base url: %w", err)
+	}
+	link := domain.ManageLink{
+		BookingID: bookingID,
+		ExpiresAt: s.now().Add(s.ttl).Truncate(time.Second),
+	}
+	link.Signature = s.signature(link, token)
+
+	q := u.Query()
+	q.Set("booking", strconv.FormatInt(link.BookingID, 10))
+	q.Set("expires", strconv.FormatInt(link.ExpiresAt.Unix(), 10))
+	q.Set("sig", link.Signature)
+	u.RawQuery = q.Encode()
+	return u.String(), link, nil
+}
+
+// Verify checks that link was signed for the booking with token and has not
+// expired.
+func (s *LinkSigner) Verify(link domain.ManageLink, token string) error {
+	if !s.Enabled() || link.BookingID <= 0 || link.Signature == "" {
+		return domain.ErrManageLinkInvalid
+	}
+	if !s.now().Before(link.ExpiresAt) {
+		return domain.ErrManageLinkInvalid
+	}
+	if !hmac.Equal([]byte(link.Signature), []byte(s.signature(link, token))) {
+		return domain.ErrManageLinkInvalid
+	}
+	return nil
+}
+
+func (s *LinkSigner) signature(link domain.ManageLink, token string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "manage-booking\n%d\n%d\n%s", link.BookingID, link.ExpiresAt.Unix(), token)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkSigner(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	signer := NewLinkSigner([]byte("s3cret"), "https://example.com/manage?lang=en", time.Hour)
+	signer.now = func() time.Time { return now }
+
+	raw, link, err := signer.Sign(5, "token-5")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour), link.ExpiresAt)
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, "en", q.Get("lang"))
+	assert.Equal(t, "5", q.Get("booking"))
+	assert.Equal(t, strconv.FormatInt(link.ExpiresAt.Unix(), 10), q.Get("expires"))
+	assert.Equal(t, link.Signature, q.Get("sig"))
+	assert.NotContains(t, raw, "token-5")
+
+	assert.NoError(t, signer.Verify(link, "token-5"))
+
+	forged := link
+	forged.BookingID = 6
+	assert.ErrorIs(t, signer.Verify(forged, "token-5"), domain.ErrManageLinkInvalid)
+	extended := link
+	extended.ExpiresAt = link.ExpiresAt.Add(time.Hour)
+	assert.ErrorIs(t, signer.Verify(extended, "token-5"), domain.ErrManageLinkInvalid)
+	assert.ErrorIs(t, signer.Verify(link, "rotated"), domain.ErrManageLinkInvalid, "rotating the token revokes the link")
+	assert.ErrorIs(t, NewLinkSigner([]byte("other"), "", time.Hour).Verify(link, "token-5"), domain.ErrManageLinkInvalid)
+
+	now = now.Add(time.Hour)
+	assert.ErrorIs(t, signer.Verify(link, "token-5"), domain.ErrManageLinkInvalid, "expired")
+}
+
+func TestLinkSignerDisabled(t *testing.T) {
+	var signer *LinkSigner
+	assert.False(t, signer.Enabled())
+	assert.False(t, NewLinkSigner(nil, "https://example.com", time.Hour).Enabled())
+	_, _, err := NewLinkSigner(nil, "https://example.com", time.Hour).Sign(1, "t")
+	assert.Error(t, err)
+	assert.ErrorIs(t, NewLinkSigner(nil, "", time.Hour).Verify(domain.ManageLink{BookingID: 1, Signature: "x", ExpiresAt: time.Now().Add(time.Hour)}, "t"), domain.ErrManageLinkInvalid)
+}
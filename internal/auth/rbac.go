@@ -0,0 +1,183 @@
+package auth
+
+import "strings"
+
+// Role is a role of a caller, carried in the roles claim.
+type Role string
+
+const (
+	RoleCustomer Role = "customer"
+	RoleAgent    Role = "agent"
+	RoleSupport  Role = "support"
+	RoleOps      Role = "ops"
+	RoleAdmin    Role = "admin"
+)
+
+// Permission is what an RPC requires of its caller.
+type Permission string
+
+const (
+	PermissionFlightsRead    Permission = "flights:read"
+	PermissionFlightsManage  Permission = "flights:manage"
+	PermissionBookingsCreate Permission = "bookings:create"
+	PermissionBookingsRead   Permission = "bookings:read"
+	PermissionBookingsManage Permission = "bookings:manage"
+	PermissionWaitlistJoin   Permission = "waitlist:join"
+	PermissionOperations     Permission = "operations:manage"
+	PermissionPromotions     Permission = "promotions:manage"
+	PermissionExchangeRates  Permission = "exchange_rates:manage"
+)
+
+var permissions = map[Permission]struct{}{
+	PermissionFlightsRead:    {},
+	PermissionFlightsManage:  {},
+	PermissionBookingsCreate: {},
+	PermissionBookingsRead:   {},
+	PermissionBookingsManage: {},
+	PermissionWaitlistJoin:   {},
+	PermissionOperations:     {},
+	PermissionPromotions:     {},
+	PermissionExchangeRates:  {},
+}
+
+// ParsePermission accepts a permission name such as "bookings:create".
+func ParsePermission(s string) (Permission, bool) {
+	_, ok := permissions[Permission(s)]
+	return Permission(s), ok
+}
+
+// adminPermissions guard the admin APIs.
+var adminPermissions = map[Permission]struct{}{
+	PermissionFlightsManage: {},
+	PermissionOperations:    {},
+	PermissionPromotions:    {},
+	PermissionExchangeRates: {},
+}
+
+// IsAdmin reports whether the permission guards an admin API. Such
+// permissions are not granted to API keys.
+func (p Permission) IsAdmin() bool {
+	_, ok := adminPermissions[p]
+	return ok
+}
+
+// bookingPermissions act on bookings. Granted as scopes, they cover the
+// bookings made with the API key only.
+var bookingPermissions = map[Permission]struct{}{
+	PermissionBookingsCreate: {},
+	PermissionBookingsRead:   {},
+	PermissionBookingsManage: {},
+	PermissionWaitlistJoin:   {},
+}
+
+// Scope says which bookings a permission covers.
+type Scope int
+
+const (
+	ScopeNone Scope = iota
+	// ScopeOwn limits the permission to the bookings of the caller.
+	ScopeOwn
+	ScopeAny
+)
+
+// rolePermissions grants permissions to roles. Customers act on their own
+// bookings only; agents book on behalf of customers and support manages
+// existing bookings.
+var rolePermissions = map[Role]map[Permission]Scope{
+	RoleCustomer: {
+		PermissionFlightsRead:    ScopeAny,
+		PermissionBookingsCreate: ScopeOwn,
+		PermissionBookingsRead:   ScopeOwn,
+		PermissionBookingsManage: ScopeOwn,
+		PermissionWaitlistJoin:   ScopeOwn,
+	},
+	RoleAgent: {
+		PermissionFlightsRead:    ScopeAny,
+		PermissionBookingsCreate: ScopeAny,
+		PermissionBookingsRead:   ScopeAny,
+		PermissionBookingsManage: ScopeAny,
+		PermissionWaitlistJoin:   ScopeAny,
+	},
+	RoleSupport: {
+		PermissionFlightsRead:    ScopeAny,
+		PermissionBookingsRead:   ScopeAny,
+		PermissionBookingsManage: ScopeAny,
+	},
+	RoleOps: {
+		PermissionFlightsRead:  ScopeAny,
+		PermissionBookingsRead: ScopeAny,
+		PermissionOperations:   ScopeAny,
+	},
+	RoleAdmin: {
+		PermissionFlightsRead:    ScopeAny,
+		PermissionFlightsManage:  ScopeAny,
+		PermissionBookingsCreate: ScopeAny,
+		PermissionBookingsRead:   ScopeAny,
+		PermissionBookingsManage: ScopeAny,
+		PermissionWaitlistJoin:   ScopeAny,
+		PermissionOperations:     ScopeAny,
+		PermissionPromotions:     ScopeAny,
+		PermissionExchangeRates:  ScopeAny,
+	},
+}
+
+// bookingSessionPermissions are what a manage-booking session may do with
+// its booking.
+var bookingSessionPermissions = map[Permission]Scope{
+	PermissionBookingsRead:   ScopeOwn,
+	PermissionBookingsManage: ScopeOwn,
+}
+
+// ParseRole accepts a case-insensitive role name.
+func ParseRole(s string) (Role, bool) {
+	role := Role(strings.ToLower(s))
+	_, ok := rolePermissions[role]
+	return role, ok
+}
+
+// HasRole reports whether the caller has role.
+func (c *Claims) HasRole(role Role) bool {
+	for _, r := range c.Roles {
+		if parsed, ok := ParseRole(r); ok && parsed == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope returns the widest scope of permission over the roles and the
+// scopes of the caller. A booking permission listed in the scopes covers the
+// bookings of the caller, which for an API key are the bookings made with
+// it; other permissions listed there are not limited. Unknown roles grant
+// nothing. Manage-booking sessions ignore roles and scopes and get
+// bookingSessionPermissions.
+func (c *Claims) Scope(permission Permission) Scope {
+	if _, ok := c.BookingID(); ok {
+		return bookingSessionPermissions[permission]
+	}
+	best := ScopeNone
+	for _, s := range c.Scopes {
+		if Permission(s) != permission {
+			continue
+		}
+		best = ScopeAny
+		if _, ok := bookingPermissions[permission]; ok {
+			best = ScopeOwn
+		}
+	}
+	for _, r := range c.Roles {
+		role, ok := ParseRole(r)
+		if !ok {
+			continue
+		}
+		if scope := rolePermissions[role][permission]; scope > best {
+			best = scope
+		}
+	}
+	return best
+}
+
+// Owns reports whether a booking made with email belongs to the caller.
+func (c *Claims) Owns(email string) bool {
+	return c.Email != "" && strings.EqualFold(c.Email, email)
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimsScope(t *testing.T) {
+	customer := &Claims{Email: "Me@Example.com", Roles: []string{"customer"}}
+	assert.Equal(t, ScopeOwn, customer.Scope(PermissionBookingsManage))
+	assert.Equal(t, ScopeAny, customer.Scope(PermissionFlightsRead))
+	assert.Equal(t, ScopeNone, customer.Scope(PermissionOperations))
+	assert.Equal(t, ScopeNone, customer.Scope(PermissionPromotions))
+	assert.Equal(t, ScopeNone, customer.Scope(PermissionExchangeRates))
+	assert.True(t, customer.Owns("me@example.com"))
+	assert.False(t, customer.Owns("other@example.com"))
+	assert.False(t, (&Claims{}).Owns(""), "no email owns nothing")
+
+	multi := &Claims{Roles: []string{"customer", "SUPPORT", "pilot"}}
+	assert.Equal(t, ScopeAny, multi.Scope(PermissionBookingsManage), "the widest scope wins")
+	assert.True(t, multi.HasRole(RoleSupport))
+	assert.False(t, multi.HasRole(RoleAdmin))
+	assert.Equal(t, ScopeOwn, multi.Scope(PermissionBookingsCreate), "support cannot book, the customer role can for itself")
+}
+
+func TestAPIKeyScope(t *testing.T) {
+	key := &Claims{Scopes: []string{"flights:read", "bookings:manage", "operations:manage"}, RegisteredClaims: jwt.RegisteredClaims{Subject: APIKeySubject(3)}}
+	assert.Equal(t, ScopeAny, key.Scope(PermissionFlightsRead))
+	assert.Equal(t, ScopeOwn, key.Scope(PermissionBookingsManage), "booking scopes cover the bookings made with the key")
+	assert.Equal(t, ScopeNone, key.Scope(PermissionBookingsRead))
+	assert.Equal(t, ScopeAny, key.Scope(PermissionOperations))
+
+	withRole := &Claims{Roles: []string{"support"}, Scopes: []string{"bookings:manage"}}
+	assert.Equal(t, ScopeAny, withRole.Scope(PermissionBookingsManage), "the widest scope wins")
+}
+
+func TestBookingSessionScope(t *testing.T) {
+	session := &Claims{Roles: []string{"admin"}, Scopes: []string{"operations:manage"}, RegisteredClaims: jwt.RegisteredClaims{Subject: BookingSubject(5)}}
+	assert.Equal(t, ScopeOwn, session.Scope(PermissionBookingsRead))
+	assert.Equal(t, ScopeOwn, session.Scope(PermissionBookingsManage))
+	assert.Equal(t, ScopeNone, session.Scope(PermissionBookingsCreate))
+	assert.Equal(t, ScopeNone, session.Scope(PermissionOperations), "roles and scopes of a session are ignored")
+}
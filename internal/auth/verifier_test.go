@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func claimsFor(subject string, ttl time.Duration) *Claims {
+	now := time.Now()
+	return &Claims{
+		Email: subject + "@example.com",
+		Roles: []string{"customer"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    "airbooking",
+			Audience:  jwt.ClaimStrings{"airbooking-api"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims *Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestVerifierHS256(t *testing.T) {
+	secret := []byte("s3cret")
+	v := NewVerifier(WithHS256Secret(secret), WithIssuer("airbooking"), WithAudience("airbooking-api"))
+	require.True(t, v.Enabled())
+
+	claims, err := v.Verify(sign(t, jwt.SigningMethodHS256, secret, "", claimsFor("c-1", time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, "c-1", claims.Subject)
+	assert.Equal(t, "c-1@example.com", claims.Email)
+	assert.Equal(t, []string{"customer"}, claims.Roles)
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte("other"), "", claimsFor("c-1", time.Hour)))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, secret, "", claimsFor("c-1", -time.Minute)))
+	assert.ErrorIs(t, err, ErrInvalidToken, "expired")
+
+	foreign := claimsFor("c-1", time.Hour)
+	foreign.Issuer = "someone-else"
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, secret, "", foreign))
+	assert.ErrorIs(t, err, ErrInvalidToken, "wrong issuer")
+
+	noExpiry := claimsFor("c-1", time.Hour)
+	noExpiry.ExpiresAt = nil
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, secret, "", noExpiry))
+	assert.ErrorIs(t, err, ErrInvalidToken, "exp is required")
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", claimsFor("c-1", time.Hour)))
+	assert.ErrorIs(t, err, ErrInvalidToken, "alg none")
+}
+
+func TestVerifierRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwksJSON := fmt.Sprintf(`{"keys": [
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256"},
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "alg": "RS256", "n": %q, "e": %q}
+	]}`, base64.RawURLEncoding.EncodeToString(key.N.Bytes()), base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	keys, err := ParseJWKS([]byte(jwksJSON))
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	v := NewVerifier(WithRSAKeys(keys))
+	claims, err := v.Verify(sign(t, jwt.SigningMethodRS256, key, "rsa-1", claimsFor("agent-7", time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, "agent-7", claims.Subject)
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodRS256, key, "rsa-2", claimsFor("agent-7", time.Hour)))
+	assert.ErrorIs(t, err, ErrInvalidToken, "unknown key id")
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte("s3cret"), "", claimsFor("agent-7", time.Hour)))
+	assert.ErrorIs(t, err, ErrInvalidToken, "HS256 is not accepted without a secret")
+}
+
+func TestParseJWKSWithoutRSAKeys(t *testing.T) {
+	_, err := ParseJWKS([]byte(`{"keys": [{"kty": "EC", "kid": "ec-1"}]}`))
+	assert.Error(t, err)
+	assert.False(t, NewVerifier().Enabled())
+}
+
+func TestFromContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	claims := claimsFor("c-1", time.Hour)
+	got, ok := FromContext(WithClaims(context.Background(), claims))
+	assert.True(t, ok)
+	assert.Same(t, claims, got)
+}
@@ -0,0 +1,112 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/textproto"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/service/apikeys"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const apiKeyHeader = "x-api-key"
+
+type apiKeyContextKey struct{}
+
+// apiKeyFromContext returns the API key the call was authenticated with.
+func apiKeyFromContext(ctx context.Context) (*domain.APIKey, bool) {
+	key, ok := ctx.Value(apiKeyContextKey{}).(*domain.APIKey)
+	return key, ok
+}
+
+// apiKeyUnaryInterceptor authenticates partners by the x-api-key metadata.
+// The caller gets the scopes of the key as permissions. Calls that already
+// carry a JWT are left alone.
+func apiKeyUnaryInterceptor(keys apikeys.APIKeyUseCase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticateAPIKey(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// apiKeyStreamInterceptor is apiKeyUnaryInterceptor for streaming calls.
+func apiKeyStreamInterceptor(keys apikeys.APIKeyUseCase) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateAPIKey(ss.Context(), keys)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticateAPIKey(ctx context.Context, keys apikeys.APIKeyUseCase) (context.Context, error) {
+	if _, ok := auth.FromContext(ctx); ok {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(apiKeyHeader)
+	if len(values) == 0 || values[0] == "" {
+		return ctx, nil
+	}
+	return withAPIKey(ctx, keys, values[0])
+}
+
+func withAPIKey(ctx context.Context, keys apikeys.APIKeyUseCase, value string) (context.Context, error) {
+	key, err := keys.Authenticate(ctx, value)
+	if errors.Is(err, domain.ErrAPIKeyNotFound) || errors.Is(err, domain.ErrAPIKeyRevoked) {
+		return nil, status.Error(codes.Unauthenticated, "invalid API key")
+	}
+	if err != nil {
+		return nil, err
+	}
+	claims := &auth.Claims{
+		Scopes:           key.Scopes,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: auth.APIKeySubject(key.ID)},
+	}
+	ctx = context.WithValue(ctx, apiKeyContextKey{}, key)
+	return auth.WithClaims(ctx, claims), nil
+}
+
+// incomingHeaderMatcher forwards X-Api-Key to gRPC as is and the other
+// headers like requestIDHeaderMatcher.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "X-Api-Key" {
+		return apiKeyHeader, true
+	}
+	return requestIDHeaderMatcher(key)
+}
+
+// apiKeyHTTPMiddleware authenticates the HTTP endpoints served next to the
+// gateway by the X-Api-Key header.
+func apiKeyHTTPMiddleware(keys apikeys.APIKeyUseCase, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if value := r.Header.Get(apiKeyHeader); value != "" {
+			if _, ok := auth.FromContext(r.Context()); !ok {
+				ctx, err := withAPIKey(r.Context(), keys, value)
+				if status.Code(err) == codes.Unauthenticated {
+					http.Error(w, "invalid API key", http.StatusUnauthorized)
+					return
+				}
+				if err != nil {
+					log.Printf("%s %s failed: %v", r.Method, r.URL.Path, err)
+					http.Error(w, "internal error", http.StatusInternalServerError)
+					return
+				}
+				r = r.WithContext(ctx)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,112 @@
+package bootstrap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/service/apikeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAPIKeys knows the keys "ak_partner" and, revoked, "ak_revoked".
+type fakeAPIKeys struct {
+	apikeys.APIKeyUseCase
+}
+
+func (fakeAPIKeys) Authenticate(ctx context.Context, key string) (*domain.APIKey, error) {
+	switch key {
+	case "ak_partner":
+		return &domain.APIKey{ID: 3, Name: "partner", Scopes: []string{"flights:read", "bookings:create"}, RateLimitPerMinute: 1000}, nil
+	case "ak_revoked":
+		return nil, domain.ErrAPIKeyRevoked
+	}
+	return nil, domain.ErrAPIKeyNotFound
+}
+
+func withAPIKeyHeader(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyHeader, key))
+}
+
+func TestAPIKeyUnaryInterceptor(t *testing.T) {
+	interceptor := apiKeyUnaryInterceptor(fakeAPIKeys{})
+	info := &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + "CreateBooking"}
+	var ctx context.Context
+	handler := func(c context.Context, req interface{}) (interface{}, error) {
+		ctx = c
+		return nil, nil
+	}
+
+	_, err := interceptor(withAPIKeyHeader("ak_partner"), nil, info, handler)
+	require.NoError(t, err)
+	claims, ok := auth.FromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "api-key:3", claims.Subject)
+	assert.Equal(t, auth.ScopeOwn, claims.Scope(auth.PermissionBookingsCreate), "keys book for themselves")
+	assert.Equal(t, auth.ScopeNone, claims.Scope(auth.PermissionBookingsManage))
+	key, ok := apiKeyFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int64(3), key.ID)
+
+	for _, bad := range []string{"ak_revoked", "ak_guess"} {
+		_, err = interceptor(withAPIKeyHeader(bad), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), bad)
+	}
+
+	_, err = interceptor(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	_, ok = auth.FromContext(ctx)
+	assert.False(t, ok, "anonymous without a key")
+}
+
+func TestAPIKeyScopesAreAuthorized(t *testing.T) {
+	chain := func(ctx context.Context, method string) error {
+		_, err := apiKeyUnaryInterceptor(fakeAPIKeys{})(ctx, &struct{}{}, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return rbacUnaryInterceptor(false, nil)(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+		})
+		return err
+	}
+	assert.NoError(t, chain(withAPIKeyHeader("ak_partner"), flightsServicePrefix+"ListFlights"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(chain(withAPIKeyHeader("ak_partner"), bookingsServicePrefix+"CancelBooking")),
+		"scopes are enforced even when JWT authentication is off")
+}
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	key, ok := incomingHeaderMatcher("X-Api-Key")
+	assert.True(t, ok)
+	assert.Equal(t, apiKeyHeader, key)
+	key, ok = incomingHeaderMatcher("X-Request-Id")
+	assert.True(t, ok)
+	assert.Equal(t, requestIDHeader, key)
+}
+
+func TestAPIKeyHTTPMiddleware(t *testing.T) {
+	var subject string
+	handler := apiKeyHTTPMiddleware(fakeAPIKeys{}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if claims, ok := auth.FromContext(r.Context()); ok {
+			subject = claims.Subject
+		}
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/flights/1/availability/events", nil)
+	r.Header.Set("X-Api-Key", "ak_partner")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "api-key:3", subject)
+
+	r.Header.Set("X-Api-Key", "ak_revoked")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
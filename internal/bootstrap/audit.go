@@ -0,0 +1,61 @@
+package bootstrap
+
+import (
+	"context"
+	"net/textproto"
+
+	"github.com/Domenick1991/airbooking/internal/audit"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIDHeader = "x-request-id"
+
+const (
+	actorCustomer = "customer"
+	actorAdmin    = "admin"
+)
+
+// auditUnaryInterceptor puts the actor and the request id of the call in the
+// context, for the booking history. The request id comes from the
+// x-request-id metadata or is generated, and is returned in the response
+// header.
+func auditUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		var requestID string
+		if values := md.Get(requestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+		actor := actorCustomer
+		if isAdminMethod(info.FullMethod) {
+			actor = actorAdmin
+		}
+		ctx = audit.WithRequestID(audit.WithActor(ctx, actor), requestID)
+		return handler(ctx, req)
+	}
+}
+
+// requestIDHeaderMatcher forwards X-Request-Id from HTTP to gRPC as is.
+func requestIDHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "X-Request-Id" {
+		return requestIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// requestIDResponseMatcher returns the request id as X-Request-Id rather
+// than Grpc-Metadata-X-Request-Id.
+func requestIDResponseMatcher(key string) (string, bool) {
+	if key == requestIDHeader {
+		return "X-Request-Id", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,39 @@
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/audit"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuditUnaryInterceptor(t *testing.T) {
+	interceptor := auditUnaryInterceptor()
+	var got audit.Info
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = audit.FromContext(ctx)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDHeader, "req-1"))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/airbooking.bookings_api.BookingsService/CancelBooking"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, audit.Info{Actor: actorCustomer, RequestID: "req-1"}, got)
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: opsServicePrefix + "CancelFlight"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, actorAdmin, got.Actor)
+	assert.NotEmpty(t, got.RequestID, "generated when the caller sent none")
+}
+
+func TestRequestIDHeaderMatchers(t *testing.T) {
+	key, ok := requestIDHeaderMatcher("X-Request-Id")
+	assert.True(t, ok)
+	assert.Equal(t, requestIDHeader, key)
+	key, ok = requestIDResponseMatcher(requestIDHeader)
+	assert.True(t, ok)
+	assert.Equal(t, "X-Request-Id", key)
+}
@@ -0,0 +1,75 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	adminServicePrefix         = "/airbooking.admin_flights_api.AdminFlightsService/"
+	opsServicePrefix           = "/airbooking.ops_api.OpsService/"
+	promotionsServicePrefix    = "/airbooking.admin_promotions_api.AdminPromotionsService/"
+	exchangeRatesServicePrefix = "/airbooking.admin_exchange_rates_api.AdminExchangeRatesService/"
+)
+
+// adminServicePrefixes lists the services guarded by the admin token.
+var adminServicePrefixes = []string{adminServicePrefix, opsServicePrefix, promotionsServicePrefix, exchangeRatesServicePrefix}
+
+// adminClaims are the claims of callers using the static admin token.
+var adminClaims = &auth.Claims{
+	Roles:            []string{string(auth.RoleAdmin)},
+	RegisteredClaims: jwt.RegisteredClaims{Subject: actorAdmin},
+}
+
+// adminAuthUnaryInterceptor requires "authorization: Bearer <token>" on every
+// AdminFlightsService, OpsService, AdminPromotionsService and
+// AdminExchangeRatesService call, unless the caller already
+// authenticated with a JWT; the roles of such callers are checked by
+// rbacUnaryInterceptor. An API key is not enough: its caller still needs the
+// token. The gateway forwards the HTTP Authorization header
+// as the same metadata key. An empty token disables the static token.
+func adminAuthUnaryInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isAdminMethod(info.FullMethod) {
+			_, apiKey := apiKeyFromContext(ctx)
+			if _, ok := auth.FromContext(ctx); !ok || apiKey {
+				if err := checkBearerToken(ctx, token); err != nil {
+					return nil, err
+				}
+				ctx = auth.WithClaims(ctx, adminClaims)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func isAdminMethod(fullMethod string) bool {
+	for _, prefix := range adminServicePrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkBearerToken(ctx context.Context, expected string) error {
+	if expected == "" {
+		return status.Error(codes.PermissionDenied, "admin API is disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		got, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(got), []byte(expected)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "missing or invalid admin token")
+}
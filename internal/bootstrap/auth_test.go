@@ -0,0 +1,95 @@
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminAuthUnaryInterceptor(t *testing.T) {
+	interceptor := adminAuthUnaryInterceptor("secret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	adminInfo := &grpc.UnaryServerInfo{FullMethod: adminServicePrefix + "CreateFlight"}
+
+	testCases := []struct {
+		name   string
+		info   *grpc.UnaryServerInfo
+		header string
+		code   codes.Code
+	}{
+		{"public method", &grpc.UnaryServerInfo{FullMethod: "/airbooking.flights_api.FlightsService/ListFlights"}, "", codes.OK},
+		{"valid token", adminInfo, "Bearer secret", codes.OK},
+		{"missing token", adminInfo, "", codes.Unauthenticated},
+		{"wrong token", adminInfo, "Bearer nope", codes.Unauthenticated},
+		{"wrong scheme", adminInfo, "Basic secret", codes.Unauthenticated},
+		{"ops method without token", &grpc.UnaryServerInfo{FullMethod: opsServicePrefix + "CancelFlight"}, "", codes.Unauthenticated},
+		{"ops method with token", &grpc.UnaryServerInfo{FullMethod: opsServicePrefix + "CancelFlight"}, "Bearer secret", codes.OK},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.header))
+			}
+			resp, err := interceptor(ctx, nil, tc.info, handler)
+			assert.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				assert.Equal(t, "ok", resp)
+			}
+		})
+	}
+}
+
+func TestAdminAuthUnaryInterceptor_Disabled(t *testing.T) {
+	interceptor := adminAuthUnaryInterceptor("")
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: adminServicePrefix + "DeleteFlight"}, nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAdminAuthUnaryInterceptor_JWT(t *testing.T) {
+	interceptor := adminAuthUnaryInterceptor("secret")
+	var claims *auth.Claims
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, _ = auth.FromContext(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: opsServicePrefix + "CancelFlight"}
+
+	staff := &auth.Claims{Roles: []string{"ops"}}
+	_, err := interceptor(auth.WithClaims(context.Background(), staff), nil, info, handler)
+	assert.NoError(t, err, "roles of JWT callers are checked by the RBAC interceptor")
+	assert.Same(t, staff, claims)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	_, err = interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.True(t, claims.HasRole(auth.RoleAdmin))
+}
+
+func TestAdminAuthUnaryInterceptor_APIKey(t *testing.T) {
+	interceptor := adminAuthUnaryInterceptor("secret")
+	var claims *auth.Claims
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, _ = auth.FromContext(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: adminServicePrefix + "DeleteFlight"}
+
+	ctx, err := withAPIKey(context.Background(), fakeAPIKeys{}, "ak_partner")
+	require.NoError(t, err)
+	_, err = interceptor(ctx, nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "an API key does not replace the admin token")
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer secret"))
+	_, err = interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.True(t, claims.HasRole(auth.RoleAdmin))
+}
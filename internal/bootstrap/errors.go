@@ -0,0 +1,138 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the ErrorInfo domain of the errors returned by the API.
+const errorDomain = "airbooking"
+
+// errorsUnaryInterceptor turns the domain errors returned by the handlers
+// into gRPC statuses. It must be the outermost interceptor so that it also
+// sees the errors of the other ones.
+func errorsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+// errorsStreamInterceptor is errorsUnaryInterceptor for streaming calls.
+func errorsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return toStatusError(info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+// toStatusError maps err to a gRPC status:
+//
+//	*domain.ValidationError      InvalidArgument, with BadRequest field violations
+//	*domain.NotFoundError        NotFound
+//	*domain.SeatUnavailableError Aborted
+//	*domain.InvalidStateError    FailedPrecondition
+//	*domain.UnauthenticatedError Unauthenticated
+//
+// Domain errors carry an ErrorInfo with their reason. Errors that already are
+// statuses are returned as is; any other error is logged and hidden behind
+// Internal.
+func toStatusError(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	var (
+		notFound    *domain.NotFoundError
+		unavailable *domain.SeatUnavailableError
+		invalid     *domain.InvalidStateError
+		validation  *domain.ValidationError
+		unauth      *domain.UnauthenticatedError
+	)
+	switch {
+	case errors.As(err, &validation):
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range domain.Violations(err) {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		return statusWithDetails(codes.InvalidArgument, err, validation.Reason(), badRequest)
+	case errors.As(err, &notFound):
+		return statusWithDetails(codes.NotFound, err, notFound.Reason())
+	case errors.As(err, &unavailable):
+		return statusWithDetails(codes.Aborted, err, unavailable.Reason())
+	case errors.As(err, &invalid):
+		return statusWithDetails(codes.FailedPrecondition, err, invalid.Reason())
+	case errors.As(err, &unauth):
+		return statusWithDetails(codes.Unauthenticated, err, unauth.Reason())
+	}
+
+	log.Printf("%s failed: %v", method, err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func statusWithDetails(code codes.Code, err error, reason string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, err.Error())
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}
+	withDetails, detailsErr := st.WithDetails(append([]protoadapt.MessageV1{info}, details...)...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// gatewayErrorHandler writes gRPC errors like runtime.DefaultHTTPErrorHandler
+// with two exceptions: FailedPrecondition, an operation the state of the
+// booking or flight does not allow, is 409 Conflict rather than 400, and
+// InvalidArgument with field violations is 422 Unprocessable Entity. Other
+// InvalidArgument errors, such as a malformed path parameter rejected by the
+// gateway itself, stay 400.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if code := gatewayHTTPStatus(err); code != 0 {
+		err = &runtime.HTTPStatusError{HTTPStatus: code, Err: err}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// gatewayHTTPStatus returns the HTTP status of err when it differs from
+// runtime.HTTPStatusFromCode, and zero otherwise.
+func gatewayHTTPStatus(err error) int {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0
+	}
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.InvalidArgument:
+		for _, detail := range st.Details() {
+			if _, ok := detail.(*errdetails.BadRequest); ok {
+				return http.StatusUnprocessableEntity
+			}
+		}
+	}
+	return 0
+}
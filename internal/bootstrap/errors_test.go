@@ -0,0 +1,85 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		code     codes.Code
+		reason   string
+		httpCode int
+	}{
+		{name: "not found", err: domain.ErrBookingNotFound, code: codes.NotFound, reason: "BOOKING_NOT_FOUND", httpCode: http.StatusNotFound},
+		{name: "seat taken", err: domain.ErrSeatTaken, code: codes.Aborted, reason: "SEAT_TAKEN", httpCode: http.StatusConflict},
+		{name: "invalid state", err: &domain.BookingTransitionError{From: domain.BookingStatusExpired, To: domain.BookingStatusConfirmed}, code: codes.FailedPrecondition, reason: "INVALID_BOOKING_STATUS_TRANSITION", httpCode: http.StatusConflict},
+		{name: "wrapped", err: fmt.Errorf("%w: flight is ARRIVED", domain.ErrInvalidStatusTransition), code: codes.FailedPrecondition, reason: "INVALID_FLIGHT_STATUS_TRANSITION", httpCode: http.StatusConflict},
+		{name: "validation", err: domain.NewValidationError("email", "email is required"), code: codes.InvalidArgument, reason: "VALIDATION_FAILED", httpCode: http.StatusUnprocessableEntity},
+		{name: "unauthenticated", err: domain.ErrInvalidCredentials, code: codes.Unauthenticated, reason: "INVALID_CREDENTIALS", httpCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(toStatusError("/test", tt.err))
+			require.True(t, ok)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.err.Error(), st.Message())
+			require.NotEmpty(t, st.Details())
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, tt.reason, info.Reason)
+			assert.Equal(t, errorDomain, info.Domain)
+
+			httpCode := gatewayHTTPStatus(st.Err())
+			if httpCode == 0 {
+				httpCode = runtime.HTTPStatusFromCode(st.Code())
+			}
+			assert.Equal(t, tt.httpCode, httpCode)
+		})
+	}
+}
+
+func TestToStatusErrorFieldViolations(t *testing.T) {
+	st := status.Convert(toStatusError("/test", errors.Join(domain.ErrInvalidSchedule, domain.NewValidationError("flight_number", "flight number is required"))))
+	require.Len(t, st.Details(), 2)
+	badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	assert.Equal(t, "flight_number", badRequest.FieldViolations[1].Field)
+	assert.Equal(t, "flight number is required", badRequest.FieldViolations[1].Description)
+}
+
+func TestToStatusErrorPassThrough(t *testing.T) {
+	denied := status.Error(codes.PermissionDenied, "admin API is disabled")
+	assert.Equal(t, denied, toStatusError("/test", denied))
+
+	st := status.Convert(toStatusError("/test", errors.New("connection refused")))
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, "internal error", st.Message(), "internal errors are not leaked")
+
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(toStatusError("/test", fmt.Errorf("query: %w", context.DeadlineExceeded))))
+
+	assert.Zero(t, gatewayHTTPStatus(status.Error(codes.InvalidArgument, "type mismatch")), "plain invalid argument stays 400")
+}
+
+func TestErrorsUnaryInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, domain.ErrFlightNotFound
+	}
+	_, err := errorsUnaryInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
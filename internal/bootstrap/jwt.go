@@ -0,0 +1,96 @@
+package bootstrap
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// jwtUnaryInterceptor verifies the bearer JWT of the call, if any, and puts
+// its claims in the context. Calls without a token stay anonymous; a token
+// that does not verify is rejected. The static admin token is left to
+// adminAuthUnaryInterceptor. When no signing key is configured tokens are
+// not checked.
+func jwtUnaryInterceptor(verifier *auth.Verifier, adminToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, verifier, adminToken, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// jwtStreamInterceptor is jwtUnaryInterceptor for streaming calls.
+func jwtStreamInterceptor(verifier *auth.Verifier, adminToken string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier, adminToken, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextServerStream replaces the context of a server stream.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticate(ctx context.Context, verifier *auth.Verifier, adminToken, fullMethod string) (context.Context, error) {
+	if !verifier.Enabled() {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	token, ok := bearerToken(md.Get("authorization"))
+	if !ok {
+		return ctx, nil
+	}
+	if isAdminMethod(fullMethod) && checkBearerToken(ctx, adminToken) == nil {
+		return ctx, nil
+	}
+	claims, err := verifier.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return auth.WithClaims(ctx, claims), nil
+}
+
+func bearerToken(values []string) (string, bool) {
+	for _, value := range values {
+		if token, ok := strings.CutPrefix(value, "Bearer "); ok && token != "" {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+// jwtHTTPMiddleware authenticates the HTTP endpoints served next to the
+// gateway, such as the availability events, the way jwtUnaryInterceptor
+// does for gRPC.
+func jwtHTTPMiddleware(verifier *auth.Verifier, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if verifier.Enabled() {
+			if token, ok := bearerToken(r.Header.Values("Authorization")); ok {
+				claims, err := verifier.Verify(token)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusUnauthorized)
+					return
+				}
+				r = r.WithContext(auth.WithClaims(r.Context(), claims))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,127 @@
+package bootstrap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testJWTSecret = []byte("test-secret")
+
+func testJWT(t *testing.T, subject string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claims{
+		Email: subject + "@example.com",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString(testJWTSecret)
+	require.NoError(t, err)
+	return token
+}
+
+func withBearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestJWTUnaryInterceptor(t *testing.T) {
+	interceptor := jwtUnaryInterceptor(auth.NewVerifier(auth.WithHS256Secret(testJWTSecret)), "admin-token")
+	var claims *auth.Claims
+	var authenticated bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, authenticated = auth.FromContext(ctx)
+		return nil, nil
+	}
+	bookings := &grpc.UnaryServerInfo{FullMethod: "/airbooking.bookings_api.BookingsService/ConfirmBooking"}
+
+	_, err := interceptor(withBearer(testJWT(t, "c-1")), nil, bookings, handler)
+	require.NoError(t, err)
+	require.True(t, authenticated)
+	assert.Equal(t, "c-1", claims.Subject)
+
+	_, err = interceptor(context.Background(), nil, bookings, handler)
+	assert.NoError(t, err)
+	assert.False(t, authenticated, "anonymous")
+
+	_, err = interceptor(withBearer("not-a-jwt"), nil, bookings, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor(withBearer("admin-token"), nil, &grpc.UnaryServerInfo{FullMethod: opsServicePrefix + "CancelFlight"}, handler)
+	assert.NoError(t, err, "the static admin token is checked by adminAuthUnaryInterceptor")
+	assert.False(t, authenticated)
+
+	_, err = interceptor(withBearer("admin-token"), nil, bookings, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "the admin token is no JWT outside the admin APIs")
+}
+
+func TestJWTUnaryInterceptorDisabled(t *testing.T) {
+	interceptor := jwtUnaryInterceptor(auth.NewVerifier(), "")
+	_, err := interceptor(withBearer("anything"), nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, ok := auth.FromContext(ctx)
+		assert.False(t, ok)
+		return nil, nil
+	})
+	assert.NoError(t, err)
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestJWTStreamInterceptor(t *testing.T) {
+	interceptor := jwtStreamInterceptor(auth.NewVerifier(auth.WithHS256Secret(testJWTSecret)), "")
+	info := &grpc.StreamServerInfo{FullMethod: "/airbooking.flights_api.FlightsService/WatchFlightAvailability"}
+
+	err := interceptor(nil, &testServerStream{ctx: withBearer(testJWT(t, "c-2"))}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		claims, ok := auth.FromContext(ss.Context())
+		require.True(t, ok)
+		assert.Equal(t, "c-2", claims.Subject)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = interceptor(nil, &testServerStream{ctx: withBearer("bad")}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		t.Fatal("handler must not run")
+		return nil
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestJWTHTTPMiddleware(t *testing.T) {
+	var subject string
+	handler := jwtHTTPMiddleware(auth.NewVerifier(auth.WithHS256Secret(testJWTSecret)), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if claims, ok := auth.FromContext(r.Context()); ok {
+			subject = claims.Subject
+		}
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/flights/1/availability/events", nil)
+	r.Header.Set("Authorization", "Bearer "+testJWT(t, "c-3"))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "c-3", subject)
+
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/flights/1/availability/events", nil)
+	r.Header.Set("Authorization", "Bearer bad")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,173 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	retryAfterHeader   = "retry-after"
+	gatewayTokenHeader = "x-gateway-token"
+)
+
+// rateLimits are the token buckets of API callers.
+type rateLimits struct {
+	IP     ratelimit.Limit
+	APIKey ratelimit.Limit
+	// GatewayToken is sent by the gateway of this process with every call;
+	// see newGatewayToken.
+	GatewayToken string
+}
+
+// newGatewayToken returns a random token the gateway proves its calls with.
+// It never leaves the process, so other callers cannot pass for the gateway.
+func newGatewayToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// gatewayMetadata adds the gateway token to the calls of the gateway.
+func gatewayMetadata(token string) func(context.Context, *http.Request) metadata.MD {
+	return func(context.Context, *http.Request) metadata.MD {
+		return metadata.Pairs(gatewayTokenHeader, token)
+	}
+}
+
+// bucket returns the bucket key and the limit of a caller: its API key when
+// it has one, its IP address otherwise.
+func (l rateLimits) bucket(ctx context.Context, ip string) (string, ratelimit.Limit) {
+	if key, ok := apiKeyFromContext(ctx); ok {
+		limit := l.APIKey
+		if key.RateLimitPerMinute > 0 {
+			limit.PerMinute = key.RateLimitPerMinute
+		}
+		return "api-key:" + strconv.FormatInt(key.ID, 10), limit
+	}
+	return "ip:" + ip, l.IP
+}
+
+// rateLimitUnaryInterceptor rejects calls over the rate limit of the caller
+// with RESOURCE_EXHAUSTED, a RetryInfo detail and a retry-after header in
+// seconds. Calls from the gateway are left to rateLimitHTTPMiddleware, which
+// has already counted them. When Redis is unavailable calls are let through.
+func rateLimitUnaryInterceptor(limiter ratelimit.Limiter, limits rateLimits) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimit(ctx, limiter, limits); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitStreamInterceptor is rateLimitUnaryInterceptor for streaming
+// calls; a stream takes one token when it is opened.
+func rateLimitStreamInterceptor(limiter ratelimit.Limiter, limits rateLimits) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimit(ss.Context(), limiter, limits); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkRateLimit(ctx context.Context, limiter ratelimit.Limiter, limits rateLimits) error {
+	if fromGateway(ctx, limits.GatewayToken) {
+		return nil
+	}
+	key, limit := limits.bucket(ctx, peerIP(ctx))
+	res, err := limiter.Allow(ctx, key, limit)
+	if err != nil {
+		log.Printf("WARNING: rate limit check for %s failed: %v", key, err)
+		return nil
+	}
+	if res.Allowed {
+		return nil
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, retryAfterSeconds(res.RetryAfter)))
+	st, detailsErr := status.New(codes.ResourceExhausted, "rate limit exceeded").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(res.RetryAfter)})
+	if detailsErr != nil {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return st.Err()
+}
+
+// retryAfterSeconds rounds up, so that a retry after that many seconds is
+// allowed.
+func retryAfterSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
+
+// fromGateway reports whether the call carries the gateway token.
+func fromGateway(ctx context.Context, token string) bool {
+	if token == "" {
+		return false
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(gatewayTokenHeader) {
+		if subtle.ConstantTimeCompare([]byte(v), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func peerIP(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return hostOf(p.Addr.String())
+	}
+	return ""
+}
+
+func hostOf(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// outgoingHeaderMatcher returns retry-after as Retry-After and the other
+// headers like requestIDResponseMatcher.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == retryAfterHeader {
+		return "Retry-After", true
+	}
+	return requestIDResponseMatcher(key)
+}
+
+// rateLimitHTTPMiddleware applies the rate limits to the gateway and the
+// HTTP endpoints served next to it, answering 429 with Retry-After.
+func rateLimitHTTPMiddleware(limiter ratelimit.Limiter, limits rateLimits, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, limit := limits.bucket(r.Context(), hostOf(r.RemoteAddr))
+		res, err := limiter.Allow(r.Context(), key, limit)
+		if err != nil {
+			log.Printf("WARNING: rate limit check for %s failed: %v", key, err)
+		} else if !res.Allowed {
+			w.Header().Set("Retry-After", retryAfterSeconds(res.RetryAfter))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
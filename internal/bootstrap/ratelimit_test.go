@@ -0,0 +1,147 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/ratelimit"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeLimiter allows the calls of the keys in allowed and records the
+// buckets it was asked about.
+type fakeLimiter struct {
+	allowed map[string]bool
+	err     error
+	keys    []string
+	limits  []ratelimit.Limit
+}
+
+func (l *fakeLimiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	l.keys = append(l.keys, key)
+	l.limits = append(l.limits, limit)
+	if l.err != nil {
+		return ratelimit.Result{}, l.err
+	}
+	if l.allowed[key] {
+		return ratelimit.Result{Allowed: true}, nil
+	}
+	return ratelimit.Result{RetryAfter: 1500 * time.Millisecond}, nil
+}
+
+var testLimits = rateLimits{
+	IP:           ratelimit.Limit{PerMinute: 60},
+	APIKey:       ratelimit.Limit{PerMinute: 600, Burst: 100},
+	GatewayToken: "gateway-secret",
+}
+
+func withPeer(ctx context.Context, addr string) context.Context {
+	tcp, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(ctx, &peer.Peer{Addr: tcp})
+}
+
+func TestRateLimitUnaryInterceptor(t *testing.T) {
+	limiter := &fakeLimiter{allowed: map[string]bool{"ip:203.0.113.7": true}}
+	interceptor := rateLimitUnaryInterceptor(limiter, testLimits)
+	info := &grpc.UnaryServerInfo{FullMethod: flightsServicePrefix + "ListFlights"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	resp, err := interceptor(withPeer(context.Background(), "203.0.113.7:5555"), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(withPeer(context.Background(), "198.51.100.1:5555"), nil, info, handler)
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	retry, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, retry.RetryDelay.AsDuration())
+	assert.Equal(t, 429, runtime.HTTPStatusFromCode(st.Code()))
+
+	limiter.err = errors.New("redis: connection refused")
+	_, err = interceptor(withPeer(context.Background(), "198.51.100.1:5555"), nil, info, handler)
+	assert.NoError(t, err, "fails open when Redis is down")
+}
+
+func TestRateLimitBuckets(t *testing.T) {
+	limiter := &fakeLimiter{allowed: map[string]bool{}}
+	interceptor := rateLimitUnaryInterceptor(limiter, testLimits)
+	info := &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + "CreateBooking"}
+
+	md := gatewayMetadata(testLimits.GatewayToken)(context.Background(), nil)
+	md.Set("x-forwarded-for", "10.9.9.9, 203.0.113.9")
+	gateway := metadata.NewIncomingContext(withPeer(context.Background(), "127.0.0.1:40000"), md)
+	_, err := interceptor(gateway, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil })
+	require.NoError(t, err, "gateway calls are counted by rateLimitHTTPMiddleware")
+
+	local := metadata.NewIncomingContext(withPeer(context.Background(), "127.0.0.1:5555"), metadata.Pairs("x-forwarded-for", "10.9.9.9"))
+	_, _ = interceptor(local, nil, info, nil)
+
+	spoofed := metadata.NewIncomingContext(withPeer(context.Background(), "198.51.100.1:5555"), metadata.Pairs(gatewayTokenHeader, "guess"))
+	_, _ = interceptor(spoofed, nil, info, nil)
+
+	ctx, err := withAPIKey(context.Background(), fakeAPIKeys{}, "ak_partner")
+	require.NoError(t, err)
+	_, _ = interceptor(ctx, nil, info, nil)
+
+	ctx = context.WithValue(context.Background(), apiKeyContextKey{}, &domain.APIKey{ID: 4})
+	_, _ = interceptor(ctx, nil, info, nil)
+
+	assert.Equal(t, []string{"ip:127.0.0.1", "ip:198.51.100.1", "api-key:3", "api-key:4"}, limiter.keys)
+	assert.Equal(t, []ratelimit.Limit{
+		testLimits.IP,
+		testLimits.IP,
+		{PerMinute: 1000, Burst: 100},
+		testLimits.APIKey,
+	}, limiter.limits)
+}
+
+func TestRateLimitHTTPMiddleware(t *testing.T) {
+	limiter := &fakeLimiter{allowed: map[string]bool{"ip:203.0.113.7": true}}
+	handler := rateLimitHTTPMiddleware(limiter, testLimits, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/flights/1/availability/events", nil)
+	r.RemoteAddr = "203.0.113.7:5555"
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	r.RemoteAddr = "198.51.100.1:5555"
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+
+	handler = apiKeyHTTPMiddleware(fakeAPIKeys{}, rateLimitHTTPMiddleware(limiter, testLimits, http.NotFoundHandler()))
+	r = httptest.NewRequest(http.MethodPost, "/api/v1/bookings", nil)
+	r.RemoteAddr = "203.0.113.7:5555"
+	r.Header.Set("X-Api-Key", "ak_partner")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "api-key:3", limiter.keys[len(limiter.keys)-1])
+}
+
+func TestOutgoingHeaderMatcher(t *testing.T) {
+	key, ok := outgoingHeaderMatcher(retryAfterHeader)
+	assert.True(t, ok)
+	assert.Equal(t, "Retry-After", key)
+	key, ok = outgoingHeaderMatcher(requestIDHeader)
+	assert.True(t, ok)
+	assert.Equal(t, "X-Request-Id", key)
+}
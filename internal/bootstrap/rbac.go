@@ -0,0 +1,203 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	bookingsServicePrefix  = "/airbooking.bookings_api.BookingsService/"
+	flightsServicePrefix   = "/airbooking.flights_api.FlightsService/"
+	customersServicePrefix = "/airbooking.customers_api.CustomersService/"
+	loyaltyServicePrefix   = "/airbooking.loyalty_api.LoyaltyService/"
+	walletServicePrefix    = "/airbooking.wallet_api.WalletService/"
+)
+
+// methodPolicy is what a caller needs to call an RPC.
+type methodPolicy struct {
+	permission auth.Permission
+	// public methods are open to anonymous callers as well.
+	public bool
+	// self methods act on the account of the caller only, so holding the
+	// permission for its own bookings is enough.
+	self bool
+}
+
+// methodPolicies maps every RPC to its permission. RPCs missing here are
+// denied. Requests that name a booking by token or are made for an email are
+// checked for ownership when the caller holds the permission for its own
+// bookings only.
+var methodPolicies = map[string]methodPolicy{
+	flightsServicePrefix + "ListFlights":             {permission: auth.PermissionFlightsRead, public: true},
+	flightsServicePrefix + "GetFlight":               {permission: auth.PermissionFlightsRead, public: true},
+	flightsServicePrefix + "GetFlightStatus":         {permission: auth.PermissionFlightsRead, public: true},
+	flightsServicePrefix + "WatchFlightAvailability": {permission: auth.PermissionFlightsRead, public: true},
+
+	bookingsServicePrefix + "CreateBooking":     {permission: auth.PermissionBookingsCreate},
+	bookingsServicePrefix + "ConfirmBooking":    {permission: auth.PermissionBookingsManage},
+	bookingsServicePrefix + "CancelBooking":     {permission: auth.PermissionBookingsManage},
+	bookingsServicePrefix + "ExtendHold":        {permission: auth.PermissionBookingsManage},
+	bookingsServicePrefix + "ReservePayment":    {permission: auth.PermissionBookingsManage},
+	bookingsServicePrefix + "ChangeSeat":        {permission: auth.PermissionBookingsManage},
+	bookingsServicePrefix + "ChangeFlight":      {permission: auth.PermissionBookingsManage},
+	bookingsServicePrefix + "CheckIn":           {permission: auth.PermissionBookingsManage},
+	bookingsServicePrefix + "GetBookingHistory": {permission: auth.PermissionBookingsRead},
+	bookingsServicePrefix + "JoinWaitlist":      {permission: auth.PermissionWaitlistJoin},
+	bookingsServicePrefix + "ListMyBookings":    {permission: auth.PermissionBookingsRead, self: true},
+
+	bookingsServicePrefix + "OpenManageLink":        {public: true},
+	bookingsServicePrefix + "GetManagedBooking":     {permission: auth.PermissionBookingsRead, self: true},
+	bookingsServicePrefix + "ConfirmManagedBooking": {permission: auth.PermissionBookingsManage, self: true},
+	bookingsServicePrefix + "CancelManagedBooking":  {permission: auth.PermissionBookingsManage, self: true},
+
+	customersServicePrefix + "Register":            {public: true},
+	customersServicePrefix + "ResendVerification":  {public: true},
+	customersServicePrefix + "VerifyEmail":         {public: true},
+	customersServicePrefix + "Login":               {public: true},
+	customersServicePrefix + "RequestBookingClaim": {permission: auth.PermissionBookingsRead, self: true},
+	customersServicePrefix + "ClaimBookings":       {permission: auth.PermissionBookingsRead, self: true},
+
+	loyaltyServicePrefix + "Enroll":             {permission: auth.PermissionBookingsRead, self: true},
+	loyaltyServicePrefix + "GetLoyaltyAccount":  {permission: auth.PermissionBookingsRead, self: true},
+	loyaltyServicePrefix + "ListLoyaltyHistory": {permission: auth.PermissionBookingsRead, self: true},
+
+	walletServicePrefix + "GetWallet":         {permission: auth.PermissionBookingsRead, self: true},
+	walletServicePrefix + "ListWalletHistory": {permission: auth.PermissionBookingsRead, self: true},
+
+	adminServicePrefix + "CreateFlight": {permission: auth.PermissionFlightsManage},
+	adminServicePrefix + "UpdateFlight": {permission: auth.PermissionFlightsManage},
+	adminServicePrefix + "DeleteFlight": {permission: auth.PermissionFlightsManage},
+
+	opsServicePrefix + "CancelFlight":        {permission: auth.PermissionOperations},
+	opsServicePrefix + "UpdateFlightStatus":  {permission: auth.PermissionOperations},
+	opsServicePrefix + "ListOversoldFlights": {permission: auth.PermissionOperations},
+	opsServicePrefix + "DenyBoarding":        {permission: auth.PermissionOperations},
+	opsServicePrefix + "ListDeniedBoardings": {permission: auth.PermissionOperations},
+	opsServicePrefix + "SetBookingStatus":    {permission: auth.PermissionOperations},
+
+	promotionsServicePrefix + "CreatePromotion":   {permission: auth.PermissionPromotions},
+	promotionsServicePrefix + "UpdatePromotion":   {permission: auth.PermissionPromotions},
+	promotionsServicePrefix + "GetPromotion":      {permission: auth.PermissionPromotions},
+	promotionsServicePrefix + "ListPromotions":    {permission: auth.PermissionPromotions},
+	promotionsServicePrefix + "GetPromotionUsage": {permission: auth.PermissionPromotions},
+
+	exchangeRatesServicePrefix + "ListExchangeRates":   {permission: auth.PermissionExchangeRates},
+	exchangeRatesServicePrefix + "SetExchangeRates":    {permission: auth.PermissionExchangeRates},
+	exchangeRatesServicePrefix + "ImportExchangeRates": {permission: auth.PermissionExchangeRates},
+}
+
+// bookingOwnerFunc returns the booking with token.
+type bookingOwnerFunc func(ctx context.Context, token string) (*domain.Booking, error)
+
+// rbacUnaryInterceptor authorizes calls by methodPolicies with the roles and
+// scopes of the authenticated caller. When enforce is false, because JWT
+// authentication is not configured, only authenticated calls, such as those
+// with an API key, are authorized here; the admin APIs stay guarded by the
+// admin token.
+func rbacUnaryInterceptor(enforce bool, owner bookingOwnerFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, authenticated := auth.FromContext(ctx); enforce || authenticated {
+			if err := authorize(ctx, info.FullMethod, req, owner); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// rbacStreamInterceptor is rbacUnaryInterceptor for streaming calls, which
+// are authorized before the first request is received.
+func rbacStreamInterceptor(enforce bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, authenticated := auth.FromContext(ss.Context()); enforce || authenticated {
+			if err := authorize(ss.Context(), info.FullMethod, nil, nil); err != nil {
+				return err
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, fullMethod string, req interface{}, owner bookingOwnerFunc) error {
+	policy, ok := methodPolicies[fullMethod]
+	if !ok {
+		return status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+	claims, authenticated := auth.FromContext(ctx)
+	if !authenticated {
+		if policy.public {
+			return nil
+		}
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	switch claims.Scope(policy.permission) {
+	case auth.ScopeAny:
+		return nil
+	case auth.ScopeOwn:
+		if policy.self {
+			return nil
+		}
+		return checkOwnership(ctx, claims, req, owner)
+	}
+	if policy.public {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "permission %s required", policy.permission)
+}
+
+// checkOwnership lets a caller limited to its own bookings act only on
+// bookings made with its email or linked to its customer account, on the
+// booking of its manage session, or on the bookings made with its API key,
+// and book only for its email. API keys book for the customers of the
+// partner, whatever their email.
+func checkOwnership(ctx context.Context, claims *auth.Claims, req interface{}, owner bookingOwnerFunc) error {
+	switch r := req.(type) {
+	case interface{ GetToken() string }:
+		if owner == nil {
+			return status.Error(codes.PermissionDenied, "booking ownership cannot be checked")
+		}
+		b, err := owner(ctx, r.GetToken())
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			// Let the handler answer NotFound.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !ownsBooking(claims, b) {
+			return status.Error(codes.PermissionDenied, "booking belongs to another customer")
+		}
+	case interface{ GetEmail() string }:
+		if _, ok := claims.APIKeyID(); ok {
+			return nil
+		}
+		// Customer accounts book for their own email when none is given.
+		if email := r.GetEmail(); email != "" && !claims.Owns(email) {
+			return status.Error(codes.PermissionDenied, "customers can only book for their own email")
+		}
+	default:
+		return status.Error(codes.PermissionDenied, "request has no owner")
+	}
+	return nil
+}
+
+func ownsBooking(claims *auth.Claims, b *domain.Booking) bool {
+	// A manage-booking session owns its booking only, whatever the email.
+	if id, ok := claims.BookingID(); ok {
+		return b.ID == id
+	}
+	// An API key owns the bookings made with it, whatever the email.
+	if id, ok := claims.APIKeyID(); ok {
+		return b.APIKeyID == id
+	}
+	if id, ok := claims.CustomerID(); ok && b.CustomerID == id {
+		return true
+	}
+	return claims.Owns(b.Email)
+}
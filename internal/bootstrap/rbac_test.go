@@ -0,0 +1,334 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_exchange_rates_api"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_flights_api"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_promotions_api"
+	"github.com/Domenick1991/airbooking/internal/pb/bookings_api"
+	"github.com/Domenick1991/airbooking/internal/pb/customers_api"
+	"github.com/Domenick1991/airbooking/internal/pb/flights_api"
+	"github.com/Domenick1991/airbooking/internal/pb/loyalty_api"
+	"github.com/Domenick1991/airbooking/internal/pb/ops_api"
+	"github.com/Domenick1991/airbooking/internal/pb/wallet_api"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	callerAnonymous     = "anonymous"
+	callerCustomerOwn   = "customer, own booking"
+	callerCustomerOther = "customer, other booking"
+	callerAgent         = "agent"
+	callerSupport       = "support"
+	callerOps           = "ops"
+	callerAdmin         = "admin"
+)
+
+const (
+	customerEmail = "me@example.com"
+	customerID    = 7
+)
+
+var customerClaims = auth.Claims{
+	Email:            customerEmail,
+	Roles:            []string{"customer"},
+	RegisteredClaims: jwt.RegisteredClaims{Subject: auth.CustomerSubject(customerID)},
+}
+
+var rbacCallers = map[string]*auth.Claims{
+	callerCustomerOwn:   &customerClaims,
+	callerCustomerOther: &customerClaims,
+	callerAgent:         {Email: "agent@example.com", Roles: []string{"agent"}},
+	callerSupport:       {Email: "support@example.com", Roles: []string{"support"}},
+	callerOps:           {Email: "ops@example.com", Roles: []string{"ops"}},
+	callerAdmin:         {Email: "admin@example.com", Roles: []string{"admin"}},
+}
+
+func expect(anonymous, own, other, agent, support, ops, admin codes.Code) map[string]codes.Code {
+	return map[string]codes.Code{
+		callerAnonymous:     anonymous,
+		callerCustomerOwn:   own,
+		callerCustomerOther: other,
+		callerAgent:         agent,
+		callerSupport:       support,
+		callerOps:           ops,
+		callerAdmin:         admin,
+	}
+}
+
+const (
+	ok     = codes.OK
+	unauth = codes.Unauthenticated
+	denied = codes.PermissionDenied
+)
+
+var (
+	flightsRead    = expect(ok, ok, ok, ok, ok, ok, ok)
+	bookingsCreate = expect(unauth, ok, denied, ok, denied, denied, ok)
+	bookingsManage = expect(unauth, ok, denied, ok, ok, denied, ok)
+	bookingsRead   = expect(unauth, ok, denied, ok, ok, ok, ok)
+	waitlistJoin   = expect(unauth, ok, denied, ok, denied, denied, ok)
+	ownAccount     = expect(unauth, ok, ok, ok, ok, ok, ok)
+	sessionManage  = expect(unauth, ok, ok, ok, ok, denied, ok)
+	public         = expect(ok, ok, ok, ok, ok, ok, ok)
+)
+
+// rbacExpectations lists the outcome of every BookingsService,
+// FlightsService, CustomersService, LoyaltyService and WalletService RPC for every kind of caller. Calls on
+// the caller's own account or manage session pass for staff as well; the
+// handlers turn away callers without a customer account or manage session.
+var rbacExpectations = map[string]map[string]codes.Code{
+	flightsServicePrefix + "ListFlights":             flightsRead,
+	flightsServicePrefix + "GetFlight":               flightsRead,
+	flightsServicePrefix + "GetFlightStatus":         flightsRead,
+	flightsServicePrefix + "WatchFlightAvailability": flightsRead,
+
+	bookingsServicePrefix + "CreateBooking":     bookingsCreate,
+	bookingsServicePrefix + "ConfirmBooking":    bookingsManage,
+	bookingsServicePrefix + "CancelBooking":     bookingsManage,
+	bookingsServicePrefix + "ExtendHold":        bookingsManage,
+	bookingsServicePrefix + "ReservePayment":    bookingsManage,
+	bookingsServicePrefix + "ChangeSeat":        bookingsManage,
+	bookingsServicePrefix + "ChangeFlight":      bookingsManage,
+	bookingsServicePrefix + "CheckIn":           bookingsManage,
+	bookingsServicePrefix + "GetBookingHistory": bookingsRead,
+	bookingsServicePrefix + "JoinWaitlist":      waitlistJoin,
+	bookingsServicePrefix + "ListMyBookings":    ownAccount,
+
+	bookingsServicePrefix + "OpenManageLink":        public,
+	bookingsServicePrefix + "GetManagedBooking":     ownAccount,
+	bookingsServicePrefix + "ConfirmManagedBooking": sessionManage,
+	bookingsServicePrefix + "CancelManagedBooking":  sessionManage,
+
+	customersServicePrefix + "Register":            public,
+	customersServicePrefix + "ResendVerification":  public,
+	customersServicePrefix + "VerifyEmail":         public,
+	customersServicePrefix + "Login":               public,
+	customersServicePrefix + "RequestBookingClaim": ownAccount,
+	customersServicePrefix + "ClaimBookings":       ownAccount,
+
+	loyaltyServicePrefix + "Enroll":             ownAccount,
+	loyaltyServicePrefix + "GetLoyaltyAccount":  ownAccount,
+	loyaltyServicePrefix + "ListLoyaltyHistory": ownAccount,
+
+	walletServicePrefix + "GetWallet":         ownAccount,
+	walletServicePrefix + "ListWalletHistory": ownAccount,
+}
+
+func serviceMethods(t *testing.T, file protoreflect.FileDescriptor) []protoreflect.MethodDescriptor {
+	t.Helper()
+	require.Equal(t, 1, file.Services().Len())
+	methods := file.Services().Get(0).Methods()
+	out := make([]protoreflect.MethodDescriptor, 0, methods.Len())
+	for i := 0; i < methods.Len(); i++ {
+		out = append(out, methods.Get(i))
+	}
+	return out
+}
+
+func fullMethod(m protoreflect.MethodDescriptor) string {
+	return fmt.Sprintf("/%s/%s", m.Parent().FullName(), m.Name())
+}
+
+func TestMethodPoliciesCoverEveryRPC(t *testing.T) {
+	for _, file := range []protoreflect.FileDescriptor{
+		flights_api.File_api_flights_api_flights_proto,
+		bookings_api.File_api_bookings_api_bookings_proto,
+		admin_flights_api.File_api_admin_flights_api_admin_flights_proto,
+		ops_api.File_api_ops_api_ops_proto,
+		customers_api.File_api_customers_api_customers_proto,
+		loyalty_api.File_api_loyalty_api_loyalty_proto,
+		wallet_api.File_api_wallet_api_wallet_proto,
+		admin_promotions_api.File_api_admin_promotions_api_admin_promotions_proto,
+		admin_exchange_rates_api.File_api_admin_exchange_rates_api_admin_exchange_rates_proto,
+	} {
+		for _, m := range serviceMethods(t, file) {
+			_, ok := methodPolicies[fullMethod(m)]
+			assert.True(t, ok, "no policy for %s", fullMethod(m))
+		}
+	}
+}
+
+// newRequest returns the request of m naming the booking of the caller, or
+// of another customer.
+func newRequest(t *testing.T, m protoreflect.MethodDescriptor, own bool) interface{} {
+	t.Helper()
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(m.Input().FullName())
+	require.NoError(t, err)
+	msg := mt.New()
+	token, email := "other-token", "other@example.com"
+	if own {
+		token, email = "own-token", customerEmail
+	}
+	if fd := msg.Descriptor().Fields().ByName("token"); fd != nil {
+		msg.Set(fd, protoreflect.ValueOfString(token))
+	}
+	if fd := msg.Descriptor().Fields().ByName("email"); fd != nil {
+		msg.Set(fd, protoreflect.ValueOfString(email))
+	}
+	return msg.Interface()
+}
+
+func testBookingOwner(ctx context.Context, token string) (*domain.Booking, error) {
+	switch token {
+	case "own-token":
+		return &domain.Booking{ID: 1, Token: token, Email: customerEmail}, nil
+	case "claimed-token":
+		return &domain.Booking{ID: 2, Token: token, Email: "old@example.com", CustomerID: customerID}, nil
+	case "other-token":
+		return &domain.Booking{ID: 3, Token: token, Email: "other@example.com", CustomerID: customerID + 1}, nil
+	case "partner-token":
+		return &domain.Booking{ID: 4, Token: token, Email: customerEmail, APIKeyID: 7}, nil
+	}
+	return nil, domain.ErrBookingNotFound
+}
+
+func TestRBACCustomerFacingServices(t *testing.T) {
+	unary := rbacUnaryInterceptor(true, testBookingOwner)
+	stream := rbacStreamInterceptor(true)
+
+	var methods []protoreflect.MethodDescriptor
+	for _, file := range []protoreflect.FileDescriptor{
+		flights_api.File_api_flights_api_flights_proto,
+		bookings_api.File_api_bookings_api_bookings_proto,
+		customers_api.File_api_customers_api_customers_proto,
+		loyalty_api.File_api_loyalty_api_loyalty_proto,
+		wallet_api.File_api_wallet_api_wallet_proto,
+	} {
+		methods = append(methods, serviceMethods(t, file)...)
+	}
+	require.Len(t, rbacExpectations, len(methods))
+	for _, m := range methods {
+		expected, found := rbacExpectations[fullMethod(m)]
+		require.True(t, found, "no expectations for %s", fullMethod(m))
+		for caller, code := range expected {
+			t.Run(fmt.Sprintf("%s/%s", m.Name(), caller), func(t *testing.T) {
+				ctx := context.Background()
+				if claims := rbacCallers[caller]; claims != nil {
+					ctx = auth.WithClaims(ctx, claims)
+				}
+				called := false
+				var err error
+				if m.IsStreamingServer() {
+					err = stream(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: fullMethod(m), IsServerStream: true}, func(srv interface{}, ss grpc.ServerStream) error {
+						called = true
+						return nil
+					})
+				} else {
+					req := newRequest(t, m, caller != callerCustomerOther)
+					_, err = unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: fullMethod(m)}, func(ctx context.Context, req interface{}) (interface{}, error) {
+						called = true
+						return nil, nil
+					})
+				}
+				assert.Equal(t, code, status.Code(err), "%v", err)
+				assert.Equal(t, code == codes.OK, called)
+			})
+		}
+	}
+}
+
+func TestRBACCustomerAccountBookings(t *testing.T) {
+	interceptor := rbacUnaryInterceptor(true, testBookingOwner)
+	ctx := auth.WithClaims(context.Background(), &customerClaims)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	cancel := &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + "CancelBooking"}
+
+	_, err := interceptor(ctx, &bookings_api.BookingTokenRequest{Token: "claimed-token"}, cancel, handler)
+	assert.NoError(t, err, "bookings claimed by the account belong to it whatever their email")
+	_, err = interceptor(ctx, &bookings_api.BookingTokenRequest{Token: "other-token"}, cancel, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor(ctx, &bookings_api.CreateBookingRequest{FlightId: 4, SeatNumber: 1}, &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + "CreateBooking"}, handler)
+	assert.NoError(t, err, "an empty email books for the account email")
+}
+
+func TestRBACAPIKeyBookings(t *testing.T) {
+	interceptor := rbacUnaryInterceptor(true, testBookingOwner)
+	ctx := auth.WithClaims(context.Background(), &auth.Claims{
+		Scopes:           []string{"bookings:create", "bookings:manage"},
+		RegisteredClaims: jwt.RegisteredClaims{Subject: auth.APIKeySubject(7)},
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	call := func(method string, req interface{}) error {
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + method}, handler)
+		return err
+	}
+
+	assert.NoError(t, call("CancelBooking", &bookings_api.BookingTokenRequest{Token: "partner-token"}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("CancelBooking", &bookings_api.BookingTokenRequest{Token: "own-token"})),
+		"a key reaches the bookings made with it only, whatever their email")
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("CancelBooking", &bookings_api.BookingTokenRequest{Token: "other-token"})))
+	assert.NoError(t, call("CreateBooking", &bookings_api.CreateBookingRequest{Email: "traveller@example.com"}), "partners book for their customers")
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("GetBookingHistory", &bookings_api.BookingTokenRequest{Token: "partner-token"})),
+		"bookings:read is not granted")
+}
+
+func TestRBACManageSession(t *testing.T) {
+	interceptor := rbacUnaryInterceptor(true, testBookingOwner)
+	// The email is ignored: a session reaches the booking of its link only.
+	ctx := auth.WithClaims(context.Background(), &auth.Claims{
+		Email:            customerEmail,
+		Roles:            []string{"admin"},
+		RegisteredClaims: jwt.RegisteredClaims{Subject: auth.BookingSubject(3)},
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	call := func(method string, req interface{}) error {
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + method}, handler)
+		return err
+	}
+
+	assert.NoError(t, call("GetManagedBooking", nil))
+	assert.NoError(t, call("CancelManagedBooking", nil))
+	assert.NoError(t, call("ConfirmManagedBooking", nil))
+	assert.NoError(t, call("GetBookingHistory", &bookings_api.BookingTokenRequest{Token: "other-token"}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("CancelBooking", &bookings_api.BookingTokenRequest{Token: "own-token"})))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("CreateBooking", &bookings_api.CreateBookingRequest{Email: customerEmail})))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("JoinWaitlist", &bookings_api.JoinWaitlistRequest{Email: customerEmail})))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: opsServicePrefix + "CancelFlight"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "roles of a session are ignored")
+}
+
+func TestRBACUnknownBookingIsLeftToHandler(t *testing.T) {
+	interceptor := rbacUnaryInterceptor(true, func(ctx context.Context, token string) (*domain.Booking, error) {
+		return nil, domain.ErrBookingNotFound
+	})
+	ctx := auth.WithClaims(context.Background(), rbacCallers[callerCustomerOwn])
+	_, err := interceptor(ctx, &bookings_api.BookingTokenRequest{Token: "missing"}, &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + "CancelBooking"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, domain.ErrBookingNotFound
+	})
+	assert.ErrorIs(t, err, domain.ErrBookingNotFound)
+}
+
+func TestRBACStaffAndAdminAPIs(t *testing.T) {
+	interceptor := rbacUnaryInterceptor(true, nil)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	cancel := &grpc.UnaryServerInfo{FullMethod: opsServicePrefix + "CancelFlight"}
+	create := &grpc.UnaryServerInfo{FullMethod: adminServicePrefix + "CreateFlight"}
+
+	_, err := interceptor(auth.WithClaims(context.Background(), rbacCallers[callerOps]), nil, cancel, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(auth.WithClaims(context.Background(), rbacCallers[callerOps]), nil, create, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = interceptor(auth.WithClaims(context.Background(), rbacCallers[callerSupport]), nil, cancel, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = interceptor(auth.WithClaims(context.Background(), adminClaims), nil, create, handler)
+	assert.NoError(t, err, "the static admin token grants the admin role")
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/airbooking.unknown.Service/Method"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = rbacUnaryInterceptor(false, nil)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: bookingsServicePrefix + "CancelBooking"}, handler)
+	assert.NoError(t, err, "not enforced without JWT authentication")
+}
@@ -3,17 +3,41 @@ package bootstrap
 import (
 	"context"
 	"fmt"
+	"log"
 	"net"
 	"net/http"
 	"time"
 
 	"github.com/Domenick1991/airbooking/config"
+	adminexchangeratesapi "github.com/Domenick1991/airbooking/internal/api/admin_exchange_rates_service_api"
+	adminflightsapi "github.com/Domenick1991/airbooking/internal/api/admin_flights_service_api"
+	adminpromotionsapi "github.com/Domenick1991/airbooking/internal/api/admin_promotions_service_api"
 	bookingsapi "github.com/Domenick1991/airbooking/internal/api/bookings_service_api"
+	customersapi "github.com/Domenick1991/airbooking/internal/api/customers_service_api"
 	flightsapi "github.com/Domenick1991/airbooking/internal/api/flights_service_api"
+	loyaltyapi "github.com/Domenick1991/airbooking/internal/api/loyalty_service_api"
+	opsapi "github.com/Domenick1991/airbooking/internal/api/ops_service_api"
+	walletapi "github.com/Domenick1991/airbooking/internal/api/wallet_service_api"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_exchange_rates_api"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_flights_api"
+	"github.com/Domenick1991/airbooking/internal/pb/admin_promotions_api"
 	"github.com/Domenick1991/airbooking/internal/pb/bookings_api"
+	"github.com/Domenick1991/airbooking/internal/pb/customers_api"
 	"github.com/Domenick1991/airbooking/internal/pb/flights_api"
+	"github.com/Domenick1991/airbooking/internal/pb/loyalty_api"
+	"github.com/Domenick1991/airbooking/internal/pb/ops_api"
+	"github.com/Domenick1991/airbooking/internal/pb/wallet_api"
+	"github.com/Domenick1991/airbooking/internal/ratelimit"
+	"github.com/Domenick1991/airbooking/internal/service/apikeys"
+	"github.com/Domenick1991/airbooking/internal/service/availability"
 	"github.com/Domenick1991/airbooking/internal/service/booking"
+	"github.com/Domenick1991/airbooking/internal/service/customers"
+	"github.com/Domenick1991/airbooking/internal/service/exchangerates"
 	"github.com/Domenick1991/airbooking/internal/service/flights"
+	"github.com/Domenick1991/airbooking/internal/service/loyalty"
+	"github.com/Domenick1991/airbooking/internal/service/operations"
+	"github.com/Domenick1991/airbooking/internal/service/promotions"
+	"github.com/Domenick1991/airbooking/internal/service/wallet"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -25,8 +49,8 @@ type Servers struct {
 }
 
 // Run starts gRPC and HTTP (grpc-gateway + swagger) servers and blocks until context is canceled or a server fails.
-func Run(ctx context.Context, cfg *config.Config, flightSvc flights.FlightUseCase, bookingSvc booking.BookingUseCase) error {
-	s, err := newServers(cfg, flightSvc, bookingSvc)
+func Run(ctx context.Context, cfg *config.Config, flightSvc flights.FlightUseCase, bookingSvc booking.BookingUseCase, adminSvc flights.FlightAdminUseCase, opsSvc operations.OperationsUseCase, availabilitySvc availability.AvailabilityUseCase, customerSvc customers.CustomerUseCase, loyaltySvc loyalty.LoyaltyUseCase, walletSvc wallet.WalletUseCase, promotionSvc promotions.PromotionUseCase, exchangeRateSvc exchangerates.ExchangeRateUseCase, apiKeys apikeys.APIKeyUseCase, limiter ratelimit.Limiter) error {
+	s, err := newServers(cfg, flightSvc, bookingSvc, adminSvc, opsSvc, availabilitySvc, customerSvc, loyaltySvc, walletSvc, promotionSvc, exchangeRateSvc, apiKeys, limiter)
 	if err != nil {
 		return err
 	}
@@ -57,16 +81,69 @@ func Run(ctx context.Context, cfg *config.Config, flightSvc flights.FlightUseCas
 	}
 }
 
-func newServers(cfg *config.Config, flightSvc flights.FlightUseCase, bookingSvc booking.BookingUseCase) (*Servers, error) {
-	grpcSrv := grpc.NewServer()
-
-	flightsServer := flightsapi.NewServer(flightSvc)
-	bookingsServer := bookingsapi.NewServer(bookingSvc)
+func newServers(cfg *config.Config, flightSvc flights.FlightUseCase, bookingSvc booking.BookingUseCase, adminSvc flights.FlightAdminUseCase, opsSvc operations.OperationsUseCase, availabilitySvc availability.AvailabilityUseCase, customerSvc customers.CustomerUseCase, loyaltySvc loyalty.LoyaltyUseCase, walletSvc wallet.WalletUseCase, promotionSvc promotions.PromotionUseCase, exchangeRateSvc exchangerates.ExchangeRateUseCase, apiKeys apikeys.APIKeyUseCase, limiter ratelimit.Limiter) (*Servers, error) {
+	verifier, err := cfg.Auth.Verifier()
+	if err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+	if !verifier.Enabled() {
+		log.Printf("WARNING: JWT authentication is not configured, only the admin APIs are protected")
+	}
+	bookingOwner := bookingOwnerFunc(bookingSvc.GetBooking)
+	gatewayToken, err := newGatewayToken()
+	if err != nil {
+		return nil, fmt.Errorf("gateway token: %w", err)
+	}
+	limits := rateLimits{
+		IP:           ratelimit.Limit{PerMinute: cfg.RateLimit.IPPerMinute, Burst: cfg.RateLimit.IPBurst},
+		APIKey:       ratelimit.Limit{PerMinute: cfg.RateLimit.APIKeyPerMinute, Burst: cfg.RateLimit.APIKeyBurst},
+		GatewayToken: gatewayToken,
+	}
+	grpcSrv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			errorsUnaryInterceptor(),
+			jwtUnaryInterceptor(verifier, cfg.Admin.Token),
+			apiKeyUnaryInterceptor(apiKeys),
+			adminAuthUnaryInterceptor(cfg.Admin.Token),
+			auditUnaryInterceptor(),
+			rateLimitUnaryInterceptor(limiter, limits),
+			rbacUnaryInterceptor(verifier.Enabled(), bookingOwner),
+		),
+		grpc.ChainStreamInterceptor(
+			errorsStreamInterceptor(),
+			jwtStreamInterceptor(verifier, cfg.Admin.Token),
+			apiKeyStreamInterceptor(apiKeys),
+			rateLimitStreamInterceptor(limiter, limits),
+			rbacStreamInterceptor(verifier.Enabled()),
+		),
+	)
+
+	flightsServer := flightsapi.NewServer(flightSvc, availabilitySvc, exchangeRateSvc)
+	bookingsServer := bookingsapi.NewServer(bookingSvc, flightSvc)
+	adminFlightsServer := adminflightsapi.NewServer(adminSvc)
+	opsServer := opsapi.NewServer(opsSvc)
+	customersServer := customersapi.NewServer(customerSvc)
+	loyaltyServer := loyaltyapi.NewServer(loyaltySvc)
+	walletServer := walletapi.NewServer(walletSvc)
+	adminPromotionsServer := adminpromotionsapi.NewServer(promotionSvc)
+	adminExchangeRatesServer := adminexchangeratesapi.NewServer(exchangeRateSvc)
 
 	flights_api.RegisterFlightsServiceServer(grpcSrv, flightsServer)
 	bookings_api.RegisterBookingsServiceServer(grpcSrv, bookingsServer)
-
-	mux := runtime.NewServeMux()
+	admin_flights_api.RegisterAdminFlightsServiceServer(grpcSrv, adminFlightsServer)
+	ops_api.RegisterOpsServiceServer(grpcSrv, opsServer)
+	customers_api.RegisterCustomersServiceServer(grpcSrv, customersServer)
+	loyalty_api.RegisterLoyaltyServiceServer(grpcSrv, loyaltyServer)
+	wallet_api.RegisterWalletServiceServer(grpcSrv, walletServer)
+	admin_promotions_api.RegisterAdminPromotionsServiceServer(grpcSrv, adminPromotionsServer)
+	admin_exchange_rates_api.RegisterAdminExchangeRatesServiceServer(grpcSrv, adminExchangeRatesServer)
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithMetadata(gatewayMetadata(limits.GatewayToken)),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	if err := flights_api.RegisterFlightsServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
 		return nil, fmt.Errorf("register flights gateway: %w", err)
@@ -74,9 +151,31 @@ func newServers(cfg *config.Config, flightSvc flights.FlightUseCase, bookingSvc
 	if err := bookings_api.RegisterBookingsServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
 		return nil, fmt.Errorf("register bookings gateway: %w", err)
 	}
+	if err := admin_flights_api.RegisterAdminFlightsServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
+		return nil, fmt.Errorf("register admin flights gateway: %w", err)
+	}
+	if err := ops_api.RegisterOpsServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
+		return nil, fmt.Errorf("register ops gateway: %w", err)
+	}
+	if err := customers_api.RegisterCustomersServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
+		return nil, fmt.Errorf("register customers gateway: %w", err)
+	}
+	if err := loyalty_api.RegisterLoyaltyServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
+		return nil, fmt.Errorf("register loyalty gateway: %w", err)
+	}
+	if err := wallet_api.RegisterWalletServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
+		return nil, fmt.Errorf("register wallet gateway: %w", err)
+	}
+	if err := admin_promotions_api.RegisterAdminPromotionsServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
+		return nil, fmt.Errorf("register admin promotions gateway: %w", err)
+	}
+	if err := admin_exchange_rates_api.RegisterAdminExchangeRatesServiceHandlerFromEndpoint(context.Background(), mux, cfg.GRPC.Address, opts); err != nil {
+		return nil, fmt.Errorf("register admin exchange rates gateway: %w", err)
+	}
 
 	handler := http.NewServeMux()
-	handler.Handle("/", mux)
+	handler.Handle("/", apiKeyHTTPMiddleware(apiKeys, rateLimitHTTPMiddleware(limiter, limits, mux)))
+	handler.Handle("GET /api/v1/flights/{id}/availability/events", jwtHTTPMiddleware(verifier, apiKeyHTTPMiddleware(apiKeys, rateLimitHTTPMiddleware(limiter, limits, availabilityEventsHandler(availabilitySvc)))))
 
 	if cfg.HTTP.SwaggerDir != "" {
 		fs := http.FileServer(http.Dir(cfg.HTTP.SwaggerDir))
@@ -89,6 +188,34 @@ func newServers(cfg *config.Config, flightSvc flights.FlightUseCase, bookingSvc
 		handler.HandleFunc("/docs/flights", func(w http.ResponseWriter, r *http.Request) {
 			renderSwaggerUI(w, "/swagger/flights.swagger.json")
 		})
+
+		handler.HandleFunc("/docs/admin/flights", func(w http.ResponseWriter, r *http.Request) {
+			renderSwaggerUI(w, "/swagger/admin_flights.swagger.json")
+		})
+
+		handler.HandleFunc("/docs/ops", func(w http.ResponseWriter, r *http.Request) {
+			renderSwaggerUI(w, "/swagger/ops.swagger.json")
+		})
+
+		handler.HandleFunc("/docs/customers", func(w http.ResponseWriter, r *http.Request) {
+			renderSwaggerUI(w, "/swagger/customers.swagger.json")
+		})
+
+		handler.HandleFunc("/docs/loyalty", func(w http.ResponseWriter, r *http.Request) {
+			renderSwaggerUI(w, "/swagger/loyalty.swagger.json")
+		})
+
+		handler.HandleFunc("/docs/wallet", func(w http.ResponseWriter, r *http.Request) {
+			renderSwaggerUI(w, "/swagger/wallet.swagger.json")
+		})
+
+		handler.HandleFunc("/docs/admin/promotions", func(w http.ResponseWriter, r *http.Request) {
+			renderSwaggerUI(w, "/swagger/admin_promotions.swagger.json")
+		})
+
+		handler.HandleFunc("/docs/admin/exchange-rates", func(w http.ResponseWriter, r *http.Request) {
+			renderSwaggerUI(w, "/swagger/admin_exchange_rates.swagger.json")
+		})
 	}
 
 	httpSrv := &http.Server{
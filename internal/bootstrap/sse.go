@@ -0,0 +1,68 @@
+package bootstrap
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/api/pbconv"
+	"github.com/Domenick1991/airbooking/internal/service/availability"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const sseKeepAlive = 15 * time.Second
+
+// availabilityEventsHandler serves WatchFlightAvailability as server-sent
+// events for browsers. Each update is an "availability" event whose data is
+// the FlightAvailability message in the gateway JSON encoding.
+func availabilityEventsHandler(watcher availability.AvailabilityUseCase) http.HandlerFunc {
+	marshaler := protojson.MarshalOptions{EmitUnpopulated: true}
+	return func(w http.ResponseWriter, r *http.Request) {
+		flightID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid flight id", http.StatusBadRequest)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		updates, err := watcher.Watch(r.Context(), flightID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(sseKeepAlive)
+		defer keepAlive.Stop()
+		for {
+			select {
+			case u, ok := <-updates:
+				if !ok {
+					return
+				}
+				data, err := marshaler.Marshal(pbconv.FlightAvailability(u))
+				if err != nil {
+					return
+				}
+				if _, err := fmt.Fprintf(w, "event: availability\ndata: %s\n\n", data); err != nil {
+					return
+				}
+			case <-keepAlive.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	}
+}
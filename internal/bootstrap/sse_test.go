@@ -0,0 +1,76 @@
+package bootstrap
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/service/availability"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWatcher struct {
+	updates []availability.Update
+}
+
+func (f *fakeWatcher) Watch(ctx context.Context, flightID int64) (<-chan availability.Update, error) {
+	if flightID != 7 {
+		return nil, domain.ErrFlightNotFound
+	}
+	ch := make(chan availability.Update, len(f.updates))
+	for _, u := range f.updates {
+		ch <- u
+	}
+	close(ch)
+	return ch, nil
+}
+
+func (f *fakeWatcher) HandleBookingEvent(ctx context.Context, event kafka.BookingEvent) error {
+	return nil
+}
+
+func TestAvailabilityEventsHandler(t *testing.T) {
+	at := time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC)
+	watcher := &fakeWatcher{updates: []availability.Update{
+		{FlightID: 7, TotalSeats: 10, AvailableSeats: 3, Event: availability.SnapshotEvent, At: at},
+		{FlightID: 7, TotalSeats: 10, AvailableSeats: 2, Event: "booking_created", SeatNumber: 4, SeatState: availability.SeatHeld, At: at},
+	}}
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v1/flights/{id}/availability/events", availabilityEventsHandler(watcher))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/api/v1/flights/7/availability/events")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var events []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+			events = append(events, data)
+		}
+	}
+	assert.Len(t, events, 2)
+	assert.Contains(t, events[0], `"event":"snapshot"`)
+	assert.Contains(t, events[1], `"seatState":"HELD"`)
+	assert.Contains(t, events[1], `"availableSeats":2`)
+
+	resp, err = http.Get(srv.URL + "/api/v1/flights/8/availability/events")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get(srv.URL + "/api/v1/flights/abc/availability/events")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
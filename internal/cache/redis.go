@@ -47,11 +47,22 @@ func (c *RedisCache) SetFlights(ctx context.Context, flights []domain.Flight) er
 	return c.client.Set(ctx, flightsKey(), payload, c.flightsTTL).Err()
 }
 
+// InvalidateFlights drops the cached flights list so the next read goes to Postgres.
+func (c *RedisCache) InvalidateFlights(ctx context.Context) error {
+	return c.client.Del(ctx, flightsKey()).Err()
+}
+
 func (c *RedisCache) AcquireSeatLock(ctx context.Context, flightID int64, seat int, ttl time.Duration) (bool, error) {
 	key := seatLockKey(flightID, seat)
 	return c.client.SetNX(ctx, key, "locked", ttl).Result()
 }
 
+// ExtendSeatLock sets the TTL of a seat lock held by a pending booking,
+// taking the lock again if it has already expired.
+func (c *RedisCache) ExtendSeatLock(ctx context.Context, flightID int64, seat int, ttl time.Duration) error {
+	return c.client.Set(ctx, seatLockKey(flightID, seat), "locked", ttl).Err()
+}
+
 func (c *RedisCache) ReleaseSeatLock(ctx context.Context, flightID int64, seat int) error {
 	return c.client.Del(ctx, seatLockKey(flightID, seat)).Err()
 }
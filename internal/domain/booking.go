@@ -2,6 +2,13 @@ package domain
 
 import "time"
 
+var (
+	ErrBookingNotFound  = &NotFoundError{Resource: "booking"}
+	ErrNoSeatsAvailable = &SeatUnavailableError{Code: "NO_SEATS_AVAILABLE", Message: "no available seats"}
+	ErrSeatTaken        = &SeatUnavailableError{Code: "SEAT_TAKEN", Message: "seat is already taken"}
+	ErrBookingNotActive = &InvalidStateError{Code: "BOOKING_NOT_ACTIVE", Message: "booking is not pending or confirmed"}
+)
+
 type BookingStatus string
 
 const (
@@ -9,6 +16,13 @@ const (
 	BookingStatusConfirmed BookingStatus = "CONFIRMED"
 	BookingStatusCancelled BookingStatus = "CANCELLED"
 	BookingStatusExpired   BookingStatus = "EXPIRED"
+	// BookingStatusDeniedBoarding is a confirmed booking taken off an
+	// oversold flight. See DeniedBoarding.
+	BookingStatusDeniedBoarding BookingStatus = "DENIED_BOARDING"
+	BookingStatusCheckedIn      BookingStatus = "CHECKED_IN"
+	BookingStatusFlown          BookingStatus = "FLOWN"
+	BookingStatusNoShow         BookingStatus = "NO_SHOW"
+	BookingStatusRefunded       BookingStatus = "REFUNDED"
 )
 
 type Booking struct {
@@ -19,6 +33,26 @@ type Booking struct {
 	Status     BookingStatus
 	ExpiresAt  time.Time
 	Email      string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// CustomerID is the account the booking belongs to, zero for guest
+	// bookings.
+	CustomerID int64
+	// APIKeyID is the partner API key the booking was made with, zero for
+	// bookings made otherwise.
+	APIKeyID  int64
+	Channel   Channel
+	FareClass FareClass
+	// PriceCents is the fare paid, taken from the flight when booked.
+	PriceCents int64
+	// PromoCode is the promo code the booking was made with and
+	// DiscountCents what it took off PriceCents.
+	PromoCode     string
+	DiscountCents int64
+	// Charges are the taxes and fees paid on top of PriceCents.
+	Charges FareCharges
+	// Currency of the prices, that of the flight when booked.
+	Currency string
+	// HoldExtensions counts how many times the pending hold was extended.
+	HoldExtensions int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
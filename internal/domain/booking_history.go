@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// BookingTransition is one entry of the status history of a booking. From is
+// empty for the creation of the booking.
+type BookingTransition struct {
+	ID        int64
+	BookingID int64
+	Token     string
+	From      BookingStatus
+	To        BookingStatus
+	Actor     string
+	Reason    string
+	RequestID string
+	CreatedAt time.Time
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidBookingStatus     = NewValidationError("status", "invalid booking status")
+	ErrInvalidBookingTransition = &InvalidStateError{Code: "INVALID_BOOKING_STATUS_TRANSITION", Message: "invalid booking status transition"}
+	ErrCheckInClosed            = &InvalidStateError{Code: "CHECK_IN_CLOSED", Message: "check-in is closed for this flight"}
+)
+
+// bookingStatusTransitions lists the statuses reachable from each status.
+// EXPIRED, NO_SHOW, FLOWN and REFUNDED are final. A checked-in booking
+// rebooked off a cancelled flight is confirmed again on its new flight.
+var bookingStatusTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusPending:        {BookingStatusConfirmed, BookingStatusCancelled, BookingStatusExpired},
+	BookingStatusConfirmed:      {BookingStatusCheckedIn, BookingStatusCancelled, BookingStatusDeniedBoarding, BookingStatusNoShow},
+	BookingStatusCheckedIn:      {BookingStatusConfirmed, BookingStatusFlown, BookingStatusDeniedBoarding, BookingStatusNoShow},
+	BookingStatusCancelled:      {BookingStatusRefunded},
+	BookingStatusDeniedBoarding: {BookingStatusRefunded},
+}
+
+// BookingTransitionError is returned when a booking cannot move from its
+// current status to the requested one. It matches ErrInvalidBookingTransition.
+type BookingTransitionError struct {
+	From BookingStatus
+	To   BookingStatus
+}
+
+func (e *BookingTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s -> %s", ErrInvalidBookingTransition, e.From, e.To)
+}
+
+func (e *BookingTransitionError) Unwrap() error {
+	return ErrInvalidBookingTransition
+}
+
+func (s BookingStatus) Valid() bool {
+	switch s {
+	case BookingStatusPending, BookingStatusConfirmed, BookingStatusCancelled, BookingStatusExpired,
+		BookingStatusDeniedBoarding, BookingStatusCheckedIn, BookingStatusFlown, BookingStatusNoShow, BookingStatusRefunded:
+		return true
+	}
+	return false
+}
+
+// ParseBookingStatus accepts a case-insensitive booking status.
+func ParseBookingStatus(s string) (BookingStatus, error) {
+	status := BookingStatus(strings.ToUpper(s))
+	if !status.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrInvalidBookingStatus, s)
+	}
+	return status, nil
+}
+
+// CanTransitionTo reports whether a booking may move from s to next.
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	for _, allowed := range bookingStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition checks that the booking may move to next.
+func (b Booking) Transition(next BookingStatus) error {
+	if !b.Status.CanTransitionTo(next) {
+		return &BookingTransitionError{From: b.Status, To: next}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingStatus_CanTransitionTo(t *testing.T) {
+	testCases := []struct {
+		from, to BookingStatus
+		allowed  bool
+	}{
+		{BookingStatusPending, BookingStatusConfirmed, true},
+		{BookingStatusPending, BookingStatusExpired, true},
+		{BookingStatusPending, BookingStatusCheckedIn, false},
+		{BookingStatusConfirmed, BookingStatusCheckedIn, true},
+		{BookingStatusConfirmed, BookingStatusCancelled, true},
+		{BookingStatusConfirmed, BookingStatusFlown, false},
+		{BookingStatusCheckedIn, BookingStatusFlown, true},
+		{BookingStatusCheckedIn, BookingStatusConfirmed, true},
+		{BookingStatusCheckedIn, BookingStatusCancelled, false},
+		{BookingStatusCancelled, BookingStatusRefunded, true},
+		{BookingStatusCancelled, BookingStatusCancelled, false},
+		{BookingStatusExpired, BookingStatusCancelled, false},
+		{BookingStatusFlown, BookingStatusRefunded, false},
+		{BookingStatusRefunded, BookingStatusConfirmed, false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.allowed, tc.from.CanTransitionTo(tc.to), "%s -> %s", tc.from, tc.to)
+	}
+}
+
+func TestBooking_Transition(t *testing.T) {
+	assert.NoError(t, Booking{Status: BookingStatusPending}.Transition(BookingStatusConfirmed))
+
+	err := Booking{Status: BookingStatusExpired}.Transition(BookingStatusConfirmed)
+	assert.ErrorIs(t, err, ErrInvalidBookingTransition)
+	assert.Equal(t, &BookingTransitionError{From: BookingStatusExpired, To: BookingStatusConfirmed}, err)
+	assert.EqualError(t, err, "invalid booking status transition: EXPIRED -> CONFIRMED")
+}
+
+func TestParseBookingStatus(t *testing.T) {
+	status, err := ParseBookingStatus("no_show")
+	assert.NoError(t, err)
+	assert.Equal(t, BookingStatusNoShow, status)
+
+	_, err = ParseBookingStatus("BOARDED")
+	assert.ErrorIs(t, err, ErrInvalidBookingStatus)
+}
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultCurrency is the currency of flights created without one and of the
+// prices stored before currencies were recorded.
+const DefaultCurrency = "RUB"
+
+// RateScale is the number of decimal places exchange rates are kept with.
+const RateScale = 12
+
+var (
+	ErrInvalidCurrency      = NewValidationError("currency", "currency must be an ISO 4217 code")
+	ErrExchangeRateNotFound = &NotFoundError{Resource: "exchange rate"}
+	ErrCurrencyMismatch     = &InvalidStateError{Code: "CURRENCY_MISMATCH", Message: "flight is priced in another currency than the booking"}
+)
+
+// minorUnits are the decimal places of the ISO 4217 currencies.
+var minorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2, "AUD": 2, "AWG": 2, "AZN": 2,
+	"BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2, "BMD": 2, "BND": 2, "BOB": 2, "BRL": 2, "BSD": 2, "BTN": 2,
+	"BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHF": 2, "CNY": 2, "COP": 2, "CRC": 2, "CUP": 2,
+	"CVE": 2, "CZK": 2, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2, "EUR": 2, "FJD": 2,
+	"FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2, "GMD": 2, "GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2,
+	"HTG": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "IRR": 2, "JMD": 2, "KES": 2, "KGS": 2, "KHR": 2,
+	"KPW": 2, "KYD": 2, "KZT": 2, "LAK": 2, "LBP": 2, "LKR": 2, "LRD": 2, "LSL": 2, "MAD": 2, "MDL": 2,
+	"MGA": 2, "MKD": 2, "MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2, "MWK": 2, "MXN": 2,
+	"MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2, "NOK": 2, "NPR": 2, "NZD": 2, "PAB": 2, "PEN": 2,
+	"PGK": 2, "PHP": 2, "PKR": 2, "PLN": 2, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2, "SAR": 2, "SBD": 2,
+	"SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2, "SHP": 2, "SLE": 2, "SOS": 2, "SRD": 2, "SSP": 2, "STN": 2,
+	"SVC": 2, "SYP": 2, "SZL": 2, "THB": 2, "TJS": 2, "TMT": 2, "TOP": 2, "TRY": 2, "TTD": 2, "TWD": 2,
+	"TZS": 2, "UAH": 2, "USD": 2, "UYU": 2, "UZS": 2, "VED": 2, "VES": 2, "WST": 2, "XCD": 2, "YER": 2,
+	"ZAR": 2, "ZMW": 2, "ZWG": 2,
+}
+
+// ParseCurrency accepts a case-insensitive ISO 4217 code; empty means
+// DefaultCurrency.
+func ParseCurrency(s string) (string, error) {
+	if strings.TrimSpace(s) == "" {
+		return DefaultCurrency, nil
+	}
+	code, ok := currencyCode(s)
+	if !ok {
+		return "", ErrInvalidCurrency
+	}
+	return code, nil
+}
+
+func currencyCode(s string) (string, bool) {
+	code := strings.ToUpper(strings.TrimSpace(s))
+	_, ok := minorUnits[code]
+	return code, ok
+}
+
+// MinorUnits returns the decimal places of a currency, 2 for unknown codes.
+func MinorUnits(currency string) int {
+	if units, ok := minorUnits[currency]; ok {
+		return units
+	}
+	return 2
+}
+
+// Money is an amount in the minor units of its currency: cents, or whole
+// yen for JPY. The _cents amounts of flights and bookings are in the minor
+// units of their currency.
+type Money struct {
+	Cents    int64
+	Currency string
+}
+
+// Amount renders the amount in major units, e.g. "1234.50" for 123450 RUB
+// and "1234" for 1234 JPY.
+func (m Money) Amount() string {
+	units := MinorUnits(m.Currency)
+	return new(big.Rat).SetFrac(big.NewInt(m.Cents), pow10(units)).FloatString(units)
+}
+
+func (m Money) String() string {
+	return m.Amount() + " " + m.Currency
+}
+
+// ExchangeRate is the price of one unit of Base in units of Quote.
+type ExchangeRate struct {
+	Base      string
+	Quote     string
+	Rate      *big.Rat
+	UpdatedAt time.Time
+}
+
+// ParseRate accepts a positive decimal such as "92.5" or "0.0108".
+func ParseRate(s string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, fmt.Errorf("invalid exchange rate %q", s)
+	}
+	return rate, nil
+}
+
+// FormatRate renders a rate with up to RateScale decimals.
+func FormatRate(rate *big.Rat) string {
+	if rate == nil {
+		return ""
+	}
+	s := rate.FloatString(RateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// Validate normalizes the currencies, rounds the rate to RateScale decimals
+// and checks the rate.
+func (r *ExchangeRate) Validate() error {
+	var violations []FieldViolation
+	var ok bool
+	if r.Base, ok = currencyCode(r.Base); !ok {
+		violations = append(violations, FieldViolation{Field: "base", Description: "base must be an ISO 4217 code"})
+	}
+	if r.Quote, ok = currencyCode(r.Quote); !ok {
+		violations = append(violations, FieldViolation{Field: "quote", Description: "quote must be an ISO 4217 code"})
+	} else if r.Quote == r.Base {
+		violations = append(violations, FieldViolation{Field: "quote", Description: "quote must differ from base"})
+	}
+	if r.Rate != nil {
+		r.Rate = roundRat(r.Rate, RateScale)
+	}
+	if r.Rate == nil || r.Rate.Sign() <= 0 {
+		violations = append(violations, FieldViolation{Field: "rate", Description: "rate must be positive"})
+	}
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// ExchangeRates converts money with a set of rates. Rates are used both
+// ways, and through a common currency when two currencies have no rate of
+// their own. The zero value only converts a currency to itself.
+type ExchangeRates struct {
+	rates map[string]map[string]*big.Rat
+}
+
+// NewExchangeRates indexes rates. A rate given for a pair wins over the
+// inverse of the rate of the opposite pair.
+func NewExchangeRates(rates []ExchangeRate) ExchangeRates {
+	x := ExchangeRates{rates: make(map[string]map[string]*big.Rat)}
+	set := func(from, to string, rate *big.Rat) {
+		if x.rates[from] == nil {
+			x.rates[from] = make(map[string]*big.Rat)
+		}
+		x.rates[from][to] = rate
+	}
+	for _, r := range rates {
+		if r.Rate != nil && r.Rate.Sign() > 0 {
+			set(r.Base, r.Quote, r.Rate)
+		}
+	}
+	for _, r := range rates {
+		if r.Rate != nil && r.Rate.Sign() > 0 && x.rates[r.Quote][r.Base] == nil {
+			set(r.Quote, r.Base, new(big.Rat).Inv(r.Rate))
+		}
+	}
+	return x
+}
+
+// Rate returns the price of one unit of from in units of to.
+func (x ExchangeRates) Rate(from, to string) (*big.Rat, bool) {
+	if from == to {
+		return big.NewRat(1, 1), true
+	}
+	if rate, ok := x.rates[from][to]; ok {
+		return rate, true
+	}
+	via := make([]string, 0, len(x.rates[from]))
+	for c := range x.rates[from] {
+		via = append(via, c)
+	}
+	sort.Strings(via)
+	for _, c := range via {
+		if second, ok := x.rates[c][to]; ok {
+			return new(big.Rat).Mul(x.rates[from][c], second), true
+		}
+	}
+	return nil, false
+}
+
+// Convert returns m in currency to, rounded half away from zero to the
+// minor units of to.
+func (x ExchangeRates) Convert(m Money, to string) (Money, error) {
+	if m.Currency == to {
+		return m, nil
+	}
+	rate, ok := x.Rate(m.Currency, to)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s to %s", ErrExchangeRateNotFound, m.Currency, to)
+	}
+	v := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Cents), rate)
+	v.Mul(v, new(big.Rat).SetFrac(pow10(MinorUnits(to)), pow10(MinorUnits(m.Currency))))
+	return Money{Cents: roundRat(v, 0).Num().Int64(), Currency: to}, nil
+}
+
+// roundRat rounds v half away from zero to scale decimals.
+func roundRat(v *big.Rat, scale int) *big.Rat {
+	unit := pow10(scale)
+	num := new(big.Int).Mul(v.Num(), unit)
+	q, r := new(big.Int).QuoRem(num, v.Denom(), new(big.Int))
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(num.Sign())))
+	}
+	return new(big.Rat).SetFrac(q, unit)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
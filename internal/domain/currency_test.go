@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	code, err := ParseCurrency(" eur ")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", code)
+
+	code, err = ParseCurrency("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCurrency, code)
+
+	_, err = ParseCurrency("EURO")
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestMoney_Amount(t *testing.T) {
+	assert.Equal(t, "1234.50", Money{Cents: 123450, Currency: "RUB"}.Amount())
+	assert.Equal(t, "1234", Money{Cents: 1234, Currency: "JPY"}.Amount())
+	assert.Equal(t, "1.234", Money{Cents: 1234, Currency: "KWD"}.Amount())
+	assert.Equal(t, "-0.05 USD", Money{Cents: -5, Currency: "USD"}.String())
+}
+
+func TestExchangeRate_Validate(t *testing.T) {
+	rate := ExchangeRate{Base: "eur", Quote: "rub", Rate: big.NewRat(1, 3)}
+	require.NoError(t, rate.Validate())
+	assert.Equal(t, "EUR", rate.Base)
+	assert.Equal(t, "0.333333333333", FormatRate(rate.Rate))
+
+	invalid := ExchangeRate{Base: "EUR", Quote: "eur", Rate: big.NewRat(0, 1)}
+	var validation *ValidationError
+	require.ErrorAs(t, invalid.Validate(), &validation)
+	assert.Len(t, validation.Violations, 2)
+}
+
+func TestExchangeRates_Convert(t *testing.T) {
+	rates := NewExchangeRates([]ExchangeRate{
+		{Base: "EUR", Quote: "RUB", Rate: big.NewRat(9525, 100)},
+		{Base: "USD", Quote: "RUB", Rate: big.NewRat(8750, 100)},
+		{Base: "USD", Quote: "JPY", Rate: big.NewRat(15025, 100)},
+		{Base: "USD", Quote: "KWD", Rate: big.NewRat(3075, 10000)},
+	})
+
+	tests := []struct {
+		name string
+		from Money
+		to   string
+		want int64
+	}{
+		{"same currency", Money{Cents: 100, Currency: "RUB"}, "RUB", 100},
+		{"direct rate", Money{Cents: 1000, Currency: "EUR"}, "RUB", 95250},
+		{"inverse rate rounds half up", Money{Cents: 4375, Currency: "RUB"}, "USD", 50},
+		{"to a currency without minor units", Money{Cents: 1001, Currency: "USD"}, "JPY", 1504},
+		{"to three decimals", Money{Cents: 1001, Currency: "USD"}, "KWD", 3078},
+		{"cross rate through a common currency", Money{Cents: 10000, Currency: "EUR"}, "USD", 10886},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rates.Convert(tt.from, tt.to)
+			require.NoError(t, err)
+			assert.Equal(t, Money{Cents: tt.want, Currency: tt.to}, got)
+		})
+	}
+
+	_, err := rates.Convert(Money{Cents: 100, Currency: "GBP"}, "RUB")
+	assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+var (
+	ErrCustomerNotFound    = &NotFoundError{Resource: "customer"}
+	ErrEmailTaken          = &InvalidStateError{Code: "EMAIL_TAKEN", Message: "an account with this email already exists"}
+	ErrEmailNotVerified    = &InvalidStateError{Code: "EMAIL_NOT_VERIFIED", Message: "email is not verified"}
+	ErrVerificationInvalid = &InvalidStateError{Code: "VERIFICATION_INVALID", Message: "verification code is invalid, used or expired"}
+	ErrInvalidCredentials  = &UnauthenticatedError{Code: "INVALID_CREDENTIALS", Message: "invalid email or password"}
+	ErrCustomerRequired    = &UnauthenticatedError{Code: "CUSTOMER_LOGIN_REQUIRED", Message: "log in with a customer account"}
+)
+
+// Password length limits. bcrypt ignores everything past 72 bytes.
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+// Customer is a registered customer. Bookings made while logged in, and
+// guest bookings claimed later, are linked to the account.
+type Customer struct {
+	ID           int64
+	Email        string
+	PasswordHash string
+	// EmailVerifiedAt is zero until the customer follows the verification
+	// email; unverified customers cannot log in.
+	EmailVerifiedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (c Customer) Verified() bool {
+	return !c.EmailVerifiedAt.IsZero()
+}
+
+// VerificationPurpose says what an emailed verification code proves.
+type VerificationPurpose string
+
+const (
+	// VerificationPurposeEmail verifies the email of a new account.
+	VerificationPurposeEmail VerificationPurpose = "VERIFY_EMAIL"
+	// VerificationPurposeClaim links the guest bookings made with an email
+	// to the account of the customer who received the code.
+	VerificationPurposeClaim VerificationPurpose = "CLAIM_BOOKINGS"
+)
+
+// EmailVerification is a one-time code sent to Email. Only the hash of the
+// code is stored.
+type EmailVerification struct {
+	ID         int64
+	CustomerID int64
+	Email      string
+	Purpose    VerificationPurpose
+	TokenHash  string
+	ExpiresAt  time.Time
+	UsedAt     time.Time
+	CreatedAt  time.Time
+}
+
+// NewEmailVerification generates a code for purpose, valid for ttl, and
+// returns it with its stored form.
+func NewEmailVerification(customerID int64, email string, purpose VerificationPurpose, ttl time.Duration, now time.Time) (string, EmailVerification, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", EmailVerification{}, err
+	}
+	code := base64.RawURLEncoding.EncodeToString(secret)
+	return code, EmailVerification{
+		CustomerID: customerID,
+		Email:      email,
+		Purpose:    purpose,
+		TokenHash:  HashVerificationCode(code),
+		ExpiresAt:  now.Add(ttl),
+	}, nil
+}
+
+// HashVerificationCode returns the stored form of a verification code.
+func HashVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// NormalizeEmail checks that email is a bare address and lower-cases it, so
+// that an account matches bookings made with any spelling of the address.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", NewValidationError("email", "email is required")
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", NewValidationError("email", "email is not a valid address")
+	}
+	return strings.ToLower(email), nil
+}
+
+// ValidatePassword checks the length of a new password.
+func ValidatePassword(password string) error {
+	switch {
+	case len(password) < MinPasswordLength:
+		return NewValidationError("password", "password must be at least 8 characters")
+	case len(password) > MaxPasswordLength:
+		return NewValidationError("password", "password must be at most 72 bytes")
+	}
+	return nil
+}
+
+// BookingPage selects a page of the bookings of a customer, newest first.
+type BookingPage struct {
+	// Statuses filters the bookings; empty means any status.
+	Statuses []BookingStatus
+	// BeforeID continues a listing after the booking with this id; zero
+	// starts from the newest booking.
+	BeforeID int64
+	Limit    int
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	email, err := NormalizeEmail("  Me@Example.COM ")
+	require.NoError(t, err)
+	assert.Equal(t, "me@example.com", email)
+
+	for _, bad := range []string{"", "me", "Me <me@example.com>", "me@example.com, you@example.com"} {
+		_, err := NormalizeEmail(bad)
+		assert.Equal(t, "email", Violations(err)[0].Field, bad)
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.Error(t, ValidatePassword("1234567"))
+	assert.NoError(t, ValidatePassword("12345678"))
+	assert.NoError(t, ValidatePassword(strings.Repeat("x", MaxPasswordLength)))
+	assert.Error(t, ValidatePassword(strings.Repeat("x", MaxPasswordLength+1)))
+}
+
+func TestNewEmailVerification(t *testing.T) {
+	now := time.Now()
+	code, v, err := NewEmailVerification(7, "me@example.com", VerificationPurposeClaim, time.Hour, now)
+	require.NoError(t, err)
+	assert.NotEmpty(t, code)
+	assert.Equal(t, HashVerificationCode(code), v.TokenHash)
+	assert.NotContains(t, v.TokenHash, code)
+	assert.Equal(t, now.Add(time.Hour), v.ExpiresAt)
+	assert.Equal(t, VerificationPurposeClaim, v.Purpose)
+}
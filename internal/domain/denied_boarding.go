@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+var (
+	ErrInvalidCompensation = NewValidationError("compensation", "compensation must not be negative")
+	ErrFlightNotOversold   = &InvalidStateError{Code: "FLIGHT_NOT_OVERSOLD", Message: "flight is not oversold"}
+)
+
+// DeniedBoardingKind tells whether the passenger gave up the seat or was
+// taken off the flight by the airline.
+type DeniedBoardingKind string
+
+const (
+	DeniedBoardingVoluntary   DeniedBoardingKind = "VOLUNTARY"
+	DeniedBoardingInvoluntary DeniedBoardingKind = "INVOLUNTARY"
+)
+
+// DeniedBoardingCompensation is what a denied passenger is paid, by kind.
+type DeniedBoardingCompensation struct {
+	VoluntaryCents   int64
+	InvoluntaryCents int64
+}
+
+func (c DeniedBoardingCompensation) Validate() error {
+	if c.VoluntaryCents < 0 || c.InvoluntaryCents < 0 {
+		return ErrInvalidCompensation
+	}
+	return nil
+}
+
+// For returns the compensation for a denied boarding of the given kind.
+func (c DeniedBoardingCompensation) For(kind DeniedBoardingKind) int64 {
+	if kind == DeniedBoardingVoluntary {
+		return c.VoluntaryCents
+	}
+	return c.InvoluntaryCents
+}
+
+// DeniedBoarding records a booking taken off an oversold flight and the
+// compensation owed to the passenger.
+type DeniedBoarding struct {
+	ID                int64
+	FlightID          int64
+	BookingID         int64
+	Token             string
+	Email             string
+	SeatNumber        int
+	Kind              DeniedBoardingKind
+	CompensationCents int64
+	CreatedAt         time.Time
+}
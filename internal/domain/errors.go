@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"strings"
+)
+
+// The domain errors below are grouped by kind so that the API layers can map
+// them to status codes without knowing every error. Sentinel errors such as
+// ErrBookingNotFound are values of these types: compare them with errors.Is,
+// classify them with errors.As.
+
+// NotFoundError is returned when the requested entity does not exist.
+type NotFoundError struct {
+	Resource string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Resource + " not found"
+}
+
+// Reason is a machine-readable code, e.g. BOOKING_NOT_FOUND.
+func (e *NotFoundError) Reason() string {
+	return strings.ToUpper(strings.ReplaceAll(e.Resource, " ", "_")) + "_NOT_FOUND"
+}
+
+// SeatUnavailableError is returned when the requested seat, or any seat, can
+// no longer be had on a flight.
+type SeatUnavailableError struct {
+	Code    string
+	Message string
+}
+
+func (e *SeatUnavailableError) Error() string {
+	return e.Message
+}
+
+func (e *SeatUnavailableError) Reason() string {
+	return e.Code
+}
+
+// InvalidStateError is returned when the current state of a booking or a
+// flight does not allow the operation.
+type InvalidStateError struct {
+	Code    string
+	Message string
+}
+
+func (e *InvalidStateError) Error() string {
+	return e.Message
+}
+
+func (e *InvalidStateError) Reason() string {
+	return e.Code
+}
+
+// UnauthenticatedError is returned when the credentials of the caller, such
+// as an email and password, are wrong.
+type UnauthenticatedError struct {
+	Code    string
+	Message string
+}
+
+func (e *UnauthenticatedError) Error() string {
+	return e.Message
+}
+
+func (e *UnauthenticatedError) Reason() string {
+	return e.Code
+}
+
+// FieldViolation describes one invalid request field.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError is returned when the input is invalid. It lists every
+// offending field.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// NewValidationError returns a validation error for a single field.
+func NewValidationError(field, description string) *ValidationError {
+	return &ValidationError{Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Violations) == 1 {
+		return e.Violations[0].Description
+	}
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Reason() string {
+	return "VALIDATION_FAILED"
+}
+
+// Violations collects the field violations of every validation error in the
+// tree of err, skipping duplicates. Errors joined with a general validation
+// error such as ErrInvalidSchedule keep their own, more precise, fields.
+func Violations(err error) []FieldViolation {
+	var out []FieldViolation
+	seen := make(map[FieldViolation]struct{})
+	var walk func(error)
+	walk = func(err error) {
+		if ve, ok := err.(*ValidationError); ok {
+			for _, v := range ve.Violations {
+				if _, dup := seen[v]; !dup {
+					seen[v] = struct{}{}
+					out = append(out, v)
+				}
+			}
+		}
+		switch u := err.(type) {
+		case interface{ Unwrap() error }:
+			if next := u.Unwrap(); next != nil {
+				walk(next)
+			}
+		case interface{ Unwrap() []error }:
+			for _, next := range u.Unwrap() {
+				walk(next)
+			}
+		}
+	}
+	if err != nil {
+		walk(err)
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorReasons(t *testing.T) {
+	assert.Equal(t, "BOOKING_NOT_FOUND", ErrBookingNotFound.Reason())
+	assert.Equal(t, "SEAT_TAKEN", ErrSeatTaken.Reason())
+	assert.Equal(t, "FLIGHT_NOT_BOOKABLE", ErrFlightNotBookable.Reason())
+	assert.Equal(t, "VALIDATION_FAILED", ErrInvalidChannel.Reason())
+}
+
+func TestErrorKinds(t *testing.T) {
+	var invalid *InvalidStateError
+	assert.ErrorAs(t, &BookingTransitionError{From: BookingStatusCancelled, To: BookingStatusConfirmed}, &invalid)
+	assert.Equal(t, ErrInvalidBookingTransition, invalid)
+
+	var notFound *NotFoundError
+	assert.ErrorAs(t, fmt.Errorf("%w: XXX", ErrAirportNotFound), &notFound)
+	assert.Equal(t, "AIRPORT_NOT_FOUND", notFound.Reason())
+}
+
+func TestViolations(t *testing.T) {
+	err := errors.Join(ErrInvalidSchedule, NewValidationError("flight_number", "flight number is required"), ErrInvalidSchedule)
+	assert.Equal(t, []FieldViolation{
+		{Field: "schedule", Description: "invalid schedule"},
+		{Field: "flight_number", Description: "flight number is required"},
+	}, Violations(err))
+	assert.Nil(t, Violations(ErrBookingNotFound))
+
+	multi := &ValidationError{Violations: []FieldViolation{{"email", "email is required"}, {"seat_number", "seat number must be positive"}}}
+	assert.EqualError(t, multi, "email: email is required; seat_number: seat number must be positive")
+}
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrPriceChanged is returned when the fare of a flight, or the discount of
+// a promo code, changed while a booking was being priced.
+var ErrPriceChanged = &InvalidStateError{Code: "PRICE_CHANGED", Message: "price of the flight has changed, book again"}
+
+// TaxLevy says at which airport of a flight a tax is levied.
+type TaxLevy string
+
+const (
+	TaxOnDeparture TaxLevy = "DEPARTURE"
+	TaxOnArrival   TaxLevy = "ARRIVAL"
+)
+
+// TaxRule is a tax charged on bookings of flights departing from, or
+// arriving at, an airport or any airport of a country. Empty Airport and
+// Country match every airport.
+type TaxRule struct {
+	Code    string
+	LevyOn  TaxLevy
+	Airport string
+	// Country is compared with the country of the airport, ignoring case.
+	Country     string
+	AmountCents int64
+	// Percent of the fare after discount, rounded down, added to
+	// AmountCents.
+	Percent int64
+}
+
+// TaxCharge is a tax charged on a booking.
+type TaxCharge struct {
+	Code        string `json:"code"`
+	Airport     string `json:"airport"`
+	AmountCents int64  `json:"amount_cents"`
+}
+
+// FareCharges are what a booking costs on top of its fare.
+type FareCharges struct {
+	Taxes              []TaxCharge
+	FuelSurchargeCents int64
+	BookingFeeCents    int64
+	SeatFeeCents       int64
+}
+
+// TaxCents sums the taxes.
+func (c FareCharges) TaxCents() int64 {
+	var total int64
+	for _, t := range c.Taxes {
+		total += t.AmountCents
+	}
+	return total
+}
+
+// TotalCents sums the taxes, surcharge and fees.
+func (c FareCharges) TotalCents() int64 {
+	return c.TaxCents() + c.FuelSurchargeCents + c.BookingFeeCents + c.SeatFeeCents
+}
+
+// PricingRules set the taxes and fees charged on top of the fare of a
+// flight. The zero value charges nothing.
+type PricingRules struct {
+	// Currency of the fixed amounts, converted to the currency of the
+	// flight when a booking is priced. Empty means the currency of the
+	// flight.
+	Currency string
+	// FuelSurchargeCents is charged per booking.
+	FuelSurchargeCents int64
+	// BookingFees by sales channel and SeatFees by fare class; missing
+	// entries are free.
+	BookingFees map[Channel]int64
+	SeatFees    map[FareClass]int64
+	Taxes       []TaxRule
+}
+
+// Validate normalizes the airports of the tax rules and checks the rules.
+func (r PricingRules) Validate() error {
+	if r.Currency != "" {
+		if _, ok := currencyCode(r.Currency); !ok {
+			return fmt.Errorf("pricing currency %q is not an ISO 4217 code", r.Currency)
+		}
+	}
+	if r.FuelSurchargeCents < 0 {
+		return fmt.Errorf("fuel surcharge must not be negative")
+	}
+	for channel, fee := range r.BookingFees {
+		if fee < 0 {
+			return fmt.Errorf("booking fee of %s must not be negative", channel)
+		}
+	}
+	for class, fee := range r.SeatFees {
+		if fee < 0 {
+			return fmt.Errorf("seat fee of %s must not be negative", class)
+		}
+	}
+	for i := range r.Taxes {
+		t := &r.Taxes[i]
+		t.Airport = strings.ToUpper(strings.TrimSpace(t.Airport))
+		if t.Code == "" {
+			return fmt.Errorf("tax %d: code is required", i)
+		}
+		if t.LevyOn != TaxOnDeparture && t.LevyOn != TaxOnArrival {
+			return fmt.Errorf("tax %s: levied on must be DEPARTURE or ARRIVAL", t.Code)
+		}
+		if t.AmountCents < 0 || t.Percent < 0 || t.Percent > 100 {
+			return fmt.Errorf("tax %s: amount must not be negative and percent must be between 0 and 100", t.Code)
+		}
+	}
+	return nil
+}
+
+// Charges prices a booking of flight f sold through channel in class, whose
+// fare after discount is fareCents. Fixed amounts in another currency than
+// the flight are converted with rates.
+func (r PricingRules) Charges(f Flight, channel Channel, class FareClass, fareCents int64, rates ExchangeRates) (FareCharges, error) {
+	var err error
+	amount := func(cents int64) int64 {
+		if err != nil || cents == 0 || r.Currency == "" || f.Currency == "" {
+			return cents
+		}
+		var m Money
+		m, err = rates.Convert(Money{Cents: cents, Currency: r.Currency}, f.Currency)
+		return m.Cents
+	}
+	charges := FareCharges{
+		Taxes:              make([]TaxCharge, 0),
+		FuelSurchargeCents: amount(r.FuelSurchargeCents),
+		BookingFeeCents:    amount(r.BookingFees[channel]),
+		SeatFeeCents:       amount(r.SeatFees[class]),
+	}
+	for _, t := range r.Taxes {
+		airport, country := f.FromAirport, f.DepartureCountry
+		if t.LevyOn == TaxOnArrival {
+			airport, country = f.ToAirport, f.ArrivalCountry
+		}
+		if (t.Airport != "" && t.Airport != airport) || (t.Country != "" && !strings.EqualFold(t.Country, country)) {
+			continue
+		}
+		charges.Taxes = append(charges.Taxes, TaxCharge{
+			Code:        t.Code,
+			Airport:     airport,
+			AmountCents: amount(t.AmountCents) + fareCents*t.Percent/100,
+		})
+	}
+	if err != nil {
+		return FareCharges{}, err
+	}
+	return charges, nil
+}
+
+// FareBreakdown is what a booking costs, item by item.
+type FareBreakdown struct {
+	// BaseFareCents is the fare of the flight before discount.
+	BaseFareCents int64
+	DiscountCents int64
+	FareCharges
+	TotalCents int64
+}
+
+// Fare returns the price breakdown of the booking.
+func (b Booking) Fare() FareBreakdown {
+	return FareBreakdown{
+		BaseFareCents: b.PriceCents + b.DiscountCents,
+		DiscountCents: b.DiscountCents,
+		FareCharges:   b.Charges,
+		TotalCents:    b.TotalCents(),
+	}
+}
+
+// TotalCents is what the booking costs: the fare after discount plus taxes
+// and fees.
+func (b Booking) TotalCents() int64 {
+	return b.PriceCents + b.Charges.TotalCents()
+}
+
+// Total is TotalCents in the currency of the booking.
+func (b Booking) Total() Money {
+	return Money{Cents: b.TotalCents(), Currency: b.Currency}
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricingRules_Validate(t *testing.T) {
+	rules := PricingRules{Taxes: []TaxRule{{Code: "RU", LevyOn: TaxOnDeparture, Airport: " svo "}}}
+	assert.NoError(t, rules.Validate())
+	assert.Equal(t, "SVO", rules.Taxes[0].Airport)
+
+	assert.Error(t, PricingRules{Taxes: []TaxRule{{Code: "RU", LevyOn: "BOTH"}}}.Validate())
+	assert.Error(t, PricingRules{Taxes: []TaxRule{{LevyOn: TaxOnArrival}}}.Validate())
+	assert.Error(t, PricingRules{Taxes: []TaxRule{{Code: "RU", LevyOn: TaxOnArrival, Percent: 101}}}.Validate())
+	assert.Error(t, PricingRules{SeatFees: map[FareClass]int64{FareClassBusiness: -1}}.Validate())
+}
+
+func TestPricingRules_Charges(t *testing.T) {
+	rules := PricingRules{
+		FuelSurchargeCents: 1500,
+		BookingFees:        map[Channel]int64{ChannelAgent: 700},
+		SeatFees:           map[FareClass]int64{FareClassBusiness: 5000},
+		Taxes: []TaxRule{
+			{Code: "SVO", LevyOn: TaxOnDeparture, Airport: "SVO", AmountCents: 300},
+			{Code: "RU", LevyOn: TaxOnArrival, Country: "ru", Percent: 10},
+			{Code: "DE", LevyOn: TaxOnArrival, Country: "DE", AmountCents: 900},
+		},
+	}
+	flight := Flight{FromAirport: "SVO", ToAirport: "LED", DepartureCountry: "RU", ArrivalCountry: "RU"}
+
+	charges, err := rules.Charges(flight, ChannelAgent, FareClassBusiness, 9999, ExchangeRates{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []TaxCharge{{Code: "SVO", Airport: "SVO", AmountCents: 300}, {Code: "RU", Airport: "LED", AmountCents: 999}}, charges.Taxes)
+	assert.Equal(t, int64(1299), charges.TaxCents())
+	assert.Equal(t, int64(1299+1500+700+5000), charges.TotalCents())
+
+	free, err := PricingRules{}.Charges(flight, ChannelWeb, FareClassEconomy, 9999, ExchangeRates{})
+	require.NoError(t, err)
+	assert.NotNil(t, free.Taxes)
+	assert.Zero(t, free.TotalCents())
+}
+
+func TestPricingRules_Charges_ConvertsFixedAmounts(t *testing.T) {
+	rules := PricingRules{
+		Currency:           "RUB",
+		FuelSurchargeCents: 150000,
+		Taxes:              []TaxRule{{Code: "DE", LevyOn: TaxOnDeparture, AmountCents: 50000, Percent: 10}},
+	}
+	flight := Flight{FromAirport: "FRA", ToAirport: "SVO", Currency: "EUR"}
+	rates := NewExchangeRates([]ExchangeRate{{Base: "EUR", Quote: "RUB", Rate: big.NewRat(100, 1)}})
+
+	charges, err := rules.Charges(flight, ChannelWeb, FareClassEconomy, 10000, rates)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500), charges.FuelSurchargeCents)
+	assert.Equal(t, int64(500+1000), charges.Taxes[0].AmountCents, "percentages are of the fare in the currency of the flight")
+
+	_, err = rules.Charges(flight, ChannelWeb, FareClassEconomy, 10000, ExchangeRates{})
+	assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+}
+
+func TestBooking_Fare(t *testing.T) {
+	b := Booking{PriceCents: 9000, DiscountCents: 1000, Charges: FareCharges{
+		Taxes:        []TaxCharge{{Code: "RU", Airport: "SVO", AmountCents: 300}},
+		SeatFeeCents: 200,
+	}}
+
+	fare := b.Fare()
+
+	assert.Equal(t, int64(10000), fare.BaseFareCents)
+	assert.Equal(t, int64(1000), fare.DiscountCents)
+	assert.Equal(t, int64(9500), fare.TotalCents)
+	assert.Equal(t, fare.TotalCents, b.TotalCents())
+}
@@ -2,15 +2,102 @@ package domain
 
 import "time"
 
+// MaxFlightDuration bounds the block time of a single flight leg.
+const MaxFlightDuration = 24 * time.Hour
+
+var (
+	ErrArrivalBeforeDeparture = NewValidationError("arrival_time", "arrival time must be after departure time")
+	ErrFlightTooLong          = NewValidationError("arrival_time", "flight duration exceeds 24 hours")
+	ErrSameAirports           = NewValidationError("to_airport", "departure and arrival airports must differ")
+	ErrAirportRequired        = NewValidationError("from_airport", "departure and arrival airports are required")
+	ErrInvalidTotalSeats      = NewValidationError("total_seats", "total seats must be positive")
+	ErrInvalidPrice           = NewValidationError("price_cents", "price must not be negative")
+	ErrFlightNotFound         = &NotFoundError{Resource: "flight"}
+	ErrAirportNotFound        = &NotFoundError{Resource: "airport"}
+	ErrTotalSeatsBelowBooked  = &InvalidStateError{Code: "TOTAL_SEATS_BELOW_BOOKED", Message: "total seats is less than the number of active bookings"}
+	ErrFlightHasBookings      = &InvalidStateError{Code: "FLIGHT_HAS_BOOKINGS", Message: "flight has bookings"}
+	ErrFlightCancelled        = &InvalidStateError{Code: "FLIGHT_CANCELLED", Message: "flight is cancelled"}
+	ErrInvalidOverbooking     = NewValidationError("overbooking_limit", "overbooking limit must not be negative")
+)
+
 type Flight struct {
-	ID             int64
-	FromAirport    string
-	ToAirport      string
-	DepartureTime  time.Time
-	ArrivalTime    time.Time
-	TotalSeats     int
-	AvailableSeats int
-	PriceCents     int64
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID                int64
+	FlightNumber      string
+	ScheduleID        int64 // zero for flights created outside of a schedule
+	FromAirport       string
+	ToAirport         string
+	DepartureTime     time.Time
+	ArrivalTime       time.Time
+	DepartureTimeZone string
+	ArrivalTimeZone   string
+	// Countries of the airports, used for taxes.
+	DepartureCountry string
+	ArrivalCountry   string
+	TotalSeats       int
+	AvailableSeats   int
+	PriceCents       int64
+	// Currency of PriceCents, an ISO 4217 code.
+	Currency         string
+	OverbookingLimit int // seats that may be sold above TotalSeats
+	Status           FlightStatus
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+
+	FlightOps
+}
+
+// Price returns the fare of the flight in its currency.
+func (f Flight) Price() Money {
+	return Money{Cents: f.PriceCents, Currency: f.Currency}
+}
+
+// LocalDeparture returns the departure time in the time zone of the origin airport.
+func (f Flight) LocalDeparture() time.Time {
+	return InTimeZone(f.DepartureTime, f.DepartureTimeZone)
+}
+
+// LocalArrival returns the arrival time in the time zone of the destination airport.
+func (f Flight) LocalArrival() time.Time {
+	return InTimeZone(f.ArrivalTime, f.ArrivalTimeZone)
+}
+
+// Validate checks the schedule and inventory fields that do not require storage lookups.
+func (f Flight) Validate() error {
+	if f.FromAirport == "" || f.ToAirport == "" {
+		return ErrAirportRequired
+	}
+	if f.FromAirport == f.ToAirport {
+		return ErrSameAirports
+	}
+	if !f.ArrivalTime.After(f.DepartureTime) {
+		return ErrArrivalBeforeDeparture
+	}
+	if f.ArrivalTime.Sub(f.DepartureTime) > MaxFlightDuration {
+		return ErrFlightTooLong
+	}
+	if f.TotalSeats <= 0 {
+		return ErrInvalidTotalSeats
+	}
+	if f.PriceCents < 0 {
+		return ErrInvalidPrice
+	}
+	if f.OverbookingLimit < 0 {
+		return ErrInvalidOverbooking
+	}
+	return nil
+}
+
+// Sellable returns the number of seats that can still be sold, counting the
+// seats that may be sold above capacity.
+func (f Flight) Sellable() int {
+	return max(f.AvailableSeats+f.OverbookingLimit, 0)
+}
+
+// Oversold returns the number of seats sold above capacity. AvailableSeats
+// goes negative when the flight is overbooked.
+func (f Flight) Oversold() int {
+	if f.AvailableSeats >= 0 {
+		return 0
+	}
+	return -f.AvailableSeats
 }
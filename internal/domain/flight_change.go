@@ -0,0 +1,99 @@
+package domain
+
+import "time"
+
+var (
+	ErrFareNotChangeable = &InvalidStateError{Code: "FARE_NOT_CHANGEABLE", Message: "fare does not allow flight changes"}
+	ErrChangeTooLate     = &InvalidStateError{Code: "CHANGE_TOO_LATE", Message: "too close to departure to change the flight"}
+	ErrSameFlight        = NewValidationError("flight_id", "booking is already on this flight")
+	ErrDifferentRoute    = NewValidationError("flight_id", "new flight must be on the same route")
+	ErrFareChanged       = &InvalidStateError{Code: "FARE_CHANGED", Message: "fare of the new flight has changed, quote again"}
+)
+
+// FareRule holds the change conditions of a fare class.
+type FareRule struct {
+	Changeable     bool
+	ChangeFeeCents int64
+	// MinNotice is how long before the departure of the booked flight a
+	// change is still accepted.
+	MinNotice time.Duration
+}
+
+type FareRules map[FareClass]FareRule
+
+// DefaultFareRules are used when no fare rules are configured.
+func DefaultFareRules() FareRules {
+	return FareRules{
+		FareClassEconomy:        {Changeable: true, ChangeFeeCents: 5000, MinNotice: 24 * time.Hour},
+		FareClassPremiumEconomy: {Changeable: true, ChangeFeeCents: 2500, MinNotice: 3 * time.Hour},
+		FareClassBusiness:       {Changeable: true, MinNotice: time.Hour},
+		FareClassFirst:          {Changeable: true},
+	}
+}
+
+// FlightChangeQuote is the price of moving a booking to another flight.
+// A negative fare difference is not refunded.
+type FlightChangeQuote struct {
+	PreviousFareCents   int64
+	NewFareCents        int64
+	FareDifferenceCents int64
+	ChangeFeeCents      int64
+}
+
+// AmountDueCents is what the customer pays for the change.
+func (q FlightChangeQuote) AmountDueCents() int64 {
+	return max(q.FareDifferenceCents, 0) + q.ChangeFeeCents
+}
+
+// QuoteChange checks that the booking on from may move to to under the
+// rules of its fare class and prices the change.
+func (r FareRules) QuoteChange(b Booking, from, to Flight, now time.Time) (FlightChangeQuote, error) {
+	if b.Status != BookingStatusPending && b.Status != BookingStatusConfirmed {
+		return FlightChangeQuote{}, ErrBookingNotActive
+	}
+	if from.ID == to.ID {
+		return FlightChangeQuote{}, ErrSameFlight
+	}
+	if from.FromAirport != to.FromAirport || from.ToAirport != to.ToAirport {
+		return FlightChangeQuote{}, ErrDifferentRoute
+	}
+	if !to.Status.Bookable() {
+		return FlightChangeQuote{}, ErrFlightNotBookable
+	}
+	if to.Currency != b.Currency {
+		return FlightChangeQuote{}, ErrCurrencyMismatch
+	}
+	class := b.FareClass
+	if class == "" {
+		class = FareClassEconomy
+	}
+	rule, ok := r[class]
+	if !ok || !rule.Changeable {
+		return FlightChangeQuote{}, ErrFareNotChangeable
+	}
+	if from.DepartureTime.Sub(now) < rule.MinNotice {
+		return FlightChangeQuote{}, ErrChangeTooLate
+	}
+	return FlightChangeQuote{
+		PreviousFareCents:   b.PriceCents,
+		NewFareCents:        to.PriceCents,
+		FareDifferenceCents: to.PriceCents - b.PriceCents,
+		ChangeFeeCents:      rule.ChangeFeeCents,
+	}, nil
+}
+
+// FlightChange records a booking moved to another flight.
+type FlightChange struct {
+	ID             int64
+	BookingID      int64
+	Token          string
+	FromFlightID   int64
+	ToFlightID     int64
+	FromSeatNumber int
+	ToSeatNumber   int
+	CreatedAt      time.Time
+	// Charges are the taxes and fees of the booking on the new flight.
+	Charges FareCharges
+
+	FlightChangeQuote
+}
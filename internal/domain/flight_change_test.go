@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFareRules_QuoteChange(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	rules := DefaultFareRules()
+	from := Flight{ID: 1, FromAirport: "SVO", ToAirport: "LED", DepartureTime: now.Add(48 * time.Hour), PriceCents: 10000, Status: FlightStatusScheduled}
+	to := Flight{ID: 2, FromAirport: "SVO", ToAirport: "LED", DepartureTime: now.Add(72 * time.Hour), PriceCents: 12500, Status: FlightStatusScheduled}
+	booking := Booking{FlightID: 1, Status: BookingStatusConfirmed, FareClass: FareClassEconomy, PriceCents: 10000}
+
+	quote, err := rules.QuoteChange(booking, from, to, now)
+	assert.NoError(t, err)
+	assert.Equal(t, FlightChangeQuote{PreviousFareCents: 10000, NewFareCents: 12500, FareDifferenceCents: 2500, ChangeFeeCents: 5000}, quote)
+	assert.Equal(t, int64(7500), quote.AmountDueCents())
+
+	cheaper := to
+	cheaper.PriceCents = 8000
+	quote, err = rules.QuoteChange(booking, from, cheaper, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-2000), quote.FareDifferenceCents)
+	assert.Equal(t, int64(5000), quote.AmountDueCents(), "lower fares are not refunded")
+
+	business := booking
+	business.FareClass = FareClassBusiness
+	quote, err = rules.QuoteChange(business, from, to, now)
+	assert.NoError(t, err)
+	assert.Zero(t, quote.ChangeFeeCents)
+}
+
+func TestFareRules_QuoteChange_Rejects(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	from := Flight{ID: 1, FromAirport: "SVO", ToAirport: "LED", DepartureTime: now.Add(12 * time.Hour), Status: FlightStatusScheduled}
+	to := Flight{ID: 2, FromAirport: "SVO", ToAirport: "LED", DepartureTime: now.Add(72 * time.Hour), Status: FlightStatusScheduled}
+	booking := Booking{FlightID: 1, Status: BookingStatusPending, FareClass: FareClassEconomy}
+	rules := DefaultFareRules()
+
+	_, err := rules.QuoteChange(booking, from, to, now)
+	assert.ErrorIs(t, err, ErrChangeTooLate)
+
+	_, err = rules.QuoteChange(booking, from, from, now)
+	assert.ErrorIs(t, err, ErrSameFlight)
+
+	other := to
+	other.ToAirport = "KZN"
+	_, err = rules.QuoteChange(booking, from, other, now)
+	assert.ErrorIs(t, err, ErrDifferentRoute)
+
+	cancelled := to
+	cancelled.Status = FlightStatusCancelled
+	_, err = rules.QuoteChange(booking, from, cancelled, now)
+	assert.ErrorIs(t, err, ErrFlightNotBookable)
+
+	priced := to
+	priced.Currency = "EUR"
+	_, err = rules.QuoteChange(booking, from, priced, now)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	expired := booking
+	expired.Status = BookingStatusExpired
+	_, err = rules.QuoteChange(expired, from, to, now)
+	assert.ErrorIs(t, err, ErrBookingNotActive)
+
+	rules[FareClassEconomy] = FareRule{}
+	from.DepartureTime = now.Add(48 * time.Hour)
+	_, err = rules.QuoteChange(booking, from, to, now)
+	assert.ErrorIs(t, err, ErrFareNotChangeable)
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SignificantDelayChange is the smallest change of the estimated departure
+// that passengers are notified about.
+const SignificantDelayChange = 15 * time.Minute
+
+var (
+	ErrInvalidFlightStatus        = NewValidationError("status", "invalid flight status")
+	ErrInvalidStatusTransition    = &InvalidStateError{Code: "INVALID_FLIGHT_STATUS_TRANSITION", Message: "invalid flight status transition"}
+	ErrEstimatedDepartureRequired = NewValidationError("estimated_departure", "delayed flight requires an estimated departure after the scheduled one")
+	ErrDiversionAirportRequired   = NewValidationError("diverted_to", "diverted flight requires a diversion airport other than the destination")
+	ErrStaleStatusUpdate          = &InvalidStateError{Code: "STALE_STATUS_UPDATE", Message: "status update is older than the current flight status"}
+	ErrFlightNotBookable          = &InvalidStateError{Code: "FLIGHT_NOT_BOOKABLE", Message: "flight is not open for booking"}
+)
+
+type FlightStatus string
+
+const (
+	FlightStatusScheduled FlightStatus = "SCHEDULED"
+	FlightStatusDelayed   FlightStatus = "DELAYED"
+	FlightStatusBoarding  FlightStatus = "BOARDING"
+	FlightStatusDeparted  FlightStatus = "DEPARTED"
+	FlightStatusDiverted  FlightStatus = "DIVERTED"
+	FlightStatusArrived   FlightStatus = "ARRIVED"
+	FlightStatusCancelled FlightStatus = "CANCELLED"
+)
+
+// flightStatusTransitions lists the statuses reachable from each status.
+// Cancellation is not an ops status update: it goes through CancelFlight so
+// that passengers are rebooked.
+var flightStatusTransitions = map[FlightStatus][]FlightStatus{
+	FlightStatusScheduled: {FlightStatusDelayed, FlightStatusBoarding, FlightStatusDeparted},
+	FlightStatusDelayed:   {FlightStatusScheduled, FlightStatusBoarding, FlightStatusDeparted},
+	FlightStatusBoarding:  {FlightStatusDelayed, FlightStatusDeparted},
+	FlightStatusDeparted:  {FlightStatusDiverted, FlightStatusArrived},
+	FlightStatusDiverted:  {FlightStatusArrived},
+}
+
+// Bookable reports whether new bookings and rebookings may use the flight.
+func (s FlightStatus) Bookable() bool {
+	return s == FlightStatusScheduled || s == FlightStatusDelayed
+}
+
+// CheckInOpen reports whether passengers may still check in for the flight.
+func (s FlightStatus) CheckInOpen() bool {
+	return s.Bookable() || s == FlightStatusBoarding
+}
+
+func (s FlightStatus) Valid() bool {
+	switch s {
+	case FlightStatusScheduled, FlightStatusDelayed, FlightStatusBoarding, FlightStatusDeparted,
+		FlightStatusDiverted, FlightStatusArrived, FlightStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo reports whether an ops update may move a flight from s to next.
+func (s FlightStatus) CanTransitionTo(next FlightStatus) bool {
+	for _, allowed := range flightStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// FlightOps is the operational state of a flight reported by the airline.
+// Zero times and empty strings mean "not reported yet".
+type FlightOps struct {
+	EstimatedDeparture time.Time
+	ActualDeparture    time.Time
+	ActualArrival      time.Time
+	Gate               string
+	Terminal           string
+	DivertedTo         string
+	StatusUpdatedAt    time.Time
+}
+
+// DepartureDelay returns how late the flight is expected to leave, or zero.
+func (f Flight) DepartureDelay() time.Duration {
+	if f.EstimatedDeparture.IsZero() || !f.EstimatedDeparture.After(f.DepartureTime) {
+		return 0
+	}
+	return f.EstimatedDeparture.Sub(f.DepartureTime)
+}
+
+// FlightStatusUpdate is a partial status report from operations. Empty fields
+// keep the current value.
+type FlightStatusUpdate struct {
+	FlightID           int64
+	Status             FlightStatus
+	EstimatedDeparture time.Time
+	Gate               string
+	Terminal           string
+	DivertedTo         string
+	ReportedAt         time.Time
+}
+
+// FlightStatusChange describes what an applied update changed.
+type FlightStatusChange struct {
+	Changes []string
+	// Significant changes are sent to booked passengers: any status change,
+	// a new gate or terminal, and estimate shifts of SignificantDelayChange or more.
+	Significant bool
+}
+
+// ApplyStatusUpdate validates the update against the current state of f and
+// applies it in place.
+func (f *Flight) ApplyStatusUpdate(u FlightStatusUpdate) (FlightStatusChange, error) {
+	var change FlightStatusChange
+	if !f.StatusUpdatedAt.IsZero() && u.ReportedAt.Before(f.StatusUpdatedAt) {
+		return change, ErrStaleStatusUpdate
+	}
+
+	next := f.Status
+	if u.Status != "" {
+		if !u.Status.Valid() {
+			return change, fmt.Errorf("%w: %q", ErrInvalidFlightStatus, u.Status)
+		}
+		next = u.Status
+	}
+	if next != f.Status && !f.Status.CanTransitionTo(next) {
+		return change, fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, f.Status, next)
+	}
+	if next == f.Status && (f.Status == FlightStatusCancelled || f.Status == FlightStatusArrived) {
+		return change, fmt.Errorf("%w: flight is %s", ErrInvalidStatusTransition, f.Status)
+	}
+
+	estimate := f.EstimatedDeparture
+	if !u.EstimatedDeparture.IsZero() {
+		estimate = u.EstimatedDeparture
+	}
+	if next == FlightStatusDelayed && !estimate.After(f.DepartureTime) {
+		return change, ErrEstimatedDepartureRequired
+	}
+	diverted := f.DivertedTo
+	if u.DivertedTo != "" {
+		diverted = u.DivertedTo
+	}
+	if next == FlightStatusDiverted && (diverted == "" || diverted == f.ToAirport) {
+		return change, ErrDiversionAirportRequired
+	}
+
+	if next != f.Status {
+		change.add(true, "status: %s -> %s", f.Status, next)
+		f.Status = next
+		switch next {
+		case FlightStatusDeparted:
+			f.ActualDeparture = u.ReportedAt
+		case FlightStatusArrived:
+			f.ActualArrival = u.ReportedAt
+		}
+	}
+	if !estimate.Equal(f.EstimatedDeparture) {
+		shift := estimate.Sub(f.EstimatedDeparture)
+		if f.EstimatedDeparture.IsZero() {
+			shift = estimate.Sub(f.DepartureTime)
+		}
+		if shift < 0 {
+			shift = -shift
+		}
+		change.add(shift >= SignificantDelayChange, "estimated departure: %s", estimate.UTC().Format(time.RFC3339))
+		f.EstimatedDeparture = estimate
+	}
+	if u.Gate != "" && u.Gate != f.Gate {
+		change.add(true, "gate: %q -> %q", f.Gate, u.Gate)
+		f.Gate = u.Gate
+	}
+	if u.Terminal != "" && u.Terminal != f.Terminal {
+		change.add(true, "terminal: %q -> %q", f.Terminal, u.Terminal)
+		f.Terminal = u.Terminal
+	}
+	if diverted != f.DivertedTo {
+		change.add(true, "diverted to: %s", diverted)
+		f.DivertedTo = diverted
+	}
+	f.StatusUpdatedAt = u.ReportedAt
+	return change, nil
+}
+
+func (c *FlightStatusChange) add(significant bool, format string, args ...interface{}) {
+	c.Changes = append(c.Changes, fmt.Sprintf(format, args...))
+	c.Significant = c.Significant || significant
+}
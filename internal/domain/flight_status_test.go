@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func statusTestFlight() Flight {
+	departure := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+	return Flight{
+		ID:            1,
+		FromAirport:   "SVO",
+		ToAirport:     "LED",
+		DepartureTime: departure,
+		ArrivalTime:   departure.Add(90 * time.Minute),
+		Status:        FlightStatusScheduled,
+	}
+}
+
+func TestFlight_ApplyStatusUpdate(t *testing.T) {
+	reported := time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC)
+
+	t.Run("delay with estimate", func(t *testing.T) {
+		f := statusTestFlight()
+		change, err := f.ApplyStatusUpdate(FlightStatusUpdate{Status: FlightStatusDelayed, EstimatedDeparture: f.DepartureTime.Add(40 * time.Minute), ReportedAt: reported})
+		assert.NoError(t, err)
+		assert.True(t, change.Significant)
+		assert.Equal(t, []string{"status: SCHEDULED -> DELAYED", "estimated departure: 2025-03-10T08:40:00Z"}, change.Changes)
+		assert.Equal(t, FlightStatusDelayed, f.Status)
+		assert.Equal(t, 40*time.Minute, f.DepartureDelay())
+		assert.Equal(t, reported, f.StatusUpdatedAt)
+	})
+
+	t.Run("small estimate shift is not significant", func(t *testing.T) {
+		f := statusTestFlight()
+		f.Status = FlightStatusDelayed
+		f.EstimatedDeparture = f.DepartureTime.Add(40 * time.Minute)
+		change, err := f.ApplyStatusUpdate(FlightStatusUpdate{EstimatedDeparture: f.DepartureTime.Add(45 * time.Minute), ReportedAt: reported})
+		assert.NoError(t, err)
+		assert.False(t, change.Significant)
+		assert.Len(t, change.Changes, 1)
+	})
+
+	t.Run("gate assignment", func(t *testing.T) {
+		f := statusTestFlight()
+		change, err := f.ApplyStatusUpdate(FlightStatusUpdate{Gate: "D12", Terminal: "D", ReportedAt: reported})
+		assert.NoError(t, err)
+		assert.True(t, change.Significant)
+		assert.Equal(t, "D12", f.Gate)
+		assert.Equal(t, "D", f.Terminal)
+	})
+
+	t.Run("repeated report changes nothing", func(t *testing.T) {
+		f := statusTestFlight()
+		f.Gate = "D12"
+		change, err := f.ApplyStatusUpdate(FlightStatusUpdate{Status: FlightStatusScheduled, Gate: "D12", ReportedAt: reported})
+		assert.NoError(t, err)
+		assert.Empty(t, change.Changes)
+		assert.False(t, change.Significant)
+	})
+
+	t.Run("departed and arrived record actual times", func(t *testing.T) {
+		f := statusTestFlight()
+		f.Status = FlightStatusBoarding
+		_, err := f.ApplyStatusUpdate(FlightStatusUpdate{Status: FlightStatusDeparted, ReportedAt: reported})
+		assert.NoError(t, err)
+		assert.Equal(t, reported, f.ActualDeparture)
+
+		landed := reported.Add(time.Hour)
+		_, err = f.ApplyStatusUpdate(FlightStatusUpdate{Status: FlightStatusArrived, ReportedAt: landed})
+		assert.NoError(t, err)
+		assert.Equal(t, landed, f.ActualArrival)
+	})
+
+	t.Run("diversion", func(t *testing.T) {
+		f := statusTestFlight()
+		f.Status = FlightStatusDeparted
+		change, err := f.ApplyStatusUpdate(FlightStatusUpdate{Status: FlightStatusDiverted, DivertedTo: "VKO", ReportedAt: reported})
+		assert.NoError(t, err)
+		assert.Contains(t, change.Changes, "diverted to: VKO")
+		assert.Equal(t, "VKO", f.DivertedTo)
+	})
+}
+
+func TestFlight_ApplyStatusUpdate_Errors(t *testing.T) {
+	reported := time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		current FlightStatus
+		update  FlightStatusUpdate
+		err     error
+	}{
+		{"unknown status", FlightStatusScheduled, FlightStatusUpdate{Status: "LANDED"}, ErrInvalidFlightStatus},
+		{"arrived before departure", FlightStatusScheduled, FlightStatusUpdate{Status: FlightStatusArrived}, ErrInvalidStatusTransition},
+		{"back from departed", FlightStatusDeparted, FlightStatusUpdate{Status: FlightStatusBoarding}, ErrInvalidStatusTransition},
+		{"cancel through status", FlightStatusScheduled, FlightStatusUpdate{Status: FlightStatusCancelled}, ErrInvalidStatusTransition},
+		{"update cancelled flight", FlightStatusCancelled, FlightStatusUpdate{Gate: "A1"}, ErrInvalidStatusTransition},
+		{"delay without estimate", FlightStatusScheduled, FlightStatusUpdate{Status: FlightStatusDelayed}, ErrEstimatedDepartureRequired},
+		{"diversion to destination", FlightStatusDeparted, FlightStatusUpdate{Status: FlightStatusDiverted, DivertedTo: "LED"}, ErrDiversionAirportRequired},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := statusTestFlight()
+			f.Status = tc.current
+			tc.update.ReportedAt = reported
+			before := f
+
+			_, err := f.ApplyStatusUpdate(tc.update)
+			assert.True(t, errors.Is(err, tc.err), "got %v", err)
+			assert.Equal(t, before, f)
+		})
+	}
+
+	t.Run("stale report", func(t *testing.T) {
+		f := statusTestFlight()
+		f.StatusUpdatedAt = reported
+		_, err := f.ApplyStatusUpdate(FlightStatusUpdate{Gate: "A1", ReportedAt: reported.Add(-time.Minute)})
+		assert.ErrorIs(t, err, ErrStaleStatusUpdate)
+	})
+}
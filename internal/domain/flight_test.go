@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlight_LocalTimes(t *testing.T) {
+	departure := time.Date(2025, 3, 10, 5, 15, 0, 0, time.UTC)
+	f := Flight{
+		DepartureTime:     departure,
+		ArrivalTime:       departure.Add(9 * time.Hour),
+		DepartureTimeZone: "Europe/Moscow",
+		ArrivalTimeZone:   "Asia/Vladivostok",
+	}
+
+	dep := f.LocalDeparture()
+	assert.Equal(t, 8, dep.Hour())
+	assert.Equal(t, "MSK", dep.Format("MST"))
+
+	arr := f.LocalArrival()
+	assert.Equal(t, 0, arr.Hour())
+	assert.Equal(t, "+10:00", arr.Format("-07:00"))
+	assert.True(t, arr.Equal(departure.Add(9*time.Hour)))
+}
+
+func TestInTimeZone_UnknownZoneFallsBackToUTC(t *testing.T) {
+	ts := time.Date(2025, 3, 10, 5, 15, 0, 0, time.FixedZone("X", 3600))
+	assert.Equal(t, time.UTC, InTimeZone(ts, "Mars/Olympus").Location())
+	assert.Equal(t, time.UTC, InTimeZone(ts, "").Location())
+}
+
+func TestFlight_Validate(t *testing.T) {
+	now := time.Now()
+	valid := Flight{FromAirport: "SVO", ToAirport: "LED", DepartureTime: now, ArrivalTime: now.Add(time.Hour), TotalSeats: 100}
+	assert.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name   string
+		modify func(f *Flight)
+		err    error
+	}{
+		{"same time", func(f *Flight) { f.ArrivalTime = f.DepartureTime }, ErrArrivalBeforeDeparture},
+		{"arrival before departure", func(f *Flight) { f.ArrivalTime = now.Add(-time.Hour) }, ErrArrivalBeforeDeparture},
+		{"too long", func(f *Flight) { f.ArrivalTime = now.Add(25 * time.Hour) }, ErrFlightTooLong},
+		{"missing airport", func(f *Flight) { f.ToAirport = "" }, ErrAirportRequired},
+		{"same airports", func(f *Flight) { f.ToAirport = "SVO" }, ErrSameAirports},
+		{"no seats", func(f *Flight) { f.TotalSeats = 0 }, ErrInvalidTotalSeats},
+		{"negative price", func(f *Flight) { f.PriceCents = -1 }, ErrInvalidPrice},
+		{"negative overbooking", func(f *Flight) { f.OverbookingLimit = -1 }, ErrInvalidOverbooking},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := valid
+			tc.modify(&f)
+			assert.ErrorIs(t, f.Validate(), tc.err)
+		})
+	}
+}
+
+func TestFlight_Sellable(t *testing.T) {
+	assert.Equal(t, 3, Flight{AvailableSeats: 3}.Sellable())
+	assert.Equal(t, 2, Flight{AvailableSeats: -3, OverbookingLimit: 5}.Sellable())
+	assert.Equal(t, 0, Flight{AvailableSeats: -5, OverbookingLimit: 5}.Sellable())
+}
+
+func TestFlight_Oversold(t *testing.T) {
+	assert.Equal(t, 0, Flight{TotalSeats: 100, AvailableSeats: 3}.Oversold())
+	assert.Equal(t, 0, Flight{TotalSeats: 100, AvailableSeats: 0}.Oversold())
+	assert.Equal(t, 4, Flight{TotalSeats: 100, AvailableSeats: -4, OverbookingLimit: 5}.Oversold())
+}
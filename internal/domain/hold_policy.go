@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidChannel      = NewValidationError("channel", "invalid booking channel")
+	ErrBookingNotPending   = &InvalidStateError{Code: "BOOKING_NOT_PENDING", Message: "booking is not pending"}
+	ErrHoldExpired         = &InvalidStateError{Code: "HOLD_EXPIRED", Message: "booking hold has expired"}
+	ErrHoldExtensionLimit  = &InvalidStateError{Code: "HOLD_EXTENSION_LIMIT", Message: "booking hold cannot be extended any more"}
+	ErrHoldNotExtendable   = &InvalidStateError{Code: "HOLD_NOT_EXTENDABLE", Message: "booking hold can no longer be extended"}
+	ErrInvalidHoldPolicy   = errors.New("hold policy TTL must be positive")
+	ErrInvalidHoldExtend   = errors.New("hold policy extension must not be negative")
+	ErrInvalidHoldMaxCount = errors.New("hold policy max extensions must not be negative")
+)
+
+// Channel is where a booking was made.
+type Channel string
+
+const (
+	ChannelWeb    Channel = "WEB"
+	ChannelMobile Channel = "MOBILE"
+	ChannelAgent  Channel = "AGENT"
+	ChannelAPI    Channel = "API"
+)
+
+var channels = map[Channel]struct{}{
+	ChannelWeb:    {},
+	ChannelMobile: {},
+	ChannelAgent:  {},
+	ChannelAPI:    {},
+}
+
+// ParseChannel accepts a case-insensitive channel; empty means web.
+func ParseChannel(s string) (Channel, error) {
+	if s == "" {
+		return ChannelWeb, nil
+	}
+	channel := Channel(strings.ToUpper(s))
+	if _, ok := channels[channel]; !ok {
+		return "", ErrInvalidChannel
+	}
+	return channel, nil
+}
+
+// HoldPolicy says how long an unconfirmed booking holds its seat and how the
+// hold may be extended. Empty Channel or FareClass match any value.
+type HoldPolicy struct {
+	Channel   Channel
+	FareClass FareClass
+	TTL       time.Duration
+	// ExtendBy is added to the expiry on every extension; zero means TTL.
+	ExtendBy      time.Duration
+	MaxExtensions int
+}
+
+func (p HoldPolicy) Validate() error {
+	if p.TTL <= 0 {
+		return ErrInvalidHoldPolicy
+	}
+	if p.ExtendBy < 0 {
+		return ErrInvalidHoldExtend
+	}
+	if p.MaxExtensions < 0 {
+		return ErrInvalidHoldMaxCount
+	}
+	return nil
+}
+
+// Extension returns the time added to the expiry by one extension.
+func (p HoldPolicy) Extension() time.Duration {
+	if p.ExtendBy > 0 {
+		return p.ExtendBy
+	}
+	return p.TTL
+}
+
+// HoldPolicies picks the hold policy of a booking. The most specific rule
+// wins: channel and fare class, then channel, then fare class, then Default.
+type HoldPolicies struct {
+	Default HoldPolicy
+	Rules   []HoldPolicy
+}
+
+func (p HoldPolicies) Validate() error {
+	if err := p.Default.Validate(); err != nil {
+		return err
+	}
+	for _, rule := range p.Rules {
+		if err := rule.withDefaults(p.Default).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p HoldPolicies) For(channel Channel, class FareClass) HoldPolicy {
+	best, bestScore := p.Default, -1
+	for _, rule := range p.Rules {
+		if (rule.Channel != "" && rule.Channel != channel) || (rule.FareClass != "" && rule.FareClass != class) {
+			continue
+		}
+		score := 0
+		if rule.Channel != "" {
+			score += 2
+		}
+		if rule.FareClass != "" {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = rule.withDefaults(p.Default), score
+		}
+	}
+	return best
+}
+
+// withDefaults fills a zero TTL and ExtendBy from the default policy.
+// MaxExtensions is taken as is: zero forbids extensions.
+func (p HoldPolicy) withDefaults(def HoldPolicy) HoldPolicy {
+	if p.TTL == 0 {
+		p.TTL = def.TTL
+	}
+	if p.ExtendBy == 0 {
+		p.ExtendBy = def.ExtendBy
+	}
+	return p
+}
+
+// ExtendHold checks that the pending booking may be extended under policy
+// at now and returns its new expiry.
+func (b Booking) ExtendHold(policy HoldPolicy, now time.Time) (time.Time, error) {
+	if b.Status != BookingStatusPending {
+		return time.Time{}, ErrBookingNotPending
+	}
+	if !b.ExpiresAt.After(now) {
+		return time.Time{}, ErrHoldExpired
+	}
+	if b.HoldExtensions >= policy.MaxExtensions {
+		return time.Time{}, ErrHoldExtensionLimit
+	}
+	return b.ExpiresAt.Add(policy.Extension()), nil
+}
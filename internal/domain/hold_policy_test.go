@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHoldPolicies_For(t *testing.T) {
+	policies := HoldPolicies{
+		Default: HoldPolicy{TTL: 15 * time.Minute, ExtendBy: 5 * time.Minute, MaxExtensions: 1},
+		Rules: []HoldPolicy{
+			{FareClass: FareClassBusiness, TTL: time.Hour, MaxExtensions: 2},
+			{Channel: ChannelAgent, TTL: 2 * time.Hour, MaxExtensions: 3},
+			{Channel: ChannelAgent, FareClass: FareClassFirst, TTL: 24 * time.Hour, ExtendBy: 12 * time.Hour, MaxExtensions: 1},
+		},
+	}
+	assert.NoError(t, policies.Validate())
+
+	assert.Equal(t, policies.Default, policies.For(ChannelWeb, FareClassEconomy))
+	assert.Equal(t, HoldPolicy{FareClass: FareClassBusiness, TTL: time.Hour, ExtendBy: 5 * time.Minute, MaxExtensions: 2}, policies.For(ChannelWeb, FareClassBusiness))
+	assert.Equal(t, 2*time.Hour, policies.For(ChannelAgent, FareClassBusiness).TTL, "channel beats fare class")
+	assert.Equal(t, 24*time.Hour, policies.For(ChannelAgent, FareClassFirst).TTL)
+	assert.Equal(t, 12*time.Hour, policies.For(ChannelAgent, FareClassFirst).Extension())
+}
+
+func TestHoldPolicies_Validate(t *testing.T) {
+	assert.ErrorIs(t, HoldPolicies{}.Validate(), ErrInvalidHoldPolicy)
+	assert.ErrorIs(t, HoldPolicies{Default: HoldPolicy{TTL: time.Minute, MaxExtensions: -1}}.Validate(), ErrInvalidHoldMaxCount)
+	assert.ErrorIs(t, HoldPolicies{
+		Default: HoldPolicy{TTL: time.Minute},
+		Rules:   []HoldPolicy{{Channel: ChannelMobile, ExtendBy: -time.Minute}},
+	}.Validate(), ErrInvalidHoldExtend)
+}
+
+func TestBooking_ExtendHold(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	policy := HoldPolicy{TTL: 15 * time.Minute, MaxExtensions: 2}
+	pending := Booking{Status: BookingStatusPending, ExpiresAt: now.Add(5 * time.Minute), HoldExtensions: 1}
+
+	expiresAt, err := pending.ExtendHold(policy, now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(20*time.Minute), expiresAt, "without ExtendBy the hold is extended by its TTL")
+
+	limit := pending
+	limit.HoldExtensions = 2
+	_, err = limit.ExtendHold(policy, now)
+	assert.ErrorIs(t, err, ErrHoldExtensionLimit)
+
+	expired := pending
+	expired.ExpiresAt = now
+	_, err = expired.ExtendHold(policy, now)
+	assert.ErrorIs(t, err, ErrHoldExpired)
+
+	confirmed := pending
+	confirmed.Status = BookingStatusConfirmed
+	_, err = confirmed.ExtendHold(policy, now)
+	assert.ErrorIs(t, err, ErrBookingNotPending)
+}
+
+func TestParseChannel(t *testing.T) {
+	channel, err := ParseChannel("")
+	assert.NoError(t, err)
+	assert.Equal(t, ChannelWeb, channel)
+	channel, err = ParseChannel("agent")
+	assert.NoError(t, err)
+	assert.Equal(t, ChannelAgent, channel)
+	_, err = ParseChannel("fax")
+	assert.ErrorIs(t, err, ErrInvalidChannel)
+}
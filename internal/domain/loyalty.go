@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+var (
+	ErrLoyaltyMemberNotFound  = &NotFoundError{Resource: "loyalty member"}
+	ErrAlreadyLoyaltyMember   = &InvalidStateError{Code: "ALREADY_LOYALTY_MEMBER", Message: "customer is already a loyalty member"}
+	ErrAirportLocationUnknown = &InvalidStateError{Code: "AIRPORT_LOCATION_UNKNOWN", Message: "airport coordinates are not known"}
+)
+
+// LoyaltyMember is the frequent-flyer account of a customer. The miles
+// balance is the sum of its ledger entries.
+type LoyaltyMember struct {
+	ID         int64
+	CustomerID int64
+	// Tier is held until TierExpiresAt unless it is requalified for.
+	Tier          LoyaltyTier
+	TierExpiresAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// TierAt returns the tier of the member at now: none once it has expired.
+func (m LoyaltyMember) TierAt(now time.Time) LoyaltyTier {
+	if m.Tier == LoyaltyTierNone || !now.Before(m.TierExpiresAt) {
+		return LoyaltyTierNone
+	}
+	return m.Tier
+}
+
+// LedgerEntryKind says why the miles balance changed.
+type LedgerEntryKind string
+
+const (
+	// LedgerEntryAccrual credits the miles of a flown booking.
+	LedgerEntryAccrual LedgerEntryKind = "ACCRUAL"
+	// LedgerEntryRedemption debits the miles a booking was paid with.
+	LedgerEntryRedemption LedgerEntryKind = "REDEMPTION"
+	// LedgerEntryRefund credits back the miles of a redemption, for a
+	// booking cancelled after it was paid.
+	LedgerEntryRefund LedgerEntryKind = "REFUND"
+)
+
+// LoyaltyLedgerEntry is an immutable change of the miles balance of a
+// member. Corrections are made with new entries.
+type LoyaltyLedgerEntry struct {
+	ID       int64
+	MemberID int64
+	Kind     LedgerEntryKind
+	// Miles is signed: credits are positive.
+	Miles int64
+	// QualifyingMiles count towards tiers; tier bonuses do not.
+	QualifyingMiles int64
+	// BookingID is zero for entries without a booking.
+	BookingID   int64
+	Description string
+	CreatedAt   time.Time
+}
+
+// LedgerPage selects a page of the ledger of a member, newest first.
+type LedgerPage struct {
+	// BeforeID continues a listing after the entry with this id; zero starts
+	// from the newest entry.
+	BeforeID int64
+	Limit    int
+}
+
+// GeoPoint is a location in degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// earthRadiusMiles is the mean radius of the Earth in statute miles.
+const earthRadiusMiles = 3958.8
+
+// GreatCircleMiles returns the great-circle distance between a and b in
+// statute miles, rounded to the nearest mile.
+func GreatCircleMiles(a, b GeoPoint) int64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return int64(math.Round(2 * earthRadiusMiles * math.Asin(math.Min(1, math.Sqrt(h)))))
+}
+
+// TierRule is what it takes to reach a tier and what it adds to accruals.
+type TierRule struct {
+	Tier LoyaltyTier
+	// QualifyingMiles needed within the qualification window.
+	QualifyingMiles int64
+	// BonusPercent of the qualifying miles is added to every accrual.
+	BonusPercent int64
+}
+
+// LoyaltyRules control accrual and tiers.
+type LoyaltyRules struct {
+	// FareMultipliers are percentages of the flown distance credited per
+	// fare class; classes not listed earn 100%.
+	FareMultipliers map[FareClass]int64
+	// MinimumMiles is the distance credited for shorter flights.
+	MinimumMiles int64
+	// Tiers are kept sorted by QualifyingMiles.
+	Tiers []TierRule
+	// QualificationWindow is how far back qualifying miles count.
+	QualificationWindow time.Duration
+	// TierValidity is how long a tier is held once reached.
+	TierValidity time.Duration
+}
+
+// DefaultLoyaltyRules are used when no loyalty rules are configured.
+func DefaultLoyaltyRules() LoyaltyRules {
+	return LoyaltyRules{
+		FareMultipliers: map[FareClass]int64{
+			FareClassEconomy:        100,
+			FareClassPremiumEconomy: 125,
+			FareClassBusiness:       150,
+			FareClassFirst:          200,
+		},
+		MinimumMiles: 500,
+		Tiers: []TierRule{
+			{Tier: LoyaltyTierSilver, QualifyingMiles: 25000, BonusPercent: 25},
+			{Tier: LoyaltyTierGold, QualifyingMiles: 50000, BonusPercent: 50},
+			{Tier: LoyaltyTierPlatinum, QualifyingMiles: 100000, BonusPercent: 100},
+		},
+		QualificationWindow: 365 * 24 * time.Hour,
+		TierValidity:        365 * 24 * time.Hour,
+	}
+}
+
+// Validate checks the rules and sorts the tiers.
+func (r *LoyaltyRules) Validate() error {
+	for class, percent := range r.FareMultipliers {
+		if percent < 0 {
+			return fmt.Errorf("fare multiplier of %s must not be negative", class)
+		}
+	}
+	if r.MinimumMiles < 0 {
+		return fmt.Errorf("minimum miles must not be negative")
+	}
+	if r.QualificationWindow <= 0 || r.TierValidity <= 0 {
+		return fmt.Errorf("qualification window and tier validity must be positive")
+	}
+	for _, t := range r.Tiers {
+		if _, ok := loyaltyTierPriority[t.Tier]; !ok || t.Tier == LoyaltyTierNone {
+			return fmt.Errorf("invalid loyalty tier %q", t.Tier)
+		}
+		if t.QualifyingMiles <= 0 || t.BonusPercent < 0 {
+			return fmt.Errorf("tier %s: qualifying miles must be positive and bonus not negative", t.Tier)
+		}
+	}
+	sort.Slice(r.Tiers, func(i, j int) bool { return r.Tiers[i].QualifyingMiles < r.Tiers[j].QualifyingMiles })
+	return nil
+}
+
+// Accrual returns the miles and qualifying miles earned by flying distance
+// in class while holding tier.
+func (r LoyaltyRules) Accrual(distance int64, class FareClass, tier LoyaltyTier) (miles, qualifying int64) {
+	percent, ok := r.FareMultipliers[class]
+	if !ok {
+		percent = 100
+	}
+	qualifying = max(distance, r.MinimumMiles) * percent / 100
+	miles = qualifying
+	for _, t := range r.Tiers {
+		if t.Tier == tier {
+			miles += qualifying * t.BonusPercent / 100
+		}
+	}
+	return miles, qualifying
+}
+
+// TierFor returns the highest tier reached with qualifying miles.
+func (r LoyaltyRules) TierFor(qualifying int64) LoyaltyTier {
+	tier := LoyaltyTierNone
+	for _, t := range r.Tiers {
+		if qualifying >= t.QualifyingMiles {
+			tier = t.Tier
+		}
+	}
+	return tier
+}
+
+// NextTier returns the tier above qualifying miles and the miles missing to
+// reach it, or no tier at the top.
+func (r LoyaltyRules) NextTier(qualifying int64) (LoyaltyTier, int64) {
+	for _, t := range r.Tiers {
+		if qualifying < t.QualifyingMiles {
+			return t.Tier, t.QualifyingMiles - qualifying
+		}
+	}
+	return LoyaltyTierNone, 0
+}
+
+// Requalify returns the tier of the member after reaching qualifying miles
+// at now and until when it is held. A tier reached again is extended; a
+// lower one does not replace the current tier before it expires.
+func (r LoyaltyRules) Requalify(m LoyaltyMember, qualifying int64, now time.Time) (LoyaltyTier, time.Time) {
+	current := m.TierAt(now)
+	reached := r.TierFor(qualifying)
+	if reached == LoyaltyTierNone || loyaltyTierPriority[reached] < loyaltyTierPriority[current] {
+		if current == LoyaltyTierNone {
+			return LoyaltyTierNone, time.Time{}
+		}
+		return current, m.TierExpiresAt
+	}
+	return reached, now.Add(r.TierValidity)
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGreatCircleMiles(t *testing.T) {
+	jfk := GeoPoint{Latitude: 40.6413, Longitude: -73.7781}
+	lhr := GeoPoint{Latitude: 51.4700, Longitude: -0.4543}
+
+	assert.InDelta(t, 3442, GreatCircleMiles(jfk, lhr), 10)
+	assert.Equal(t, GreatCircleMiles(jfk, lhr), GreatCircleMiles(lhr, jfk))
+	assert.Zero(t, GreatCircleMiles(jfk, jfk))
+}
+
+func TestLoyaltyRules_Accrual(t *testing.T) {
+	rules := DefaultLoyaltyRules()
+
+	miles, qualifying := rules.Accrual(1000, FareClassBusiness, LoyaltyTierNone)
+	assert.Equal(t, int64(1500), miles)
+	assert.Equal(t, int64(1500), qualifying)
+
+	miles, qualifying = rules.Accrual(200, FareClassEconomy, LoyaltyTierGold)
+	assert.Equal(t, int64(500), qualifying, "short flights earn the minimum")
+	assert.Equal(t, int64(750), miles, "the tier bonus does not qualify")
+}
+
+func TestLoyaltyRules_Tiers(t *testing.T) {
+	rules := DefaultLoyaltyRules()
+
+	assert.Equal(t, LoyaltyTierNone, rules.TierFor(24999))
+	assert.Equal(t, LoyaltyTierGold, rules.TierFor(60000))
+	next, missing := rules.NextTier(60000)
+	assert.Equal(t, LoyaltyTierPlatinum, next)
+	assert.Equal(t, int64(40000), missing)
+	next, _ = rules.NextTier(100000)
+	assert.Equal(t, LoyaltyTierNone, next)
+}
+
+func TestLoyaltyRules_Requalify(t *testing.T) {
+	rules := DefaultLoyaltyRules()
+	now := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	gold := LoyaltyMember{Tier: LoyaltyTierGold, TierExpiresAt: now.Add(30 * 24 * time.Hour)}
+
+	tier, expires := rules.Requalify(gold, 30000, now)
+	assert.Equal(t, LoyaltyTierGold, tier, "a lower tier waits for the current one to expire")
+	assert.Equal(t, gold.TierExpiresAt, expires)
+
+	tier, expires = rules.Requalify(gold, 50000, now)
+	assert.Equal(t, LoyaltyTierGold, tier)
+	assert.Equal(t, now.Add(rules.TierValidity), expires)
+
+	tier, _ = rules.Requalify(gold, 30000, gold.TierExpiresAt)
+	assert.Equal(t, LoyaltyTierSilver, tier, "an expired tier drops to the one requalified for")
+
+	tier, expires = rules.Requalify(LoyaltyMember{}, 100, now)
+	assert.Equal(t, LoyaltyTierNone, tier)
+	assert.True(t, expires.IsZero())
+}
+
+func TestLoyaltyRules_Validate(t *testing.T) {
+	rules := DefaultLoyaltyRules()
+	rules.Tiers[0], rules.Tiers[2] = rules.Tiers[2], rules.Tiers[0]
+	assert.NoError(t, rules.Validate())
+	assert.Equal(t, LoyaltyTierSilver, rules.Tiers[0].Tier)
+
+	rules.Tiers = append(rules.Tiers, TierRule{Tier: "DIAMOND", QualifyingMiles: 1})
+	assert.Error(t, rules.Validate())
+}
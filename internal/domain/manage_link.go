@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+var (
+	ErrManageLinkInvalid      = &UnauthenticatedError{Code: "MANAGE_LINK_INVALID", Message: "manage link is invalid or expired"}
+	ErrBookingSessionRequired = &UnauthenticatedError{Code: "BOOKING_SESSION_REQUIRED", Message: "open the manage link of the booking"}
+)
+
+// ManageLink is the signed part of a manage-booking link emailed to a
+// passenger. The signature covers the booking token as well, so links stop
+// working once the token is rotated.
+type ManageLink struct {
+	BookingID int64
+	ExpiresAt time.Time
+	Signature string
+}
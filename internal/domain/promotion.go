@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+var (
+	ErrPromotionNotFound      = &NotFoundError{Resource: "promotion"}
+	ErrPromoCodeTaken         = &InvalidStateError{Code: "PROMO_CODE_TAKEN", Message: "promo code is already in use"}
+	ErrPromoCodeNotValid      = &InvalidStateError{Code: "PROMO_CODE_NOT_VALID", Message: "promo code is not valid now"}
+	ErrPromoCodeNotApplicable = &InvalidStateError{Code: "PROMO_CODE_NOT_APPLICABLE", Message: "promo code does not apply to this flight"}
+	ErrPromoCodeExhausted     = &InvalidStateError{Code: "PROMO_CODE_EXHAUSTED", Message: "promo code has been used up"}
+	ErrPromoCodeLimitReached  = &InvalidStateError{Code: "PROMO_CODE_LIMIT_REACHED", Message: "promo code has already been used by this customer"}
+)
+
+// DiscountType says how a promotion takes money off the fare.
+type DiscountType string
+
+const (
+	// DiscountPercent takes PercentOff percent off the fare.
+	DiscountPercent DiscountType = "PERCENT"
+	// DiscountFixed takes AmountOffCents off the fare.
+	DiscountFixed DiscountType = "FIXED"
+)
+
+// Promotion is a discount campaign redeemed with its promo code when a
+// booking is made. Zero times and empty airports do not restrict it.
+type Promotion struct {
+	ID          int64
+	Code        string
+	Description string
+	Type        DiscountType
+	// PercentOff is set for DiscountPercent promotions, 1 to 100.
+	PercentOff int64
+	// AmountOffCents is set for DiscountFixed promotions. The discount never
+	// exceeds the fare.
+	AmountOffCents int64
+	// Currency of AmountOffCents. Fixed discounts only apply to flights
+	// priced in it.
+	Currency string
+	// ValidFrom and ValidUntil bound when bookings can be made with the code.
+	ValidFrom  time.Time
+	ValidUntil time.Time
+	// FromAirport and ToAirport restrict the route.
+	FromAirport string
+	ToAirport   string
+	// TravelFrom and TravelUntil bound the departure of the flight.
+	TravelFrom  time.Time
+	TravelUntil time.Time
+	// MaxUses caps the bookings made with the code, MaxUsesPerCustomer the
+	// bookings per customer account, or per email, trimmed and compared
+	// without case, for guest bookings. Zero is unlimited. Bookings that
+	// expire or are cancelled give their use back.
+	MaxUses            int
+	MaxUsesPerCustomer int
+	Active             bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// NormalizePromoCode returns the stored form of a promo code: trimmed and
+// upper-case.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate normalizes the code and airports of the promotion and checks it.
+func (p *Promotion) Validate() error {
+	p.Code = NormalizePromoCode(p.Code)
+	p.FromAirport = strings.ToUpper(strings.TrimSpace(p.FromAirport))
+	p.ToAirport = strings.ToUpper(strings.TrimSpace(p.ToAirport))
+
+	var violations []FieldViolation
+	add := func(field, description string) {
+		violations = append(violations, FieldViolation{Field: field, Description: description})
+	}
+	if p.Code == "" || strings.ContainsAny(p.Code, " \t\n") {
+		add("code", "code is required and must not contain spaces")
+	}
+	switch p.Type {
+	case DiscountPercent:
+		if p.PercentOff <= 0 || p.PercentOff > 100 {
+			add("percent_off", "percent off must be between 1 and 100")
+		}
+		if p.AmountOffCents != 0 {
+			add("amount_off_cents", "amount off is only set for FIXED discounts")
+		}
+		if p.Currency != "" {
+			add("currency", "currency is only set for FIXED discounts")
+		}
+	case DiscountFixed:
+		if p.AmountOffCents <= 0 {
+			add("amount_off_cents", "amount off must be positive")
+		}
+		if p.PercentOff != 0 {
+			add("percent_off", "percent off is only set for PERCENT discounts")
+		}
+		currency, err := ParseCurrency(p.Currency)
+		if err != nil {
+			add("currency", "currency must be an ISO 4217 code")
+		}
+		p.Currency = currency
+	default:
+		add("type", "type must be PERCENT or FIXED")
+	}
+	if !p.ValidFrom.IsZero() && !p.ValidUntil.IsZero() && !p.ValidUntil.After(p.ValidFrom) {
+		add("valid_until", "valid until must be after valid from")
+	}
+	if !p.TravelFrom.IsZero() && !p.TravelUntil.IsZero() && !p.TravelUntil.After(p.TravelFrom) {
+		add("travel_until", "travel until must be after travel from")
+	}
+	if p.MaxUses < 0 {
+		add("max_uses", "max uses must not be negative")
+	}
+	if p.MaxUsesPerCustomer < 0 {
+		add("max_uses_per_customer", "max uses per customer must not be negative")
+	}
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// Apply returns the discount the promotion gives on flight f when booked at
+// now. It is run again, with the usage limits, on the locked promotion when
+// the booking is stored.
+func (p Promotion) Apply(f Flight, now time.Time) (int64, error) {
+	if !p.Active || now.Before(p.ValidFrom) || (!p.ValidUntil.IsZero() && !now.Before(p.ValidUntil)) {
+		return 0, ErrPromoCodeNotValid
+	}
+	if (p.FromAirport != "" && p.FromAirport != f.FromAirport) || (p.ToAirport != "" && p.ToAirport != f.ToAirport) {
+		return 0, ErrPromoCodeNotApplicable
+	}
+	if f.DepartureTime.Before(p.TravelFrom) || (!p.TravelUntil.IsZero() && !f.DepartureTime.Before(p.TravelUntil)) {
+		return 0, ErrPromoCodeNotApplicable
+	}
+	if p.Type == DiscountFixed && p.Currency != f.Currency {
+		return 0, ErrPromoCodeNotApplicable
+	}
+	return p.Discount(f.PriceCents), nil
+}
+
+// Discount returns what the promotion takes off priceCents, rounded down
+// for percentages.
+func (p Promotion) Discount(priceCents int64) int64 {
+	discount := p.AmountOffCents
+	if p.Type == DiscountPercent {
+		discount = priceCents * p.PercentOff / 100
+	}
+	return min(max(discount, 0), priceCents)
+}
+
+// PromotionUsage reports how a promotion was used. Uses count the bookings
+// holding a use; the bookings that expired or were cancelled have given it
+// back.
+type PromotionUsage struct {
+	Promotion   Promotion
+	Redemptions int
+	Uses        int
+	Customers   int
+	// DiscountCents is the discount given to the bookings holding a use.
+	DiscountCents int64
+	// ByStatus counts the redemptions by the current status of their
+	// booking.
+	ByStatus map[BookingStatus]int
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromotion_Validate(t *testing.T) {
+	p := Promotion{Code: " spring25 ", Type: DiscountPercent, PercentOff: 25, FromAirport: "svo"}
+	assert.NoError(t, p.Validate())
+	assert.Equal(t, "SPRING25", p.Code)
+	assert.Equal(t, "SVO", p.FromAirport)
+
+	fixed := Promotion{Code: "TENOFF", Type: DiscountFixed, AmountOffCents: 1000}
+	assert.NoError(t, fixed.Validate())
+	assert.Equal(t, DefaultCurrency, fixed.Currency)
+
+	invalid := Promotion{Code: "X", Type: DiscountFixed, PercentOff: 5, MaxUses: -1}
+	var validation *ValidationError
+	assert.ErrorAs(t, invalid.Validate(), &validation)
+	assert.Len(t, validation.Violations, 3)
+}
+
+func TestPromotion_Apply(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	flight := Flight{FromAirport: "SVO", ToAirport: "LED", DepartureTime: now.Add(30 * 24 * time.Hour), PriceCents: 9999}
+	p := Promotion{Type: DiscountPercent, PercentOff: 10, Active: true, ValidUntil: now.Add(time.Hour), ToAirport: "LED"}
+
+	discount, err := p.Apply(flight, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(999), discount, "percentages round down")
+
+	_, err = p.Apply(flight, now.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrPromoCodeNotValid)
+
+	p.TravelUntil = flight.DepartureTime
+	_, err = p.Apply(flight, now)
+	assert.ErrorIs(t, err, ErrPromoCodeNotApplicable)
+
+	fixed := Promotion{Type: DiscountFixed, AmountOffCents: 20000, Active: true}
+	discount, err = fixed.Apply(flight, now)
+	assert.NoError(t, err)
+	assert.Equal(t, flight.PriceCents, discount, "the discount never exceeds the fare")
+
+	flight.Currency = "EUR"
+	_, err = fixed.Apply(flight, now)
+	assert.ErrorIs(t, err, ErrPromoCodeNotApplicable, "fixed discounts only apply to flights in their currency")
+	flight.Currency = ""
+
+	fixed.Active = false
+	_, err = fixed.Apply(flight, now)
+	assert.ErrorIs(t, err, ErrPromoCodeNotValid)
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidWeekdays  = NewValidationError("days_of_week", "invalid days of week")
+	ErrInvalidSchedule  = NewValidationError("schedule", "invalid schedule")
+	ErrScheduleNotFound = &NotFoundError{Resource: "schedule"}
+)
+
+// Weekdays is a set of operating days. Monday is bit 0, Sunday is bit 6,
+// which matches the SSIM "1234567" day numbering.
+type Weekdays uint8
+
+const AllWeekdays Weekdays = 1<<7 - 1
+
+// ParseWeekdays parses the SSIM notation: a 7 character string where
+// position N holds the digit N if the flight operates on that day and a
+// space otherwise, e.g. "12345 7" for daily except Saturday. Compact forms
+// such as "1357" are accepted as well.
+func ParseWeekdays(s string) (Weekdays, error) {
+	var w Weekdays
+	for i, r := range s {
+		switch {
+		case r == ' ' || r == '.':
+			if len(s) != 7 {
+				return 0, ErrInvalidWeekdays
+			}
+		case r >= '1' && r <= '7':
+			if len(s) == 7 && int(r-'1') != i {
+				return 0, ErrInvalidWeekdays
+			}
+			w |= 1 << (r - '1')
+		default:
+			return 0, ErrInvalidWeekdays
+		}
+	}
+	if w == 0 {
+		return 0, ErrInvalidWeekdays
+	}
+	return w, nil
+}
+
+// Has reports whether the set contains the given day.
+func (w Weekdays) Has(day time.Weekday) bool {
+	// time.Weekday has Sunday = 0.
+	bit := (int(day) + 6) % 7
+	return w&(1<<bit) != 0
+}
+
+// String renders the set in SSIM notation.
+func (w Weekdays) String() string {
+	var b strings.Builder
+	for i := 0; i < 7; i++ {
+		if w&(1<<i) != 0 {
+			b.WriteByte(byte('1' + i))
+		} else {
+			b.WriteByte(' ')
+		}
+	}
+	return b.String()
+}
+
+// Schedule is a recurring flight definition that is materialized into
+// flights rows for a rolling horizon.
+type Schedule struct {
+	ID                int64
+	FlightNumber      string
+	FromAirport       string
+	ToAirport         string
+	DaysOfWeek        Weekdays
+	DepartureLocal    time.Duration // offset from local midnight at the departure airport
+	BlockTime         time.Duration
+	EffectiveFrom     time.Time // first operating date, inclusive
+	EffectiveTo       time.Time // last operating date, inclusive
+	Aircraft          string
+	TotalSeats        int
+	PriceCents        int64
+	DepartureTimeZone string
+	ArrivalTimeZone   string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (s Schedule) Validate() error {
+	switch {
+	case s.FlightNumber == "":
+		return errors.Join(ErrInvalidSchedule, NewValidationError("flight_number", "flight number is required"))
+	case s.DaysOfWeek == 0 || s.DaysOfWeek > AllWeekdays:
+		return errors.Join(ErrInvalidSchedule, ErrInvalidWeekdays)
+	case s.DepartureLocal < 0 || s.DepartureLocal >= 24*time.Hour:
+		return errors.Join(ErrInvalidSchedule, NewValidationError("departure_local", "departure time must be within a day"))
+	case s.EffectiveTo.Before(s.EffectiveFrom):
+		return errors.Join(ErrInvalidSchedule, NewValidationError("effective_to", "effective period ends before it starts"))
+	}
+	probe := Flight{
+		FromAirport:   s.FromAirport,
+		ToAirport:     s.ToAirport,
+		DepartureTime: s.EffectiveFrom,
+		ArrivalTime:   s.EffectiveFrom.Add(s.BlockTime),
+		TotalSeats:    s.TotalSeats,
+		PriceCents:    s.PriceCents,
+	}
+	if err := probe.Validate(); err != nil {
+		return errors.Join(ErrInvalidSchedule, err)
+	}
+	return nil
+}
+
+// Occurrences returns the flights generated by the schedule with a departure
+// in [from, to). Operating days are evaluated on the local calendar date of
+// the departure airport, so a daily 08:15 departure stays at 08:15 local time
+// across DST changes.
+func (s Schedule) Occurrences(from, to time.Time) []Flight {
+	loc, err := LoadLocation(s.DepartureTimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	first := dateOf(s.EffectiveFrom)
+	last := dateOf(s.EffectiveTo)
+	if start := dateOf(from.In(loc)); start.After(first) {
+		first = start
+	}
+	if end := dateOf(to.In(loc)); end.Before(last) {
+		last = end
+	}
+
+	hour := int(s.DepartureLocal / time.Hour)
+	minute := int(s.DepartureLocal % time.Hour / time.Minute)
+
+	var flights []Flight
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !s.DaysOfWeek.Has(day.Weekday()) {
+			continue
+		}
+		departure := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+		if departure.Before(from) || !departure.Before(to) {
+			continue
+		}
+		flights = append(flights, Flight{
+			FlightNumber:      s.FlightNumber,
+			ScheduleID:        s.ID,
+			FromAirport:       s.FromAirport,
+			ToAirport:         s.ToAirport,
+			DepartureTime:     departure.UTC(),
+			ArrivalTime:       departure.Add(s.BlockTime).UTC(),
+			DepartureTimeZone: s.DepartureTimeZone,
+			ArrivalTimeZone:   s.ArrivalTimeZone,
+			TotalSeats:        s.TotalSeats,
+			AvailableSeats:    s.TotalSeats,
+			PriceCents:        s.PriceCents,
+		})
+	}
+	return flights
+}
+
+// dateOf truncates t to its calendar date, keeping the date in UTC so that
+// day arithmetic is not affected by DST transitions.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWeekdays(t *testing.T) {
+	w, err := ParseWeekdays("12345 7")
+	assert.NoError(t, err)
+	assert.Equal(t, "12345 7", w.String())
+	assert.True(t, w.Has(time.Monday))
+	assert.True(t, w.Has(time.Sunday))
+	assert.False(t, w.Has(time.Saturday))
+
+	w, err = ParseWeekdays("135")
+	assert.NoError(t, err)
+	assert.Equal(t, "1 3 5  ", w.String())
+
+	for _, bad := range []string{"", "       ", "2134567", "8", "1-3"} {
+		_, err := ParseWeekdays(bad)
+		assert.ErrorIs(t, err, ErrInvalidWeekdays, bad)
+	}
+}
+
+func testSchedule() Schedule {
+	return Schedule{
+		ID:                1,
+		FlightNumber:      "SU123",
+		FromAirport:       "SVO",
+		ToAirport:         "LED",
+		DaysOfWeek:        AllWeekdays &^ (1 << 5), // daily except Saturday
+		DepartureLocal:    8*time.Hour + 15*time.Minute,
+		BlockTime:         90 * time.Minute,
+		EffectiveFrom:     time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		EffectiveTo:       time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC),
+		Aircraft:          "320",
+		TotalSeats:        150,
+		DepartureTimeZone: "Europe/Berlin",
+		ArrivalTimeZone:   "Europe/Moscow",
+	}
+}
+
+func TestSchedule_Occurrences(t *testing.T) {
+	s := testSchedule()
+	berlin, _ := LoadLocation("Europe/Berlin")
+
+	// Week of the DST switch in Europe (Sunday 2025-03-30).
+	from := time.Date(2025, 3, 24, 0, 0, 0, 0, berlin)
+	to := from.AddDate(0, 0, 7)
+	flights := s.Occurrences(from, to)
+
+	assert.Len(t, flights, 6)
+	for _, f := range flights {
+		local := f.DepartureTime.In(berlin)
+		assert.NotEqual(t, time.Saturday, local.Weekday())
+		assert.Equal(t, 8, local.Hour())
+		assert.Equal(t, 15, local.Minute())
+		assert.Equal(t, 90*time.Minute, f.ArrivalTime.Sub(f.DepartureTime))
+		assert.Equal(t, "SU123", f.FlightNumber)
+		assert.Equal(t, int64(1), f.ScheduleID)
+		assert.Equal(t, 150, f.AvailableSeats)
+	}
+	// 07:15 UTC before the switch, 06:15 UTC after.
+	assert.Equal(t, 7, flights[0].DepartureTime.Hour())
+	assert.Equal(t, 6, flights[len(flights)-1].DepartureTime.Hour())
+}
+
+func TestSchedule_Occurrences_RespectsEffectivePeriod(t *testing.T) {
+	s := testSchedule()
+	from := time.Date(2025, 10, 29, 0, 0, 0, 0, time.UTC)
+	flights := s.Occurrences(from, from.AddDate(0, 0, 10))
+
+	// Oct 29, 30, 31 are Wed, Thu, Fri.
+	assert.Len(t, flights, 3)
+
+	// Departures already in the past relative to "from" are skipped.
+	from = time.Date(2025, 10, 29, 12, 0, 0, 0, time.UTC)
+	assert.Len(t, s.Occurrences(from, from.AddDate(0, 0, 10)), 2)
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	s := testSchedule()
+	assert.NoError(t, s.Validate())
+
+	s.DepartureLocal = 24 * time.Hour
+	assert.ErrorIs(t, s.Validate(), ErrInvalidSchedule)
+
+	s = testSchedule()
+	s.EffectiveTo = s.EffectiveFrom.AddDate(0, 0, -1)
+	assert.ErrorIs(t, s.Validate(), ErrInvalidSchedule)
+
+	s = testSchedule()
+	s.BlockTime = 0
+	assert.ErrorIs(t, s.Validate(), ErrArrivalBeforeDeparture)
+}
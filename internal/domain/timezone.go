@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"sync"
+	"time"
+)
+
+var locations sync.Map
+
+// InTimeZone converts t to the IANA time zone name (e.g. "Europe/Moscow").
+// Unknown or empty zones fall back to UTC.
+func InTimeZone(t time.Time, zone string) time.Time {
+	loc, err := LoadLocation(zone)
+	if err != nil {
+		return t.UTC()
+	}
+	return t.In(loc)
+}
+
+// LoadLocation is a cached time.LoadLocation.
+func LoadLocation(zone string) (*time.Location, error) {
+	if zone == "" {
+		return time.UTC, nil
+	}
+	if loc, ok := locations.Load(zone); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(zone, loc)
+	return loc, nil
+}
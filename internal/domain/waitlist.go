@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+var (
+	ErrAlreadyWaitlisted  = &InvalidStateError{Code: "ALREADY_WAITLISTED", Message: "already on the waitlist for this flight"}
+	ErrFlightNotSoldOut   = &InvalidStateError{Code: "FLIGHT_NOT_SOLD_OUT", Message: "flight has available seats, book directly"}
+	ErrInvalidFareClass   = NewValidationError("fare_class", "invalid fare class")
+	ErrInvalidLoyaltyTier = NewValidationError("loyalty_tier", "invalid loyalty tier")
+)
+
+type FareClass string
+
+const (
+	FareClassEconomy        FareClass = "ECONOMY"
+	FareClassPremiumEconomy FareClass = "PREMIUM_ECONOMY"
+	FareClassBusiness       FareClass = "BUSINESS"
+	FareClassFirst          FareClass = "FIRST"
+)
+
+type LoyaltyTier string
+
+const (
+	LoyaltyTierNone     LoyaltyTier = ""
+	LoyaltyTierSilver   LoyaltyTier = "SILVER"
+	LoyaltyTierGold     LoyaltyTier = "GOLD"
+	LoyaltyTierPlatinum LoyaltyTier = "PLATINUM"
+)
+
+var fareClassPriority = map[FareClass]int{
+	FareClassEconomy:        0,
+	FareClassPremiumEconomy: 10,
+	FareClassBusiness:       20,
+	FareClassFirst:          30,
+}
+
+var loyaltyTierPriority = map[LoyaltyTier]int{
+	LoyaltyTierNone:     0,
+	LoyaltyTierSilver:   1,
+	LoyaltyTierGold:     2,
+	LoyaltyTierPlatinum: 3,
+}
+
+// ParseFareClass accepts a case-insensitive fare class; empty means economy.
+func ParseFareClass(s string) (FareClass, error) {
+	if s == "" {
+		return FareClassEconomy, nil
+	}
+	class := FareClass(strings.ToUpper(s))
+	if _, ok := fareClassPriority[class]; !ok {
+		return "", ErrInvalidFareClass
+	}
+	return class, nil
+}
+
+// ParseLoyaltyTier accepts a case-insensitive tier; empty means no tier.
+func ParseLoyaltyTier(s string) (LoyaltyTier, error) {
+	tier := LoyaltyTier(strings.ToUpper(s))
+	if _, ok := loyaltyTierPriority[tier]; !ok {
+		return "", ErrInvalidLoyaltyTier
+	}
+	return tier, nil
+}
+
+// WaitlistPriority ranks waitlisted customers: fare class first, loyalty tier
+// second. Entries with equal priority are served first come, first served.
+func WaitlistPriority(class FareClass, tier LoyaltyTier) int {
+	return fareClassPriority[class] + loyaltyTierPriority[tier]
+}
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting WaitlistStatus = "WAITING"
+	// WaitlistStatusOffered entries hold a pending booking (BookingToken)
+	// that the customer has to confirm before it expires.
+	WaitlistStatusOffered WaitlistStatus = "OFFERED"
+)
+
+type WaitlistEntry struct {
+	ID           int64
+	FlightID     int64
+	Email        string
+	FareClass    FareClass
+	LoyaltyTier  LoyaltyTier
+	Priority     int
+	Status       WaitlistStatus
+	BookingToken string
+	// Position is the 1-based place in the queue of waiting entries.
+	Position  int
+	OfferedAt time.Time
+	CreatedAt time.Time
+}
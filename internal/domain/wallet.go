@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	ErrPaymentReservationNotFound = &NotFoundError{Resource: "payment reservation"}
+	ErrInsufficientMiles          = &InvalidStateError{Code: "INSUFFICIENT_MILES", Message: "not enough miles available"}
+	ErrInsufficientCredit         = &InvalidStateError{Code: "INSUFFICIENT_CREDIT", Message: "not enough travel credit available"}
+	ErrRedemptionExceedsPrice     = NewValidationError("credit_cents", "miles and credit must not exceed the price of the booking")
+	ErrWalletCustomerRequired     = &InvalidStateError{Code: "BOOKING_CUSTOMER_REQUIRED", Message: "only bookings of customer accounts can be paid with miles or credit"}
+)
+
+// WalletEntryKind says why the travel credit of a customer changed.
+type WalletEntryKind string
+
+const (
+	// WalletEntryCredit issues travel credit, e.g. for a cancelled booking.
+	WalletEntryCredit WalletEntryKind = "CREDIT"
+	// WalletEntryRedemption debits the credit a booking was paid with.
+	WalletEntryRedemption WalletEntryKind = "REDEMPTION"
+	// WalletEntryRefund credits back the credit of a redemption.
+	WalletEntryRefund WalletEntryKind = "REFUND"
+)
+
+// WalletEntry is an immutable change of the travel credit of a customer.
+// Like the miles ledger, corrections are made with new entries.
+type WalletEntry struct {
+	ID         int64
+	CustomerID int64
+	Kind       WalletEntryKind
+	// AmountCents is signed: credits are positive.
+	AmountCents int64
+	// BookingID is zero for entries without a booking.
+	BookingID   int64
+	Description string
+	CreatedAt   time.Time
+}
+
+// WalletBalance is what a customer can pay bookings with. Reserved amounts
+// are held by pending bookings and are not available to others.
+type WalletBalance struct {
+	CreditCents         int64
+	ReservedCreditCents int64
+	Miles               int64
+	ReservedMiles       int64
+}
+
+// AvailableCreditCents is the credit not held by pending bookings.
+func (b WalletBalance) AvailableCreditCents() int64 {
+	return b.CreditCents - b.ReservedCreditCents
+}
+
+// AvailableMiles are the miles not held by pending bookings.
+func (b WalletBalance) AvailableMiles() int64 {
+	return b.Miles - b.ReservedMiles
+}
+
+// ReservationStatus is the state of a payment reservation.
+type ReservationStatus string
+
+const (
+	// ReservationHeld holds miles and credit while the booking is pending.
+	// A held reservation stops counting once its booking leaves PENDING.
+	ReservationHeld ReservationStatus = "HELD"
+	// ReservationCaptured paid the booking when it was confirmed.
+	ReservationCaptured ReservationStatus = "CAPTURED"
+	// ReservationReleased was given back unused.
+	ReservationReleased ReservationStatus = "RELEASED"
+	// ReservationRefunded was paid back after the booking was cancelled.
+	ReservationRefunded ReservationStatus = "REFUNDED"
+)
+
+// PaymentReservation is the part of the price of a booking paid with miles
+// and travel credit. The rest, AmountDueCents, is paid otherwise.
+type PaymentReservation struct {
+	ID         int64
+	BookingID  int64
+	CustomerID int64
+	Miles      int64
+	// MilesValueCents is what Miles were worth when reserved.
+	MilesValueCents int64
+	CreditCents     int64
+	// PriceCents is the total price of the booking, taxes and fees
+	// included, in Currency.
+	PriceCents int64
+	// Currency is the settlement currency the reservation is paid in.
+	Currency  string
+	Status    ReservationStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AmountDueCents is the part of the price not paid with miles or credit.
+func (r PaymentReservation) AmountDueCents() int64 {
+	return r.PriceCents - r.MilesValueCents - r.CreditCents
+}
+
+// RedemptionRules control paying with miles and travel credit and the
+// credit given for cancelled bookings.
+type RedemptionRules struct {
+	// SettlementCurrency is the currency payments are settled in. Travel
+	// credit and the value of miles are in it; bookings priced in another
+	// currency are converted when paid. Empty settles in the currency of
+	// the booking.
+	SettlementCurrency string
+	// CentsPerHundredMiles is what 100 miles are worth.
+	CentsPerHundredMiles int64
+	// CancellationCreditPercent of the part of the price paid otherwise is
+	// issued as travel credit when a booking paid with miles or credit is
+	// cancelled. The miles and credit are always given back; bookings paid
+	// only otherwise earn no credit.
+	CancellationCreditPercent int64
+}
+
+// DefaultRedemptionRules value a mile at a cent and give no credit for
+// cancelled bookings.
+func DefaultRedemptionRules() RedemptionRules {
+	return RedemptionRules{SettlementCurrency: DefaultCurrency, CentsPerHundredMiles: 100}
+}
+
+// Validate checks the rules.
+func (r RedemptionRules) Validate() error {
+	if r.SettlementCurrency != "" {
+		if _, ok := currencyCode(r.SettlementCurrency); !ok {
+			return fmt.Errorf("settlement currency %q is not an ISO 4217 code", r.SettlementCurrency)
+		}
+	}
+	if r.CentsPerHundredMiles <= 0 {
+		return fmt.Errorf("the value of miles must be positive")
+	}
+	if r.CancellationCreditPercent < 0 || r.CancellationCreditPercent > 100 {
+		return fmt.Errorf("cancellation credit must be between 0 and 100 percent")
+	}
+	return nil
+}
+
+// Settlement returns the total price of booking b in the settlement
+// currency, converted with rates.
+func (r RedemptionRules) Settlement(b Booking, rates ExchangeRates) (Money, error) {
+	total := b.Total()
+	if r.SettlementCurrency == "" || b.Currency == "" {
+		return total, nil
+	}
+	return rates.Convert(total, r.SettlementCurrency)
+}
+
+// MilesValueCents returns what miles are worth, rounded down.
+func (r RedemptionRules) MilesValueCents(miles int64) int64 {
+	return miles * r.CentsPerHundredMiles / 100
+}
+
+// Reserve returns the reservation paying the total price of booking b,
+// converted to the settlement currency with rates, with miles and
+// creditCents. Miles worth more than what is left after the credit are not
+// accepted.
+func (r RedemptionRules) Reserve(b Booking, rates ExchangeRates, miles, creditCents int64) (*PaymentReservation, error) {
+	if b.CustomerID == 0 {
+		return nil, ErrWalletCustomerRequired
+	}
+	if b.Status != BookingStatusPending {
+		return nil, &BookingTransitionError{From: b.Status, To: BookingStatusConfirmed}
+	}
+	var violations []FieldViolation
+	if miles < 0 {
+		violations = append(violations, FieldViolation{Field: "miles", Description: "miles must not be negative"})
+	}
+	if creditCents < 0 {
+		violations = append(violations, FieldViolation{Field: "credit_cents", Description: "credit must not be negative"})
+	}
+	if len(violations) > 0 {
+		return nil, &ValidationError{Violations: violations}
+	}
+	total, err := r.Settlement(b, rates)
+	if err != nil {
+		return nil, err
+	}
+	res := &PaymentReservation{
+		BookingID:       b.ID,
+		CustomerID:      b.CustomerID,
+		Miles:           miles,
+		MilesValueCents: r.MilesValueCents(miles),
+		CreditCents:     creditCents,
+		PriceCents:      total.Cents,
+		Currency:        total.Currency,
+		Status:          ReservationHeld,
+	}
+	if res.AmountDueCents() < 0 {
+		return nil, ErrRedemptionExceedsPrice
+	}
+	return res, nil
+}
+
+// CancellationCreditCents is the travel credit issued when a booking paid
+// with res is cancelled, in the currency of res. It is zero when res is
+// nil: nothing says the booking was paid.
+func (r RedemptionRules) CancellationCreditCents(res *PaymentReservation) int64 {
+	if res == nil {
+		return 0
+	}
+	return res.AmountDueCents() * r.CancellationCreditPercent / 100
+}
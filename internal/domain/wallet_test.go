@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedemptionRules_Reserve(t *testing.T) {
+	rules := RedemptionRules{CentsPerHundredMiles: 150}
+	b := Booking{ID: 1, CustomerID: 2, Status: BookingStatusPending, PriceCents: 10000}
+
+	res, err := rules.Reserve(b, ExchangeRates{}, 4001, 2500)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6001), res.MilesValueCents, "miles are valued rounding down")
+	assert.Equal(t, int64(1499), res.AmountDueCents())
+	assert.Equal(t, ReservationHeld, res.Status)
+
+	_, err = rules.Reserve(b, ExchangeRates{}, 0, 10001)
+	assert.ErrorIs(t, err, ErrRedemptionExceedsPrice)
+
+	_, err = rules.Reserve(b, ExchangeRates{}, -1, -1)
+	var validation *ValidationError
+	require.ErrorAs(t, err, &validation)
+	assert.Len(t, validation.Violations, 2)
+
+	b.Status = BookingStatusConfirmed
+	_, err = rules.Reserve(b, ExchangeRates{}, 0, 100)
+	assert.ErrorIs(t, err, ErrInvalidBookingTransition)
+}
+
+func TestRedemptionRules_CancellationCreditCents(t *testing.T) {
+	rules := RedemptionRules{CentsPerHundredMiles: 100, CancellationCreditPercent: 50}
+
+	assert.Zero(t, rules.CancellationCreditCents(nil), "nothing says a booking without a reservation was paid")
+	assert.Equal(t, int64(2000), rules.CancellationCreditCents(&PaymentReservation{PriceCents: 10000, MilesValueCents: 5000, CreditCents: 1000}))
+}
+
+func TestRedemptionRules_SettlesInSettlementCurrency(t *testing.T) {
+	rules := DefaultRedemptionRules()
+	b := Booking{ID: 1, CustomerID: 2, Status: BookingStatusPending, PriceCents: 10000, Currency: "EUR"}
+	rates := NewExchangeRates([]ExchangeRate{{Base: "EUR", Quote: "RUB", Rate: big.NewRat(9525, 100)}})
+
+	res, err := rules.Reserve(b, rates, 0, 500000)
+	require.NoError(t, err)
+	assert.Equal(t, "RUB", res.Currency)
+	assert.Equal(t, int64(952500), res.PriceCents)
+
+	_, err = rules.Reserve(b, ExchangeRates{}, 0, 0)
+	assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+}
+
+func TestRedemptionRules_Validate(t *testing.T) {
+	assert.NoError(t, DefaultRedemptionRules().Validate())
+	assert.Error(t, RedemptionRules{}.Validate())
+	assert.Error(t, RedemptionRules{CentsPerHundredMiles: 1, SettlementCurrency: "RUBLES"}.Validate())
+	assert.Error(t, RedemptionRules{CentsPerHundredMiles: 1, CancellationCreditPercent: 101}.Validate())
+}
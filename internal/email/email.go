@@ -3,17 +3,67 @@ package email
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/kafka"
 )
 
-type Sender struct{}
+type Sender struct {
+	links *auth.LinkSigner
+}
+
+type SenderOption func(*Sender)
+
+// WithManageLinks adds a manage link signed by links to the emails about
+// bookings that can still be cancelled.
+func WithManageLinks(links *auth.LinkSigner) SenderOption {
+	return func(s *Sender) {
+		s.links = links
+	}
+}
 
-func NewSender() *Sender {
-	return &Sender{}
+func NewSender(opts ...SenderOption) *Sender {
+	s := &Sender{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Sender) Send(ctx context.Context, event kafka.BookingEvent) error {
-	fmt.Printf("send email to %s about %s for flight %d seat %d\n", event.Email, event.Type, event.FlightID, event.SeatNumber)
+	if event.VerificationCode != "" {
+		fmt.Printf("send email to %s about %s: code %s, valid until %s\n", event.Email, event.Type, event.VerificationCode, event.ExpiresAt.Format(time.RFC3339))
+		return nil
+	}
+	manage := s.manageLink(event)
+	if event.FlightStatus != nil {
+		fmt.Printf("send email to %s about flight %d: %s %v%s\n", event.Email, event.FlightID, event.FlightStatus.Status, event.FlightStatus.Changes, manage)
+		return nil
+	}
+	if event.PreviousFlightID != 0 && event.PreviousFlightID != event.FlightID {
+		fmt.Printf("send email to %s about %s: flight %d seat %d (was flight %d seat %d)%s\n", event.Email, event.Type, event.FlightID, event.SeatNumber, event.PreviousFlightID, event.PreviousSeatNumber, manage)
+		return nil
+	}
+	fmt.Printf("send email to %s about %s for flight %d seat %d%s\n", event.Email, event.Type, event.FlightID, event.SeatNumber, manage)
 	return nil
 }
+
+// manageLink returns the manage link line of the email about event, or an
+// empty string. The email carries the signed link, never the booking token.
+func (s *Sender) manageLink(event kafka.BookingEvent) string {
+	if !s.links.Enabled() || event.BookingID == 0 || event.Token == "" {
+		return ""
+	}
+	if !domain.BookingStatus(event.Status).CanTransitionTo(domain.BookingStatusCancelled) {
+		return ""
+	}
+	link, manage, err := s.links.Sign(event.BookingID, event.Token)
+	if err != nil {
+		log.Printf("WARNING: failed to sign the manage link of booking %d: %v", event.BookingID, err)
+		return ""
+	}
+	return fmt.Sprintf(", manage until %s: %s", manage.ExpiresAt.Format(time.RFC3339), link)
+}
@@ -7,6 +7,19 @@ import (
 	"github.com/segmentio/kafka-go"
 )
 
+// FlightStatusMessage is a status report read from the ops status topic.
+// Empty fields keep the current value; reported_at defaults to the time the
+// message is processed.
+type FlightStatusMessage struct {
+	FlightID           int64     `json:"flight_id"`
+	Status             string    `json:"status"`
+	EstimatedDeparture time.Time `json:"estimated_departure"`
+	Gate               string    `json:"gate"`
+	Terminal           string    `json:"terminal"`
+	DivertedTo         string    `json:"diverted_to"`
+	ReportedAt         time.Time `json:"reported_at"`
+}
+
 type Consumer struct {
 	reader *kafka.Reader
 }
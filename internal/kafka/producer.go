@@ -55,11 +55,57 @@ import (
 type BookingEvent struct {
 	Type       string    `json:"type"`
 	Token      string    `json:"token"`
+	BookingID  int64     `json:"booking_id,omitempty"`
 	FlightID   int64     `json:"flight_id"`
 	SeatNumber int       `json:"seat_number"`
 	Email      string    `json:"email"`
 	Status     string    `json:"status"`
 	ExpiresAt  time.Time `json:"expires_at"`
+	// Set on flight disruption events (flight_cancelled, booking_rebooked,
+	// booking_rebooking_offered), on booking_seat_changed and on
+	// booking_flight_changed.
+	PreviousFlightID     int64   `json:"previous_flight_id,omitempty"`
+	PreviousSeatNumber   int     `json:"previous_seat_number,omitempty"`
+	AlternativeFlightIDs []int64 `json:"alternative_flight_ids,omitempty"`
+	Reason               string  `json:"reason,omitempty"`
+	// Set on flight_status_changed.
+	FlightStatus *FlightStatusInfo `json:"flight_status,omitempty"`
+	// Set on booking_denied_boarding.
+	DeniedBoardingKind string `json:"denied_boarding_kind,omitempty"`
+	CompensationCents  int64  `json:"compensation_cents,omitempty"`
+	// Set on booking_flight_changed.
+	AmountDueCents int64 `json:"amount_due_cents,omitempty"`
+	// Set on customer_email_verification and booking_claim_verification,
+	// which carry no booking.
+	VerificationCode string `json:"verification_code,omitempty"`
+}
+
+// FlightStatusInfo is the operational state of a flight sent to passengers.
+type FlightStatusInfo struct {
+	Status             string     `json:"status"`
+	EstimatedDeparture *time.Time `json:"estimated_departure,omitempty"`
+	Gate               string     `json:"gate,omitempty"`
+	Terminal           string     `json:"terminal,omitempty"`
+	DivertedTo         string     `json:"diverted_to,omitempty"`
+	Changes            []string   `json:"changes"`
+}
+
+// FlightEvent is published to the flight events topic whenever the flight
+// inventory changes. Action is one of "created", "updated", "deleted".
+type FlightEvent struct {
+	Type           string    `json:"type"`
+	Action         string    `json:"action"`
+	FlightID       int64     `json:"flight_id"`
+	FromAirport    string    `json:"from_airport"`
+	ToAirport      string    `json:"to_airport"`
+	DepartureTime  time.Time `json:"departure_time"`
+	ArrivalTime    time.Time `json:"arrival_time"`
+	TotalSeats     int       `json:"total_seats"`
+	AvailableSeats int       `json:"available_seats"`
+	PriceCents     int64     `json:"price_cents"`
+	Currency       string    `json:"currency,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
 }
 
 type Producer struct {
@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0-devel
+// 	protoc        v3.14.0
+// source: api/admin_exchange_rates_api/admin_exchange_rates.proto
+
+package admin_exchange_rates_api
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExchangeRate is the price of one unit of base in units of quote. Rates
+// are used both ways, and through a common currency for pairs without one.
+type ExchangeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ISO 4217 codes.
+	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// Positive decimal with up to 12 decimals, e.g. "95.25".
+	Rate string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// RFC3339; set by the server.
+	UpdatedAt string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExchangeRate) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ExchangeRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rates []*ExchangeRate `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+}
+
+func (x *ExchangeRates) Reset() {
+	*x = ExchangeRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRates) ProtoMessage() {}
+
+func (x *ExchangeRates) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRates.ProtoReflect.Descriptor instead.
+func (*ExchangeRates) Descriptor() ([]byte, []int) {
+	return file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExchangeRates) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ImportExchangeRatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Csv string `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+}
+
+func (x *ImportExchangeRatesRequest) Reset() {
+	*x = ImportExchangeRatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ImportExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportExchangeRatesRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+var File_api_admin_exchange_rates_api_admin_exchange_rates_proto protoreflect.FileDescriptor
+
+var file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDesc = []byte{
+	0x0a, 0x37, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x23, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6b, 0x0a, 0x0c, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x58, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0x2e, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x73, 0x76,
+	0x32, 0x86, 0x04, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x32, 0x2e, 0x61,
+	0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2d, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x69,
+	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x1a,
+	0x32, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x1a, 0x1c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2d, 0x72, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xba, 0x01, 0x0a,
+	0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x42, 0x62, 0x5a, 0x60, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x63, 0x6b,
+	0x31, 0x39, 0x39, 0x31, 0x2f, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x5f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescOnce sync.Once
+	file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescData = file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDesc
+)
+
+func file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescGZIP() []byte {
+	file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescOnce.Do(func() {
+		file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescData)
+	})
+	return file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDescData
+}
+
+var file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_admin_exchange_rates_api_admin_exchange_rates_proto_goTypes = []interface{}{
+	(*ExchangeRate)(nil),               // 0: airbooking.admin_exchange_rates_api.ExchangeRate
+	(*ExchangeRates)(nil),              // 1: airbooking.admin_exchange_rates_api.ExchangeRates
+	(*ImportExchangeRatesRequest)(nil), // 2: airbooking.admin_exchange_rates_api.ImportExchangeRatesRequest
+	(*emptypb.Empty)(nil),              // 3: google.protobuf.Empty
+}
+var file_api_admin_exchange_rates_api_admin_exchange_rates_proto_depIdxs = []int32{
+	0, // 0: airbooking.admin_exchange_rates_api.ExchangeRates.rates:type_name -> airbooking.admin_exchange_rates_api.ExchangeRate
+	3, // 1: airbooking.admin_exchange_rates_api.AdminExchangeRatesService.ListExchangeRates:input_type -> google.protobuf.Empty
+	1, // 2: airbooking.admin_exchange_rates_api.AdminExchangeRatesService.SetExchangeRates:input_type -> airbooking.admin_exchange_rates_api.ExchangeRates
+	2, // 3: airbooking.admin_exchange_rates_api.AdminExchangeRatesService.ImportExchangeRates:input_type -> airbooking.admin_exchange_rates_api.ImportExchangeRatesRequest
+	1, // 4: airbooking.admin_exchange_rates_api.AdminExchangeRatesService.ListExchangeRates:output_type -> airbooking.admin_exchange_rates_api.ExchangeRates
+	1, // 5: airbooking.admin_exchange_rates_api.AdminExchangeRatesService.SetExchangeRates:output_type -> airbooking.admin_exchange_rates_api.ExchangeRates
+	1, // 6: airbooking.admin_exchange_rates_api.AdminExchangeRatesService.ImportExchangeRates:output_type -> airbooking.admin_exchange_rates_api.ExchangeRates
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_admin_exchange_rates_api_admin_exchange_rates_proto_init() }
+func file_api_admin_exchange_rates_api_admin_exchange_rates_proto_init() {
+	if File_api_admin_exchange_rates_api_admin_exchange_rates_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportExchangeRatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_admin_exchange_rates_api_admin_exchange_rates_proto_goTypes,
+		DependencyIndexes: file_api_admin_exchange_rates_api_admin_exchange_rates_proto_depIdxs,
+		MessageInfos:      file_api_admin_exchange_rates_api_admin_exchange_rates_proto_msgTypes,
+	}.Build()
+	File_api_admin_exchange_rates_api_admin_exchange_rates_proto = out.File
+	file_api_admin_exchange_rates_api_admin_exchange_rates_proto_rawDesc = nil
+	file_api_admin_exchange_rates_api_admin_exchange_rates_proto_goTypes = nil
+	file_api_admin_exchange_rates_api_admin_exchange_rates_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AdminExchangeRatesServiceClient is the client API for AdminExchangeRatesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminExchangeRatesServiceClient interface {
+	ListExchangeRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExchangeRates, error)
+	// SetExchangeRates adds or replaces the given rates; other rates are kept.
+	SetExchangeRates(ctx context.Context, in *ExchangeRates, opts ...grpc.CallOption) (*ExchangeRates, error)
+	// ImportExchangeRates sets the rates of a CSV file with base,quote,rate
+	// lines, e.g. "EUR,RUB,95.25". A header line, blank lines and lines
+	// starting with # are skipped.
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRates, error)
+}
+
+type adminExchangeRatesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminExchangeRatesServiceClient(cc grpc.ClientConnInterface) AdminExchangeRatesServiceClient {
+	return &adminExchangeRatesServiceClient{cc}
+}
+
+func (c *adminExchangeRatesServiceClient) ListExchangeRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExchangeRates, error) {
+	out := new(ExchangeRates)
+	err := c.cc.Invoke(ctx, "/airbooking.admin_exchange_rates_api.AdminExchangeRatesService/ListExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminExchangeRatesServiceClient) SetExchangeRates(ctx context.Context, in *ExchangeRates, opts ...grpc.CallOption) (*ExchangeRates, error) {
+	out := new(ExchangeRates)
+	err := c.cc.Invoke(ctx, "/airbooking.admin_exchange_rates_api.AdminExchangeRatesService/SetExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminExchangeRatesServiceClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRates, error) {
+	out := new(ExchangeRates)
+	err := c.cc.Invoke(ctx, "/airbooking.admin_exchange_rates_api.AdminExchangeRatesService/ImportExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminExchangeRatesServiceServer is the server API for AdminExchangeRatesService service.
+type AdminExchangeRatesServiceServer interface {
+	ListExchangeRates(context.Context, *emptypb.Empty) (*ExchangeRates, error)
+	// SetExchangeRates adds or replaces the given rates; other rates are kept.
+	SetExchangeRates(context.Context, *ExchangeRates) (*ExchangeRates, error)
+	// ImportExchangeRates sets the rates of a CSV file with base,quote,rate
+	// lines, e.g. "EUR,RUB,95.25". A header line, blank lines and lines
+	// starting with # are skipped.
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ExchangeRates, error)
+}
+
+// UnimplementedAdminExchangeRatesServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminExchangeRatesServiceServer struct {
+}
+
+func (*UnimplementedAdminExchangeRatesServiceServer) ListExchangeRates(context.Context, *emptypb.Empty) (*ExchangeRates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (*UnimplementedAdminExchangeRatesServiceServer) SetExchangeRates(context.Context, *ExchangeRates) (*ExchangeRates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (*UnimplementedAdminExchangeRatesServiceServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ExchangeRates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+
+func RegisterAdminExchangeRatesServiceServer(s *grpc.Server, srv AdminExchangeRatesServiceServer) {
+	s.RegisterService(&_AdminExchangeRatesService_serviceDesc, srv)
+}
+
+func _AdminExchangeRatesService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminExchangeRatesServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.admin_exchange_rates_api.AdminExchangeRatesService/ListExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminExchangeRatesServiceServer).ListExchangeRates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminExchangeRatesService_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminExchangeRatesServiceServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.admin_exchange_rates_api.AdminExchangeRatesService/SetExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminExchangeRatesServiceServer).SetExchangeRates(ctx, req.(*ExchangeRates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminExchangeRatesService_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminExchangeRatesServiceServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.admin_exchange_rates_api.AdminExchangeRatesService/ImportExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminExchangeRatesServiceServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminExchangeRatesService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "airbooking.admin_exchange_rates_api.AdminExchangeRatesService",
+	HandlerType: (*AdminExchangeRatesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _AdminExchangeRatesService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _AdminExchangeRatesService_SetExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _AdminExchangeRatesService_ImportExchangeRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/admin_exchange_rates_api/admin_exchange_rates.proto",
+}
@@ -0,0 +1,634 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0-devel
+// 	protoc        v3.14.0
+// source: api/admin_flights_api/admin_flights.proto
+
+package admin_flights_api
+
+import (
+	context "context"
+	models "github.com/Domenick1991/airbooking/internal/pb/models"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateFlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromAirport string `protobuf:"bytes,1,opt,name=from_airport,json=fromAirport,proto3" json:"from_airport,omitempty"`
+	ToAirport   string `protobuf:"bytes,2,opt,name=to_airport,json=toAirport,proto3" json:"to_airport,omitempty"`
+	// RFC3339 timestamps.
+	DepartureTime string `protobuf:"bytes,3,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime   string `protobuf:"bytes,4,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	TotalSeats    int32  `protobuf:"varint,5,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	PriceCents    int64  `protobuf:"varint,6,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// Seats that may be sold above total_seats.
+	OverbookingLimit int32 `protobuf:"varint,7,opt,name=overbooking_limit,json=overbookingLimit,proto3" json:"overbooking_limit,omitempty"`
+	// ISO 4217 code of price_cents, which is in its minor units; RUB when
+	// empty.
+	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *CreateFlightRequest) Reset() {
+	*x = CreateFlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_flights_api_admin_flights_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlightRequest) ProtoMessage() {}
+
+func (x *CreateFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_flights_api_admin_flights_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlightRequest.ProtoReflect.Descriptor instead.
+func (*CreateFlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_flights_api_admin_flights_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateFlightRequest) GetFromAirport() string {
+	if x != nil {
+		return x.FromAirport
+	}
+	return ""
+}
+
+func (x *CreateFlightRequest) GetToAirport() string {
+	if x != nil {
+		return x.ToAirport
+	}
+	return ""
+}
+
+func (x *CreateFlightRequest) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *CreateFlightRequest) GetArrivalTime() string {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return ""
+}
+
+func (x *CreateFlightRequest) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *CreateFlightRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *CreateFlightRequest) GetOverbookingLimit() int32 {
+	if x != nil {
+		return x.OverbookingLimit
+	}
+	return 0
+}
+
+func (x *CreateFlightRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type UpdateFlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAirport string `protobuf:"bytes,2,opt,name=from_airport,json=fromAirport,proto3" json:"from_airport,omitempty"`
+	ToAirport   string `protobuf:"bytes,3,opt,name=to_airport,json=toAirport,proto3" json:"to_airport,omitempty"`
+	// RFC3339 timestamps.
+	DepartureTime string `protobuf:"bytes,4,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime   string `protobuf:"bytes,5,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	TotalSeats    int32  `protobuf:"varint,6,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	PriceCents    int64  `protobuf:"varint,7,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// Seats that may be sold above total_seats.
+	OverbookingLimit int32 `protobuf:"varint,8,opt,name=overbooking_limit,json=overbookingLimit,proto3" json:"overbooking_limit,omitempty"`
+	// ISO 4217 code of price_cents, which is in its minor units; RUB when
+	// empty.
+	Currency string `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *UpdateFlightRequest) Reset() {
+	*x = UpdateFlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_flights_api_admin_flights_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFlightRequest) ProtoMessage() {}
+
+func (x *UpdateFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_flights_api_admin_flights_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFlightRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_flights_api_admin_flights_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateFlightRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateFlightRequest) GetFromAirport() string {
+	if x != nil {
+		return x.FromAirport
+	}
+	return ""
+}
+
+func (x *UpdateFlightRequest) GetToAirport() string {
+	if x != nil {
+		return x.ToAirport
+	}
+	return ""
+}
+
+func (x *UpdateFlightRequest) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *UpdateFlightRequest) GetArrivalTime() string {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return ""
+}
+
+func (x *UpdateFlightRequest) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *UpdateFlightRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *UpdateFlightRequest) GetOverbookingLimit() int32 {
+	if x != nil {
+		return x.OverbookingLimit
+	}
+	return 0
+}
+
+func (x *UpdateFlightRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type DeleteFlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteFlightRequest) Reset() {
+	*x = DeleteFlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_flights_api_admin_flights_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFlightRequest) ProtoMessage() {}
+
+func (x *DeleteFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_flights_api_admin_flights_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFlightRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_flights_api_admin_flights_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteFlightRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_api_admin_flights_api_admin_flights_proto protoreflect.FileDescriptor
+
+var file_api_admin_flights_api_admin_flights_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x61, 0x69, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x02, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x69, 0x72,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xbc, 0x02, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x32, 0x9a,
+	0x03, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x69, 0x72, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x83, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x69, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x1a, 0x1a, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x2e, 0x61,
+	0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a,
+	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x54, 0x5a, 0x52, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x6f, 0x6d, 0x65, 0x6e, 0x69,
+	0x63, 0x6b, 0x31, 0x39, 0x39, 0x31, 0x2f, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x3b,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_admin_flights_api_admin_flights_proto_rawDescOnce sync.Once
+	file_api_admin_flights_api_admin_flights_proto_rawDescData = file_api_admin_flights_api_admin_flights_proto_rawDesc
+)
+
+func file_api_admin_flights_api_admin_flights_proto_rawDescGZIP() []byte {
+	file_api_admin_flights_api_admin_flights_proto_rawDescOnce.Do(func() {
+		file_api_admin_flights_api_admin_flights_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_admin_flights_api_admin_flights_proto_rawDescData)
+	})
+	return file_api_admin_flights_api_admin_flights_proto_rawDescData
+}
+
+var file_api_admin_flights_api_admin_flights_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_admin_flights_api_admin_flights_proto_goTypes = []interface{}{
+	(*CreateFlightRequest)(nil), // 0: airbooking.admin_flights_api.CreateFlightRequest
+	(*UpdateFlightRequest)(nil), // 1: airbooking.admin_flights_api.UpdateFlightRequest
+	(*DeleteFlightRequest)(nil), // 2: airbooking.admin_flights_api.DeleteFlightRequest
+	(*models.Flight)(nil),       // 3: airbooking.models.Flight
+	(*emptypb.Empty)(nil),       // 4: google.protobuf.Empty
+}
+var file_api_admin_flights_api_admin_flights_proto_depIdxs = []int32{
+	0, // 0: airbooking.admin_flights_api.AdminFlightsService.CreateFlight:input_type -> airbooking.admin_flights_api.CreateFlightRequest
+	1, // 1: airbooking.admin_flights_api.AdminFlightsService.UpdateFlight:input_type -> airbooking.admin_flights_api.UpdateFlightRequest
+	2, // 2: airbooking.admin_flights_api.AdminFlightsService.DeleteFlight:input_type -> airbooking.admin_flights_api.DeleteFlightRequest
+	3, // 3: airbooking.admin_flights_api.AdminFlightsService.CreateFlight:output_type -> airbooking.models.Flight
+	3, // 4: airbooking.admin_flights_api.AdminFlightsService.UpdateFlight:output_type -> airbooking.models.Flight
+	4, // 5: airbooking.admin_flights_api.AdminFlightsService.DeleteFlight:output_type -> google.protobuf.Empty
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_admin_flights_api_admin_flights_proto_init() }
+func file_api_admin_flights_api_admin_flights_proto_init() {
+	if File_api_admin_flights_api_admin_flights_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_admin_flights_api_admin_flights_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFlightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_flights_api_admin_flights_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFlightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_flights_api_admin_flights_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFlightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_admin_flights_api_admin_flights_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_admin_flights_api_admin_flights_proto_goTypes,
+		DependencyIndexes: file_api_admin_flights_api_admin_flights_proto_depIdxs,
+		MessageInfos:      file_api_admin_flights_api_admin_flights_proto_msgTypes,
+	}.Build()
+	File_api_admin_flights_api_admin_flights_proto = out.File
+	file_api_admin_flights_api_admin_flights_proto_rawDesc = nil
+	file_api_admin_flights_api_admin_flights_proto_goTypes = nil
+	file_api_admin_flights_api_admin_flights_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AdminFlightsServiceClient is the client API for AdminFlightsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminFlightsServiceClient interface {
+	CreateFlight(ctx context.Context, in *CreateFlightRequest, opts ...grpc.CallOption) (*models.Flight, error)
+	UpdateFlight(ctx context.Context, in *UpdateFlightRequest, opts ...grpc.CallOption) (*models.Flight, error)
+	DeleteFlight(ctx context.Context, in *DeleteFlightRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type adminFlightsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminFlightsServiceClient(cc grpc.ClientConnInterface) AdminFlightsServiceClient {
+	return &adminFlightsServiceClient{cc}
+}
+
+func (c *adminFlightsServiceClient) CreateFlight(ctx context.Context, in *CreateFlightRequest, opts ...grpc.CallOption) (*models.Flight, error) {
+	out := new(models.Flight)
+	err := c.cc.Invoke(ctx, "/airbooking.admin_flights_api.AdminFlightsService/CreateFlight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminFlightsServiceClient) UpdateFlight(ctx context.Context, in *UpdateFlightRequest, opts ...grpc.CallOption) (*models.Flight, error) {
+	out := new(models.Flight)
+	err := c.cc.Invoke(ctx, "/airbooking.admin_flights_api.AdminFlightsService/UpdateFlight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminFlightsServiceClient) DeleteFlight(ctx context.Context, in *DeleteFlightRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/airbooking.admin_flights_api.AdminFlightsService/DeleteFlight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminFlightsServiceServer is the server API for AdminFlightsService service.
+type AdminFlightsServiceServer interface {
+	CreateFlight(context.Context, *CreateFlightRequest) (*models.Flight, error)
+	UpdateFlight(context.Context, *UpdateFlightRequest) (*models.Flight, error)
+	DeleteFlight(context.Context, *DeleteFlightRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedAdminFlightsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminFlightsServiceServer struct {
+}
+
+func (*UnimplementedAdminFlightsServiceServer) CreateFlight(context.Context, *CreateFlightRequest) (*models.Flight, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlight not implemented")
+}
+func (*UnimplementedAdminFlightsServiceServer) UpdateFlight(context.Context, *UpdateFlightRequest) (*models.Flight, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlight not implemented")
+}
+func (*UnimplementedAdminFlightsServiceServer) DeleteFlight(context.Context, *DeleteFlightRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFlight not implemented")
+}
+
+func RegisterAdminFlightsServiceServer(s *grpc.Server, srv AdminFlightsServiceServer) {
+	s.RegisterService(&_AdminFlightsService_serviceDesc, srv)
+}
+
+func _AdminFlightsService_CreateFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminFlightsServiceServer).CreateFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.admin_flights_api.AdminFlightsService/CreateFlight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminFlightsServiceServer).CreateFlight(ctx, req.(*CreateFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminFlightsService_UpdateFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminFlightsServiceServer).UpdateFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.admin_flights_api.AdminFlightsService/UpdateFlight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminFlightsServiceServer).UpdateFlight(ctx, req.(*UpdateFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminFlightsService_DeleteFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminFlightsServiceServer).DeleteFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.admin_flights_api.AdminFlightsService/DeleteFlight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminFlightsServiceServer).DeleteFlight(ctx, req.(*DeleteFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminFlightsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "airbooking.admin_flights_api.AdminFlightsService",
+	HandlerType: (*AdminFlightsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFlight",
+			Handler:    _AdminFlightsService_CreateFlight_Handler,
+		},
+		{
+			MethodName: "UpdateFlight",
+			Handler:    _AdminFlightsService_UpdateFlight_Handler,
+		},
+		{
+			MethodName: "DeleteFlight",
+			Handler:    _AdminFlightsService_DeleteFlight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/admin_flights_api/admin_flights.proto",
+}
@@ -15,6 +15,7 @@ import (
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -34,6 +35,12 @@ type CreateBookingRequest struct {
 	FlightId   int64  `protobuf:"varint,1,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
 	SeatNumber int32  `protobuf:"varint,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
 	Email      string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// WEB (default), MOBILE, AGENT or API.
+	Channel string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	// ECONOMY (default), PREMIUM_ECONOMY, BUSINESS or FIRST.
+	FareClass string `protobuf:"bytes,5,opt,name=fare_class,json=fareClass,proto3" json:"fare_class,omitempty"`
+	// Optional promo code; its discount is taken off the fare.
+	PromoCode string `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
 }
 
 func (x *CreateBookingRequest) Reset() {
@@ -89,31 +96,720 @@ func (x *CreateBookingRequest) GetEmail() string {
 	return ""
 }
 
+func (x *CreateBookingRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetFareClass() string {
+	if x != nil {
+		return x.FareClass
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
 type BookingTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *BookingTokenRequest) Reset() {
+	*x = BookingTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookingTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingTokenRequest) ProtoMessage() {}
+
+func (x *BookingTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingTokenRequest.ProtoReflect.Descriptor instead.
+func (*BookingTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ReservePaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Miles       int64  `protobuf:"varint,2,opt,name=miles,proto3" json:"miles,omitempty"`
+	CreditCents int64  `protobuf:"varint,3,opt,name=credit_cents,json=creditCents,proto3" json:"credit_cents,omitempty"`
+}
+
+func (x *ReservePaymentRequest) Reset() {
+	*x = ReservePaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePaymentRequest) ProtoMessage() {}
+
+func (x *ReservePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePaymentRequest.ProtoReflect.Descriptor instead.
+func (*ReservePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReservePaymentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReservePaymentRequest) GetMiles() int64 {
+	if x != nil {
+		return x.Miles
+	}
+	return 0
+}
+
+func (x *ReservePaymentRequest) GetCreditCents() int64 {
+	if x != nil {
+		return x.CreditCents
+	}
+	return 0
+}
+
+type PaymentReservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miles int64 `protobuf:"varint,1,opt,name=miles,proto3" json:"miles,omitempty"`
+	// What the miles are worth.
+	MilesValueCents int64 `protobuf:"varint,2,opt,name=miles_value_cents,json=milesValueCents,proto3" json:"miles_value_cents,omitempty"`
+	CreditCents     int64 `protobuf:"varint,3,opt,name=credit_cents,json=creditCents,proto3" json:"credit_cents,omitempty"`
+	PriceCents      int64 `protobuf:"varint,4,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// Left to pay otherwise.
+	AmountDueCents int64 `protobuf:"varint,5,opt,name=amount_due_cents,json=amountDueCents,proto3" json:"amount_due_cents,omitempty"`
+	// HELD until the booking is confirmed.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// ISO 4217 code the amounts are settled in; price_cents is the total of
+	// the booking converted to it.
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *PaymentReservation) Reset() {
+	*x = PaymentReservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentReservation) ProtoMessage() {}
+
+func (x *PaymentReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentReservation.ProtoReflect.Descriptor instead.
+func (*PaymentReservation) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PaymentReservation) GetMiles() int64 {
+	if x != nil {
+		return x.Miles
+	}
+	return 0
+}
+
+func (x *PaymentReservation) GetMilesValueCents() int64 {
+	if x != nil {
+		return x.MilesValueCents
+	}
+	return 0
+}
+
+func (x *PaymentReservation) GetCreditCents() int64 {
+	if x != nil {
+		return x.CreditCents
+	}
+	return 0
+}
+
+func (x *PaymentReservation) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *PaymentReservation) GetAmountDueCents() int64 {
+	if x != nil {
+		return x.AmountDueCents
+	}
+	return 0
+}
+
+func (x *PaymentReservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentReservation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ChangeSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SeatNumber int32  `protobuf:"varint,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+}
+
+func (x *ChangeSeatRequest) Reset() {
+	*x = ChangeSeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatRequest) ProtoMessage() {}
+
+func (x *ChangeSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSeatRequest) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChangeSeatRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ChangeSeatRequest) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+type ChangeFlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	FlightId int64  `protobuf:"varint,2,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	// Seat on the new flight; 0 takes the lowest free seat.
+	SeatNumber int32 `protobuf:"varint,3,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+}
+
+func (x *ChangeFlightRequest) Reset() {
+	*x = ChangeFlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFlightRequest) ProtoMessage() {}
+
+func (x *ChangeFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFlightRequest.ProtoReflect.Descriptor instead.
+func (*ChangeFlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChangeFlightRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ChangeFlightRequest) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *ChangeFlightRequest) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+type ChangeFlightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Booking           *models.Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	PreviousFareCents int64           `protobuf:"varint,2,opt,name=previous_fare_cents,json=previousFareCents,proto3" json:"previous_fare_cents,omitempty"`
+	NewFareCents      int64           `protobuf:"varint,3,opt,name=new_fare_cents,json=newFareCents,proto3" json:"new_fare_cents,omitempty"`
+	// Negative when the new fare is lower; the difference is not refunded.
+	FareDifferenceCents int64 `protobuf:"varint,4,opt,name=fare_difference_cents,json=fareDifferenceCents,proto3" json:"fare_difference_cents,omitempty"`
+	ChangeFeeCents      int64 `protobuf:"varint,5,opt,name=change_fee_cents,json=changeFeeCents,proto3" json:"change_fee_cents,omitempty"`
+	AmountDueCents      int64 `protobuf:"varint,6,opt,name=amount_due_cents,json=amountDueCents,proto3" json:"amount_due_cents,omitempty"`
+}
+
+func (x *ChangeFlightResponse) Reset() {
+	*x = ChangeFlightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeFlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFlightResponse) ProtoMessage() {}
+
+func (x *ChangeFlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeFlightResponse.ProtoReflect.Descriptor instead.
+func (*ChangeFlightResponse) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangeFlightResponse) GetBooking() *models.Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *ChangeFlightResponse) GetPreviousFareCents() int64 {
+	if x != nil {
+		return x.PreviousFareCents
+	}
+	return 0
+}
+
+func (x *ChangeFlightResponse) GetNewFareCents() int64 {
+	if x != nil {
+		return x.NewFareCents
+	}
+	return 0
+}
+
+func (x *ChangeFlightResponse) GetFareDifferenceCents() int64 {
+	if x != nil {
+		return x.FareDifferenceCents
+	}
+	return 0
+}
+
+func (x *ChangeFlightResponse) GetChangeFeeCents() int64 {
+	if x != nil {
+		return x.ChangeFeeCents
+	}
+	return 0
+}
+
+func (x *ChangeFlightResponse) GetAmountDueCents() int64 {
+	if x != nil {
+		return x.AmountDueCents
+	}
+	return 0
+}
+
+type GetBookingHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transitions []*models.BookingTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *GetBookingHistoryResponse) Reset() {
+	*x = GetBookingHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryResponse) ProtoMessage() {}
+
+func (x *GetBookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBookingHistoryResponse) GetTransitions() []*models.BookingTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type JoinWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightId  int64  `protobuf:"varint,1,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	Email     string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FareClass string `protobuf:"bytes,3,opt,name=fare_class,json=fareClass,proto3" json:"fare_class,omitempty"`
+	// Ignored: the tier of the loyalty account of the logged-in customer
+	// decides the priority.
+	//
+	// Deprecated: Do not use.
+	LoyaltyTier string `protobuf:"bytes,4,opt,name=loyalty_tier,json=loyaltyTier,proto3" json:"loyalty_tier,omitempty"`
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JoinWaitlistRequest) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetFareClass() string {
+	if x != nil {
+		return x.FareClass
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *JoinWaitlistRequest) GetLoyaltyTier() string {
+	if x != nil {
+		return x.LoyaltyTier
+	}
+	return ""
+}
+
+type ListMyBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 20 by default, at most 100.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Booking statuses to return, e.g. CONFIRMED; empty returns every status.
+	Statuses []string `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *ListMyBookingsRequest) Reset() {
+	*x = ListMyBookingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsRequest) ProtoMessage() {}
+
+func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMyBookingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyBookingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMyBookingsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListMyBookingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bookings []*models.Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMyBookingsResponse) Reset() {
+	*x = ListMyBookingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsResponse) ProtoMessage() {}
+
+func (x *ListMyBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMyBookingsResponse) GetBookings() []*models.Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *ListMyBookingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// OpenManageLinkRequest carries the query parameters of a manage link.
+type OpenManageLinkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// booking parameter.
+	BookingId int64 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// expires parameter, Unix seconds.
+	Expires int64 `protobuf:"varint,2,opt,name=expires,proto3" json:"expires,omitempty"`
+	// sig parameter.
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *BookingTokenRequest) Reset() {
-	*x = BookingTokenRequest{}
+func (x *OpenManageLinkRequest) Reset() {
+	*x = OpenManageLinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bookings_api_bookings_proto_msgTypes[1]
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BookingTokenRequest) String() string {
+func (x *OpenManageLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookingTokenRequest) ProtoMessage() {}
+func (*OpenManageLinkRequest) ProtoMessage() {}
 
-func (x *BookingTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bookings_api_bookings_proto_msgTypes[1]
+func (x *OpenManageLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -124,18 +820,106 @@ func (x *BookingTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookingTokenRequest.ProtoReflect.Descriptor instead.
-func (*BookingTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use OpenManageLinkRequest.ProtoReflect.Descriptor instead.
+func (*OpenManageLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BookingTokenRequest) GetToken() string {
+func (x *OpenManageLinkRequest) GetBookingId() int64 {
 	if x != nil {
-		return x.Token
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *OpenManageLinkRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *OpenManageLinkRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ManageSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Always "Bearer".
+	TokenType string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// RFC3339.
+	ExpiresAt string `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Without its token.
+	Booking *models.Booking `protobuf:"bytes,4,opt,name=booking,proto3" json:"booking,omitempty"`
+}
+
+func (x *ManageSession) Reset() {
+	*x = ManageSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bookings_api_bookings_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManageSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageSession) ProtoMessage() {}
+
+func (x *ManageSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bookings_api_bookings_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageSession.ProtoReflect.Descriptor instead.
+func (*ManageSession) Descriptor() ([]byte, []int) {
+	return file_api_bookings_api_bookings_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ManageSession) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ManageSession) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *ManageSession) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return ""
 }
 
+func (x *ManageSession) GetBooking() *models.Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
 var File_api_bookings_api_bookings_proto protoreflect.FileDescriptor
 
 var file_api_bookings_api_bookings_proto_rawDesc = []byte{
@@ -144,48 +928,259 @@ var file_api_bookings_api_bookings_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x17, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f,
 	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6a,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x2b, 0x0a, 0x13, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x88, 0x03, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x2e, 0x61,
-	0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x72, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x2b, 0x0a, 0x13, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x43, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
+	0x69, 0x6c, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x4a, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x69, 0x0a, 0x13,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61,
+	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xaa, 0x02, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46, 0x61, 0x72,
+	0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x61,
+	0x72, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x6e, 0x65, 0x77, 0x46, 0x61, 0x72, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x66, 0x61, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x66, 0x61, 0x72,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x46, 0x65, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x43,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x4a, 0x6f,
+	0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x72, 0x65, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x74,
+	0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x6c,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x32, 0x84,
+	0x10, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x77, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x2d, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x1b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e,
+	0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69,
 	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22,
-	0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x42,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x1a,
+	0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x0d,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e,
+	0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69,
+	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a,
+	0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x9a,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x2e, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x1a, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d,
+	0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x0a, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x12, 0x2a, 0x2e, 0x61, 0x69, 0x72, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x1a, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x7d, 0x2f, 0x73, 0x65, 0x61, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x9e, 0x01, 0x0a, 0x0c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x2e, 0x61,
+	0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x69, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2b, 0x22, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a,
+	0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x2c, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2d, 0x69, 0x6e, 0x3a, 0x01, 0x2a,
+	0x12, 0x9f, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
 	0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69,
 	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x7d, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x44, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x63, 0x6b, 0x31, 0x39, 0x39, 0x31, 0x2f, 0x61, 0x69,
-	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70,
-	0x69, 0x3b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x24, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x98, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x8c, 0x01, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x2e, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x67,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x61, 0x69,
+	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
+	0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x76, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x3a, 0x01, 0x2a, 0x12,
+	0x74, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x3a, 0x01, 0x2a, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x63, 0x6b, 0x31, 0x39, 0x39, 0x31,
+	0x2f, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73,
+	0x5f, 0x61, 0x70, 0x69, 0x3b, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -200,24 +1195,66 @@ func file_api_bookings_api_bookings_proto_rawDescGZIP() []byte {
 	return file_api_bookings_api_bookings_proto_rawDescData
 }
 
-var file_api_bookings_api_bookings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_bookings_api_bookings_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_bookings_api_bookings_proto_goTypes = []interface{}{
-	(*CreateBookingRequest)(nil), // 0: airbooking.bookings_api.CreateBookingRequest
-	(*BookingTokenRequest)(nil),  // 1: airbooking.bookings_api.BookingTokenRequest
-	(*models.Booking)(nil),       // 2: airbooking.models.Booking
+	(*CreateBookingRequest)(nil),      // 0: airbooking.bookings_api.CreateBookingRequest
+	(*BookingTokenRequest)(nil),       // 1: airbooking.bookings_api.BookingTokenRequest
+	(*ReservePaymentRequest)(nil),     // 2: airbooking.bookings_api.ReservePaymentRequest
+	(*PaymentReservation)(nil),        // 3: airbooking.bookings_api.PaymentReservation
+	(*ChangeSeatRequest)(nil),         // 4: airbooking.bookings_api.ChangeSeatRequest
+	(*ChangeFlightRequest)(nil),       // 5: airbooking.bookings_api.ChangeFlightRequest
+	(*ChangeFlightResponse)(nil),      // 6: airbooking.bookings_api.ChangeFlightResponse
+	(*GetBookingHistoryResponse)(nil), // 7: airbooking.bookings_api.GetBookingHistoryResponse
+	(*JoinWaitlistRequest)(nil),       // 8: airbooking.bookings_api.JoinWaitlistRequest
+	(*ListMyBookingsRequest)(nil),     // 9: airbooking.bookings_api.ListMyBookingsRequest
+	(*ListMyBookingsResponse)(nil),    // 10: airbooking.bookings_api.ListMyBookingsResponse
+	(*OpenManageLinkRequest)(nil),     // 11: airbooking.bookings_api.OpenManageLinkRequest
+	(*ManageSession)(nil),             // 12: airbooking.bookings_api.ManageSession
+	(*models.Booking)(nil),            // 13: airbooking.models.Booking
+	(*models.BookingTransition)(nil),  // 14: airbooking.models.BookingTransition
+	(*emptypb.Empty)(nil),             // 15: google.protobuf.Empty
+	(*models.WaitlistEntry)(nil),      // 16: airbooking.models.WaitlistEntry
 }
 var file_api_bookings_api_bookings_proto_depIdxs = []int32{
-	0, // 0: airbooking.bookings_api.BookingsService.CreateBooking:input_type -> airbooking.bookings_api.CreateBookingRequest
-	1, // 1: airbooking.bookings_api.BookingsService.ConfirmBooking:input_type -> airbooking.bookings_api.BookingTokenRequest
-	1, // 2: airbooking.bookings_api.BookingsService.CancelBooking:input_type -> airbooking.bookings_api.BookingTokenRequest
-	2, // 3: airbooking.bookings_api.BookingsService.CreateBooking:output_type -> airbooking.models.Booking
-	2, // 4: airbooking.bookings_api.BookingsService.ConfirmBooking:output_type -> airbooking.models.Booking
-	2, // 5: airbooking.bookings_api.BookingsService.CancelBooking:output_type -> airbooking.models.Booking
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	13, // 0: airbooking.bookings_api.ChangeFlightResponse.booking:type_name -> airbooking.models.Booking
+	14, // 1: airbooking.bookings_api.GetBookingHistoryResponse.transitions:type_name -> airbooking.models.BookingTransition
+	13, // 2: airbooking.bookings_api.ListMyBookingsResponse.bookings:type_name -> airbooking.models.Booking
+	13, // 3: airbooking.bookings_api.ManageSession.booking:type_name -> airbooking.models.Booking
+	0,  // 4: airbooking.bookings_api.BookingsService.CreateBooking:input_type -> airbooking.bookings_api.CreateBookingRequest
+	1,  // 5: airbooking.bookings_api.BookingsService.ConfirmBooking:input_type -> airbooking.bookings_api.BookingTokenRequest
+	1,  // 6: airbooking.bookings_api.BookingsService.CancelBooking:input_type -> airbooking.bookings_api.BookingTokenRequest
+	1,  // 7: airbooking.bookings_api.BookingsService.ExtendHold:input_type -> airbooking.bookings_api.BookingTokenRequest
+	2,  // 8: airbooking.bookings_api.BookingsService.ReservePayment:input_type -> airbooking.bookings_api.ReservePaymentRequest
+	4,  // 9: airbooking.bookings_api.BookingsService.ChangeSeat:input_type -> airbooking.bookings_api.ChangeSeatRequest
+	5,  // 10: airbooking.bookings_api.BookingsService.ChangeFlight:input_type -> airbooking.bookings_api.ChangeFlightRequest
+	1,  // 11: airbooking.bookings_api.BookingsService.CheckIn:input_type -> airbooking.bookings_api.BookingTokenRequest
+	1,  // 12: airbooking.bookings_api.BookingsService.GetBookingHistory:input_type -> airbooking.bookings_api.BookingTokenRequest
+	8,  // 13: airbooking.bookings_api.BookingsService.JoinWaitlist:input_type -> airbooking.bookings_api.JoinWaitlistRequest
+	9,  // 14: airbooking.bookings_api.BookingsService.ListMyBookings:input_type -> airbooking.bookings_api.ListMyBookingsRequest
+	11, // 15: airbooking.bookings_api.BookingsService.OpenManageLink:input_type -> airbooking.bookings_api.OpenManageLinkRequest
+	15, // 16: airbooking.bookings_api.BookingsService.GetManagedBooking:input_type -> google.protobuf.Empty
+	15, // 17: airbooking.bookings_api.BookingsService.ConfirmManagedBooking:input_type -> google.protobuf.Empty
+	15, // 18: airbooking.bookings_api.BookingsService.CancelManagedBooking:input_type -> google.protobuf.Empty
+	13, // 19: airbooking.bookings_api.BookingsService.CreateBooking:output_type -> airbooking.models.Booking
+	13, // 20: airbooking.bookings_api.BookingsService.ConfirmBooking:output_type -> airbooking.models.Booking
+	13, // 21: airbooking.bookings_api.BookingsService.CancelBooking:output_type -> airbooking.models.Booking
+	13, // 22: airbooking.bookings_api.BookingsService.ExtendHold:output_type -> airbooking.models.Booking
+	3,  // 23: airbooking.bookings_api.BookingsService.ReservePayment:output_type -> airbooking.bookings_api.PaymentReservation
+	13, // 24: airbooking.bookings_api.BookingsService.ChangeSeat:output_type -> airbooking.models.Booking
+	6,  // 25: airbooking.bookings_api.BookingsService.ChangeFlight:output_type -> airbooking.bookings_api.ChangeFlightResponse
+	13, // 26: airbooking.bookings_api.BookingsService.CheckIn:output_type -> airbooking.models.Booking
+	7,  // 27: airbooking.bookings_api.BookingsService.GetBookingHistory:output_type -> airbooking.bookings_api.GetBookingHistoryResponse
+	16, // 28: airbooking.bookings_api.BookingsService.JoinWaitlist:output_type -> airbooking.models.WaitlistEntry
+	10, // 29: airbooking.bookings_api.BookingsService.ListMyBookings:output_type -> airbooking.bookings_api.ListMyBookingsResponse
+	12, // 30: airbooking.bookings_api.BookingsService.OpenManageLink:output_type -> airbooking.bookings_api.ManageSession
+	13, // 31: airbooking.bookings_api.BookingsService.GetManagedBooking:output_type -> airbooking.models.Booking
+	13, // 32: airbooking.bookings_api.BookingsService.ConfirmManagedBooking:output_type -> airbooking.models.Booking
+	13, // 33: airbooking.bookings_api.BookingsService.CancelManagedBooking:output_type -> airbooking.models.Booking
+	19, // [19:34] is the sub-list for method output_type
+	4,  // [4:19] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_api_bookings_api_bookings_proto_init() }
@@ -250,6 +1287,138 @@ func file_api_bookings_api_bookings_proto_init() {
 				return nil
 			}
 		}
+		file_api_bookings_api_bookings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservePaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentReservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeFlightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeFlightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookingHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinWaitlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyBookingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyBookingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenManageLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bookings_api_bookings_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManageSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -257,7 +1426,7 @@ func file_api_bookings_api_bookings_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_bookings_api_bookings_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -271,52 +1440,198 @@ func file_api_bookings_api_bookings_proto_init() {
 	file_api_bookings_api_bookings_proto_depIdxs = nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BookingsServiceClient is the client API for BookingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BookingsServiceClient interface {
+	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*models.Booking, error)
+	ConfirmBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error)
+	CancelBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error)
+	// ExtendHold pushes back the expiry of a pending booking and its seat lock
+	// by the extension of its hold policy, up to the policy's maximum number
+	// of extensions.
+	ExtendHold(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error)
+	// ReservePayment holds miles and travel credit of the customer account of
+	// a pending booking until the booking is confirmed, when they pay that
+	// part of its price. Expiry or cancellation gives them back. Zero miles
+	// and credit drop the reservation.
+	ReservePayment(ctx context.Context, in *ReservePaymentRequest, opts ...grpc.CallOption) (*PaymentReservation, error)
+	// ChangeSeat moves a pending or confirmed booking to another free seat of
+	// the same flight. A pending booking keeps its hold expiry.
+	ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*models.Booking, error)
+	// ChangeFlight moves a pending or confirmed booking to another flight on the
+	// same route. The fare class decides whether the change is allowed, until
+	// when, and the change fee; a higher fare adds the difference.
+	ChangeFlight(ctx context.Context, in *ChangeFlightRequest, opts ...grpc.CallOption) (*ChangeFlightResponse, error)
+	// CheckIn checks in a confirmed booking until boarding ends.
+	CheckIn(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error)
+	// GetBookingHistory returns every status change of a booking, oldest first,
+	// with who made it and why.
+	GetBookingHistory(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error)
+	// JoinWaitlist queues a passenger for a sold-out flight. Released seats are
+	// offered to the queue by fare class and loyalty tier, then by arrival.
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*models.WaitlistEntry, error)
+	// ListMyBookings returns the bookings of the logged-in customer, newest
+	// first: those made while logged in and the claimed guest bookings.
+	ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error)
+	// OpenManageLink exchanges the parameters of a manage link emailed to the
+	// passenger for an access token limited to that booking. Links expire, and
+	// stop working once the booking is cancelled.
+	OpenManageLink(ctx context.Context, in *OpenManageLinkRequest, opts ...grpc.CallOption) (*ManageSession, error)
+	// GetManagedBooking returns the booking of the manage session of the
+	// caller. Its token is not returned.
+	GetManagedBooking(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*models.Booking, error)
+	// ConfirmManagedBooking confirms the booking of the manage session.
+	ConfirmManagedBooking(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*models.Booking, error)
+	// CancelManagedBooking cancels the booking of the manage session.
+	CancelManagedBooking(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*models.Booking, error)
+}
+
+type bookingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingsServiceClient(cc grpc.ClientConnInterface) BookingsServiceClient {
+	return &bookingsServiceClient{cc}
+}
+
+func (c *bookingsServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+	out := new(models.Booking)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/CreateBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsServiceClient) ConfirmBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+	out := new(models.Booking)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ConfirmBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsServiceClient) CancelBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+	out := new(models.Booking)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/CancelBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsServiceClient) ExtendHold(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+	out := new(models.Booking)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ExtendHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsServiceClient) ReservePayment(ctx context.Context, in *ReservePaymentRequest, opts ...grpc.CallOption) (*PaymentReservation, error) {
+	out := new(PaymentReservation)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ReservePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsServiceClient) ChangeSeat(ctx context.Context, in *ChangeSeatRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+	out := new(models.Booking)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ChangeSeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (c *bookingsServiceClient) ChangeFlight(ctx context.Context, in *ChangeFlightRequest, opts ...grpc.CallOption) (*ChangeFlightResponse, error) {
+	out := new(ChangeFlightResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ChangeFlight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// BookingsServiceClient is the client API for BookingsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BookingsServiceClient interface {
-	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*models.Booking, error)
-	ConfirmBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error)
-	CancelBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error)
+func (c *bookingsServiceClient) CheckIn(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+	out := new(models.Booking)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type bookingsServiceClient struct {
-	cc grpc.ClientConnInterface
+func (c *bookingsServiceClient) GetBookingHistory(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error) {
+	out := new(GetBookingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/GetBookingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewBookingsServiceClient(cc grpc.ClientConnInterface) BookingsServiceClient {
-	return &bookingsServiceClient{cc}
+func (c *bookingsServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*models.WaitlistEntry, error) {
+	out := new(models.WaitlistEntry)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/JoinWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *bookingsServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+func (c *bookingsServiceClient) ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error) {
+	out := new(ListMyBookingsResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ListMyBookings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsServiceClient) OpenManageLink(ctx context.Context, in *OpenManageLinkRequest, opts ...grpc.CallOption) (*ManageSession, error) {
+	out := new(ManageSession)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/OpenManageLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingsServiceClient) GetManagedBooking(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*models.Booking, error) {
 	out := new(models.Booking)
-	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/CreateBooking", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/GetManagedBooking", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bookingsServiceClient) ConfirmBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+func (c *bookingsServiceClient) ConfirmManagedBooking(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*models.Booking, error) {
 	out := new(models.Booking)
-	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ConfirmBooking", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/ConfirmManagedBooking", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *bookingsServiceClient) CancelBooking(ctx context.Context, in *BookingTokenRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+func (c *bookingsServiceClient) CancelManagedBooking(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*models.Booking, error) {
 	out := new(models.Booking)
-	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/CancelBooking", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/airbooking.bookings_api.BookingsService/CancelManagedBooking", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -328,6 +1643,44 @@ type BookingsServiceServer interface {
 	CreateBooking(context.Context, *CreateBookingRequest) (*models.Booking, error)
 	ConfirmBooking(context.Context, *BookingTokenRequest) (*models.Booking, error)
 	CancelBooking(context.Context, *BookingTokenRequest) (*models.Booking, error)
+	// ExtendHold pushes back the expiry of a pending booking and its seat lock
+	// by the extension of its hold policy, up to the policy's maximum number
+	// of extensions.
+	ExtendHold(context.Context, *BookingTokenRequest) (*models.Booking, error)
+	// ReservePayment holds miles and travel credit of the customer account of
+	// a pending booking until the booking is confirmed, when they pay that
+	// part of its price. Expiry or cancellation gives them back. Zero miles
+	// and credit drop the reservation.
+	ReservePayment(context.Context, *ReservePaymentRequest) (*PaymentReservation, error)
+	// ChangeSeat moves a pending or confirmed booking to another free seat of
+	// the same flight. A pending booking keeps its hold expiry.
+	ChangeSeat(context.Context, *ChangeSeatRequest) (*models.Booking, error)
+	// ChangeFlight moves a pending or confirmed booking to another flight on the
+	// same route. The fare class decides whether the change is allowed, until
+	// when, and the change fee; a higher fare adds the difference.
+	ChangeFlight(context.Context, *ChangeFlightRequest) (*ChangeFlightResponse, error)
+	// CheckIn checks in a confirmed booking until boarding ends.
+	CheckIn(context.Context, *BookingTokenRequest) (*models.Booking, error)
+	// GetBookingHistory returns every status change of a booking, oldest first,
+	// with who made it and why.
+	GetBookingHistory(context.Context, *BookingTokenRequest) (*GetBookingHistoryResponse, error)
+	// JoinWaitlist queues a passenger for a sold-out flight. Released seats are
+	// offered to the queue by fare class and loyalty tier, then by arrival.
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*models.WaitlistEntry, error)
+	// ListMyBookings returns the bookings of the logged-in customer, newest
+	// first: those made while logged in and the claimed guest bookings.
+	ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error)
+	// OpenManageLink exchanges the parameters of a manage link emailed to the
+	// passenger for an access token limited to that booking. Links expire, and
+	// stop working once the booking is cancelled.
+	OpenManageLink(context.Context, *OpenManageLinkRequest) (*ManageSession, error)
+	// GetManagedBooking returns the booking of the manage session of the
+	// caller. Its token is not returned.
+	GetManagedBooking(context.Context, *emptypb.Empty) (*models.Booking, error)
+	// ConfirmManagedBooking confirms the booking of the manage session.
+	ConfirmManagedBooking(context.Context, *emptypb.Empty) (*models.Booking, error)
+	// CancelManagedBooking cancels the booking of the manage session.
+	CancelManagedBooking(context.Context, *emptypb.Empty) (*models.Booking, error)
 }
 
 // UnimplementedBookingsServiceServer can be embedded to have forward compatible implementations.
@@ -343,6 +1696,42 @@ func (*UnimplementedBookingsServiceServer) ConfirmBooking(context.Context, *Book
 func (*UnimplementedBookingsServiceServer) CancelBooking(context.Context, *BookingTokenRequest) (*models.Booking, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
 }
+func (*UnimplementedBookingsServiceServer) ExtendHold(context.Context, *BookingTokenRequest) (*models.Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendHold not implemented")
+}
+func (*UnimplementedBookingsServiceServer) ReservePayment(context.Context, *ReservePaymentRequest) (*PaymentReservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservePayment not implemented")
+}
+func (*UnimplementedBookingsServiceServer) ChangeSeat(context.Context, *ChangeSeatRequest) (*models.Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSeat not implemented")
+}
+func (*UnimplementedBookingsServiceServer) ChangeFlight(context.Context, *ChangeFlightRequest) (*ChangeFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeFlight not implemented")
+}
+func (*UnimplementedBookingsServiceServer) CheckIn(context.Context, *BookingTokenRequest) (*models.Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (*UnimplementedBookingsServiceServer) GetBookingHistory(context.Context, *BookingTokenRequest) (*GetBookingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
+func (*UnimplementedBookingsServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*models.WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (*UnimplementedBookingsServiceServer) ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBookings not implemented")
+}
+func (*UnimplementedBookingsServiceServer) OpenManageLink(context.Context, *OpenManageLinkRequest) (*ManageSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenManageLink not implemented")
+}
+func (*UnimplementedBookingsServiceServer) GetManagedBooking(context.Context, *emptypb.Empty) (*models.Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagedBooking not implemented")
+}
+func (*UnimplementedBookingsServiceServer) ConfirmManagedBooking(context.Context, *emptypb.Empty) (*models.Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmManagedBooking not implemented")
+}
+func (*UnimplementedBookingsServiceServer) CancelManagedBooking(context.Context, *emptypb.Empty) (*models.Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelManagedBooking not implemented")
+}
 
 func RegisterBookingsServiceServer(s *grpc.Server, srv BookingsServiceServer) {
 	s.RegisterService(&_BookingsService_serviceDesc, srv)
@@ -402,6 +1791,222 @@ func _BookingsService_CancelBooking_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingsService_ExtendHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).ExtendHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/ExtendHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).ExtendHold(ctx, req.(*BookingTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_ReservePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).ReservePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/ReservePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).ReservePayment(ctx, req.(*ReservePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_ChangeSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).ChangeSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/ChangeSeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).ChangeSeat(ctx, req.(*ChangeSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_ChangeFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).ChangeFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/ChangeFlight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).ChangeFlight(ctx, req.(*ChangeFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).CheckIn(ctx, req.(*BookingTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/GetBookingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).GetBookingHistory(ctx, req.(*BookingTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/JoinWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_ListMyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).ListMyBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/ListMyBookings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).ListMyBookings(ctx, req.(*ListMyBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_OpenManageLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenManageLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).OpenManageLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/OpenManageLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).OpenManageLink(ctx, req.(*OpenManageLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_GetManagedBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).GetManagedBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/GetManagedBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).GetManagedBooking(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_ConfirmManagedBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).ConfirmManagedBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/ConfirmManagedBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).ConfirmManagedBooking(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingsService_CancelManagedBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingsServiceServer).CancelManagedBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.bookings_api.BookingsService/CancelManagedBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingsServiceServer).CancelManagedBooking(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BookingsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "airbooking.bookings_api.BookingsService",
 	HandlerType: (*BookingsServiceServer)(nil),
@@ -418,6 +2023,54 @@ var _BookingsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBooking",
 			Handler:    _BookingsService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "ExtendHold",
+			Handler:    _BookingsService_ExtendHold_Handler,
+		},
+		{
+			MethodName: "ReservePayment",
+			Handler:    _BookingsService_ReservePayment_Handler,
+		},
+		{
+			MethodName: "ChangeSeat",
+			Handler:    _BookingsService_ChangeSeat_Handler,
+		},
+		{
+			MethodName: "ChangeFlight",
+			Handler:    _BookingsService_ChangeFlight_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _BookingsService_CheckIn_Handler,
+		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _BookingsService_GetBookingHistory_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _BookingsService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "ListMyBookings",
+			Handler:    _BookingsService_ListMyBookings_Handler,
+		},
+		{
+			MethodName: "OpenManageLink",
+			Handler:    _BookingsService_OpenManageLink_Handler,
+		},
+		{
+			MethodName: "GetManagedBooking",
+			Handler:    _BookingsService_GetManagedBooking_Handler,
+		},
+		{
+			MethodName: "ConfirmManagedBooking",
+			Handler:    _BookingsService_ConfirmManagedBooking_Handler,
+		},
+		{
+			MethodName: "CancelManagedBooking",
+			Handler:    _BookingsService_CancelManagedBooking_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/bookings_api/bookings.proto",
@@ -15,7 +15,6 @@ import (
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -27,18 +26,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListFlightsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ISO 4217 code to show prices in as display_price, e.g. "EUR".
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *ListFlightsRequest) Reset() {
+	*x = ListFlightsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_flights_api_flights_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlightsRequest) ProtoMessage() {}
+
+func (x *ListFlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_flights_api_flights_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlightsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListFlightsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type GetFlightRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ISO 4217 code to show the price in as display_price, e.g. "EUR".
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
 func (x *GetFlightRequest) Reset() {
 	*x = GetFlightRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_flights_api_flights_proto_msgTypes[0]
+		mi := &file_api_flights_api_flights_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -51,7 +100,7 @@ func (x *GetFlightRequest) String() string {
 func (*GetFlightRequest) ProtoMessage() {}
 
 func (x *GetFlightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_flights_api_flights_proto_msgTypes[0]
+	mi := &file_api_flights_api_flights_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -64,7 +113,7 @@ func (x *GetFlightRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFlightRequest.ProtoReflect.Descriptor instead.
 func (*GetFlightRequest) Descriptor() ([]byte, []int) {
-	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{0}
+	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *GetFlightRequest) GetId() int64 {
@@ -74,6 +123,206 @@ func (x *GetFlightRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetFlightRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetFlightStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetFlightStatusRequest) Reset() {
+	*x = GetFlightStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_flights_api_flights_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFlightStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlightStatusRequest) ProtoMessage() {}
+
+func (x *GetFlightStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_flights_api_flights_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlightStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetFlightStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFlightStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WatchFlightAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchFlightAvailabilityRequest) Reset() {
+	*x = WatchFlightAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_flights_api_flights_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchFlightAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFlightAvailabilityRequest) ProtoMessage() {}
+
+func (x *WatchFlightAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_flights_api_flights_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFlightAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*WatchFlightAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchFlightAvailabilityRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FlightAvailability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightId       int64 `protobuf:"varint,1,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	TotalSeats     int32 `protobuf:"varint,2,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	AvailableSeats int32 `protobuf:"varint,3,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	// "snapshot" or the booking event type, e.g. "booking_created".
+	Event string `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	// Seat affected by the event, zero for snapshots.
+	SeatNumber int32 `protobuf:"varint,5,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	// HELD, BOOKED or RELEASED; empty for snapshots.
+	SeatState string `protobuf:"bytes,6,opt,name=seat_state,json=seatState,proto3" json:"seat_state,omitempty"`
+	// RFC3339.
+	At string `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *FlightAvailability) Reset() {
+	*x = FlightAvailability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_flights_api_flights_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightAvailability) ProtoMessage() {}
+
+func (x *FlightAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_flights_api_flights_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightAvailability.ProtoReflect.Descriptor instead.
+func (*FlightAvailability) Descriptor() ([]byte, []int) {
+	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlightAvailability) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *FlightAvailability) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *FlightAvailability) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *FlightAvailability) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *FlightAvailability) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *FlightAvailability) GetSeatState() string {
+	if x != nil {
+		return x.SeatState
+	}
+	return ""
+}
+
+func (x *FlightAvailability) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
 type ListFlightsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -85,7 +334,7 @@ type ListFlightsResponse struct {
 func (x *ListFlightsResponse) Reset() {
 	*x = ListFlightsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_flights_api_flights_proto_msgTypes[1]
+		mi := &file_api_flights_api_flights_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -98,7 +347,7 @@ func (x *ListFlightsResponse) String() string {
 func (*ListFlightsResponse) ProtoMessage() {}
 
 func (x *ListFlightsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_flights_api_flights_proto_msgTypes[1]
+	mi := &file_api_flights_api_flights_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +360,7 @@ func (x *ListFlightsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFlightsResponse.ProtoReflect.Descriptor instead.
 func (*ListFlightsResponse) Descriptor() ([]byte, []int) {
-	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{1}
+	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListFlightsResponse) GetFlights() []*models.Flight {
@@ -132,7 +381,7 @@ type GetFlightResponse struct {
 func (x *GetFlightResponse) Reset() {
 	*x = GetFlightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_flights_api_flights_proto_msgTypes[2]
+		mi := &file_api_flights_api_flights_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -145,7 +394,7 @@ func (x *GetFlightResponse) String() string {
 func (*GetFlightResponse) ProtoMessage() {}
 
 func (x *GetFlightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_flights_api_flights_proto_msgTypes[2]
+	mi := &file_api_flights_api_flights_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +407,7 @@ func (x *GetFlightResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFlightResponse.ProtoReflect.Descriptor instead.
 func (*GetFlightResponse) Descriptor() ([]byte, []int) {
-	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{2}
+	return file_api_flights_api_flights_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetFlightResponse) GetFlight() *models.Flight {
@@ -177,42 +426,85 @@ var file_api_flights_api_flights_proto_rawDesc = []byte{
 	0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x66, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x13, 0x4c,
-	0x69, 0x73, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x07,
-	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06,
-	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
-	0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x32,
-	0xfd, 0x01, 0x0a, 0x0e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x6b, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x61, 0x69, 0x72, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12,
-	0x7e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x2e, 0x61,
-	0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42,
-	0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x6f,
-	0x6d, 0x65, 0x6e, 0x69, 0x63, 0x6b, 0x31, 0x39, 0x39, 0x31, 0x2f, 0x61, 0x69, 0x72, 0x62, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
-	0x62, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x3b, 0x66, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x3e, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x28, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x1e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x12, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x22, 0x4a, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x07, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x32, 0xc8, 0x04, 0x0a, 0x0e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x12, 0x7e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x28, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x69,
+	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xaa,
+	0x01, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x36, 0x2e, 0x61, 0x69, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x29,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x30, 0x01, 0x42, 0x48, 0x5a, 0x46, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x6f, 0x6d, 0x65, 0x6e, 0x69,
+	0x63, 0x6b, 0x31, 0x39, 0x39, 0x31, 0x2f, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x3b, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -227,23 +519,31 @@ func file_api_flights_api_flights_proto_rawDescGZIP() []byte {
 	return file_api_flights_api_flights_proto_rawDescData
 }
 
-var file_api_flights_api_flights_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_flights_api_flights_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_flights_api_flights_proto_goTypes = []interface{}{
-	(*GetFlightRequest)(nil),    // 0: airbooking.flights_api.GetFlightRequest
-	(*ListFlightsResponse)(nil), // 1: airbooking.flights_api.ListFlightsResponse
-	(*GetFlightResponse)(nil),   // 2: airbooking.flights_api.GetFlightResponse
-	(*models.Flight)(nil),       // 3: airbooking.models.Flight
-	(*emptypb.Empty)(nil),       // 4: google.protobuf.Empty
+	(*ListFlightsRequest)(nil),             // 0: airbooking.flights_api.ListFlightsRequest
+	(*GetFlightRequest)(nil),               // 1: airbooking.flights_api.GetFlightRequest
+	(*GetFlightStatusRequest)(nil),         // 2: airbooking.flights_api.GetFlightStatusRequest
+	(*WatchFlightAvailabilityRequest)(nil), // 3: airbooking.flights_api.WatchFlightAvailabilityRequest
+	(*FlightAvailability)(nil),             // 4: airbooking.flights_api.FlightAvailability
+	(*ListFlightsResponse)(nil),            // 5: airbooking.flights_api.ListFlightsResponse
+	(*GetFlightResponse)(nil),              // 6: airbooking.flights_api.GetFlightResponse
+	(*models.Flight)(nil),                  // 7: airbooking.models.Flight
+	(*models.FlightStatus)(nil),            // 8: airbooking.models.FlightStatus
 }
 var file_api_flights_api_flights_proto_depIdxs = []int32{
-	3, // 0: airbooking.flights_api.ListFlightsResponse.flights:type_name -> airbooking.models.Flight
-	3, // 1: airbooking.flights_api.GetFlightResponse.flight:type_name -> airbooking.models.Flight
-	4, // 2: airbooking.flights_api.FlightsService.ListFlights:input_type -> google.protobuf.Empty
-	0, // 3: airbooking.flights_api.FlightsService.GetFlight:input_type -> airbooking.flights_api.GetFlightRequest
-	1, // 4: airbooking.flights_api.FlightsService.ListFlights:output_type -> airbooking.flights_api.ListFlightsResponse
-	2, // 5: airbooking.flights_api.FlightsService.GetFlight:output_type -> airbooking.flights_api.GetFlightResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
+	7, // 0: airbooking.flights_api.ListFlightsResponse.flights:type_name -> airbooking.models.Flight
+	7, // 1: airbooking.flights_api.GetFlightResponse.flight:type_name -> airbooking.models.Flight
+	0, // 2: airbooking.flights_api.FlightsService.ListFlights:input_type -> airbooking.flights_api.ListFlightsRequest
+	1, // 3: airbooking.flights_api.FlightsService.GetFlight:input_type -> airbooking.flights_api.GetFlightRequest
+	2, // 4: airbooking.flights_api.FlightsService.GetFlightStatus:input_type -> airbooking.flights_api.GetFlightStatusRequest
+	3, // 5: airbooking.flights_api.FlightsService.WatchFlightAvailability:input_type -> airbooking.flights_api.WatchFlightAvailabilityRequest
+	5, // 6: airbooking.flights_api.FlightsService.ListFlights:output_type -> airbooking.flights_api.ListFlightsResponse
+	6, // 7: airbooking.flights_api.FlightsService.GetFlight:output_type -> airbooking.flights_api.GetFlightResponse
+	8, // 8: airbooking.flights_api.FlightsService.GetFlightStatus:output_type -> airbooking.models.FlightStatus
+	4, // 9: airbooking.flights_api.FlightsService.WatchFlightAvailability:output_type -> airbooking.flights_api.FlightAvailability
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
 	2, // [2:2] is the sub-list for extension extendee
 	0, // [0:2] is the sub-list for field type_name
@@ -256,7 +556,7 @@ func file_api_flights_api_flights_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_flights_api_flights_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFlightRequest); i {
+			switch v := v.(*ListFlightsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -268,7 +568,7 @@ func file_api_flights_api_flights_proto_init() {
 			}
 		}
 		file_api_flights_api_flights_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFlightsResponse); i {
+			switch v := v.(*GetFlightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -280,6 +580,54 @@ func file_api_flights_api_flights_proto_init() {
 			}
 		}
 		file_api_flights_api_flights_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFlightStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_flights_api_flights_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchFlightAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_flights_api_flights_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlightAvailability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_flights_api_flights_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFlightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_flights_api_flights_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetFlightResponse); i {
 			case 0:
 				return &v.state
@@ -298,7 +646,7 @@ func file_api_flights_api_flights_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_flights_api_flights_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -324,8 +672,14 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type FlightsServiceClient interface {
-	ListFlights(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFlightsResponse, error)
+	ListFlights(ctx context.Context, in *ListFlightsRequest, opts ...grpc.CallOption) (*ListFlightsResponse, error)
 	GetFlight(ctx context.Context, in *GetFlightRequest, opts ...grpc.CallOption) (*GetFlightResponse, error)
+	GetFlightStatus(ctx context.Context, in *GetFlightStatusRequest, opts ...grpc.CallOption) (*models.FlightStatus, error)
+	// WatchFlightAvailability streams seat availability of a flight. The first
+	// message is a snapshot, the following ones are sent as bookings are
+	// created, confirmed, cancelled or expired. The same stream is available as
+	// server-sent events at /api/v1/flights/{id}/availability/events.
+	WatchFlightAvailability(ctx context.Context, in *WatchFlightAvailabilityRequest, opts ...grpc.CallOption) (FlightsService_WatchFlightAvailabilityClient, error)
 }
 
 type flightsServiceClient struct {
@@ -336,7 +690,7 @@ func NewFlightsServiceClient(cc grpc.ClientConnInterface) FlightsServiceClient {
 	return &flightsServiceClient{cc}
 }
 
-func (c *flightsServiceClient) ListFlights(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFlightsResponse, error) {
+func (c *flightsServiceClient) ListFlights(ctx context.Context, in *ListFlightsRequest, opts ...grpc.CallOption) (*ListFlightsResponse, error) {
 	out := new(ListFlightsResponse)
 	err := c.cc.Invoke(ctx, "/airbooking.flights_api.FlightsService/ListFlights", in, out, opts...)
 	if err != nil {
@@ -354,29 +708,82 @@ func (c *flightsServiceClient) GetFlight(ctx context.Context, in *GetFlightReque
 	return out, nil
 }
 
+func (c *flightsServiceClient) GetFlightStatus(ctx context.Context, in *GetFlightStatusRequest, opts ...grpc.CallOption) (*models.FlightStatus, error) {
+	out := new(models.FlightStatus)
+	err := c.cc.Invoke(ctx, "/airbooking.flights_api.FlightsService/GetFlightStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightsServiceClient) WatchFlightAvailability(ctx context.Context, in *WatchFlightAvailabilityRequest, opts ...grpc.CallOption) (FlightsService_WatchFlightAvailabilityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightsService_serviceDesc.Streams[0], "/airbooking.flights_api.FlightsService/WatchFlightAvailability", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flightsServiceWatchFlightAvailabilityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlightsService_WatchFlightAvailabilityClient interface {
+	Recv() (*FlightAvailability, error)
+	grpc.ClientStream
+}
+
+type flightsServiceWatchFlightAvailabilityClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightsServiceWatchFlightAvailabilityClient) Recv() (*FlightAvailability, error) {
+	m := new(FlightAvailability)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // FlightsServiceServer is the server API for FlightsService service.
 type FlightsServiceServer interface {
-	ListFlights(context.Context, *emptypb.Empty) (*ListFlightsResponse, error)
+	ListFlights(context.Context, *ListFlightsRequest) (*ListFlightsResponse, error)
 	GetFlight(context.Context, *GetFlightRequest) (*GetFlightResponse, error)
+	GetFlightStatus(context.Context, *GetFlightStatusRequest) (*models.FlightStatus, error)
+	// WatchFlightAvailability streams seat availability of a flight. The first
+	// message is a snapshot, the following ones are sent as bookings are
+	// created, confirmed, cancelled or expired. The same stream is available as
+	// server-sent events at /api/v1/flights/{id}/availability/events.
+	WatchFlightAvailability(*WatchFlightAvailabilityRequest, FlightsService_WatchFlightAvailabilityServer) error
 }
 
 // UnimplementedFlightsServiceServer can be embedded to have forward compatible implementations.
 type UnimplementedFlightsServiceServer struct {
 }
 
-func (*UnimplementedFlightsServiceServer) ListFlights(context.Context, *emptypb.Empty) (*ListFlightsResponse, error) {
+func (*UnimplementedFlightsServiceServer) ListFlights(context.Context, *ListFlightsRequest) (*ListFlightsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFlights not implemented")
 }
 func (*UnimplementedFlightsServiceServer) GetFlight(context.Context, *GetFlightRequest) (*GetFlightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFlight not implemented")
 }
+func (*UnimplementedFlightsServiceServer) GetFlightStatus(context.Context, *GetFlightStatusRequest) (*models.FlightStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlightStatus not implemented")
+}
+func (*UnimplementedFlightsServiceServer) WatchFlightAvailability(*WatchFlightAvailabilityRequest, FlightsService_WatchFlightAvailabilityServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFlightAvailability not implemented")
+}
 
 func RegisterFlightsServiceServer(s *grpc.Server, srv FlightsServiceServer) {
 	s.RegisterService(&_FlightsService_serviceDesc, srv)
 }
 
 func _FlightsService_ListFlights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListFlightsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -388,7 +795,7 @@ func _FlightsService_ListFlights_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: "/airbooking.flights_api.FlightsService/ListFlights",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FlightsServiceServer).ListFlights(ctx, req.(*emptypb.Empty))
+		return srv.(FlightsServiceServer).ListFlights(ctx, req.(*ListFlightsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -411,6 +818,45 @@ func _FlightsService_GetFlight_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FlightsService_GetFlightStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlightStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightsServiceServer).GetFlightStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.flights_api.FlightsService/GetFlightStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightsServiceServer).GetFlightStatus(ctx, req.(*GetFlightStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightsService_WatchFlightAvailability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFlightAvailabilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlightsServiceServer).WatchFlightAvailability(m, &flightsServiceWatchFlightAvailabilityServer{stream})
+}
+
+type FlightsService_WatchFlightAvailabilityServer interface {
+	Send(*FlightAvailability) error
+	grpc.ServerStream
+}
+
+type flightsServiceWatchFlightAvailabilityServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightsServiceWatchFlightAvailabilityServer) Send(m *FlightAvailability) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _FlightsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "airbooking.flights_api.FlightsService",
 	HandlerType: (*FlightsServiceServer)(nil),
@@ -423,7 +869,17 @@ var _FlightsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFlight",
 			Handler:    _FlightsService_GetFlight_Handler,
 		},
+		{
+			MethodName: "GetFlightStatus",
+			Handler:    _FlightsService_GetFlightStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchFlightAvailability",
+			Handler:       _FlightsService_WatchFlightAvailability_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/flights_api/flights.proto",
 }
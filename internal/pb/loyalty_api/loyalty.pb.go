@@ -0,0 +1,710 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0-devel
+// 	protoc        v3.14.0
+// source: api/loyalty_api/loyalty.proto
+
+package loyalty_api
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LoyaltyAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberId   int64 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	CustomerId int64 `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// SILVER, GOLD, PLATINUM or empty.
+	Tier string `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	// RFC3339; empty without a tier.
+	TierExpiresAt string `protobuf:"bytes,4,opt,name=tier_expires_at,json=tierExpiresAt,proto3" json:"tier_expires_at,omitempty"`
+	Balance       int64  `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Qualifying miles earned within the qualification window.
+	QualifyingMiles int64 `protobuf:"varint,6,opt,name=qualifying_miles,json=qualifyingMiles,proto3" json:"qualifying_miles,omitempty"`
+	// Empty at the top tier.
+	NextTier        string `protobuf:"bytes,7,opt,name=next_tier,json=nextTier,proto3" json:"next_tier,omitempty"`
+	MilesToNextTier int64  `protobuf:"varint,8,opt,name=miles_to_next_tier,json=milesToNextTier,proto3" json:"miles_to_next_tier,omitempty"`
+	MemberSince     string `protobuf:"bytes,9,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+}
+
+func (x *LoyaltyAccount) Reset() {
+	*x = LoyaltyAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_loyalty_api_loyalty_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyAccount) ProtoMessage() {}
+
+func (x *LoyaltyAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_loyalty_api_loyalty_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyAccount.ProtoReflect.Descriptor instead.
+func (*LoyaltyAccount) Descriptor() ([]byte, []int) {
+	return file_api_loyalty_api_loyalty_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoyaltyAccount) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *LoyaltyAccount) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *LoyaltyAccount) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *LoyaltyAccount) GetTierExpiresAt() string {
+	if x != nil {
+		return x.TierExpiresAt
+	}
+	return ""
+}
+
+func (x *LoyaltyAccount) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *LoyaltyAccount) GetQualifyingMiles() int64 {
+	if x != nil {
+		return x.QualifyingMiles
+	}
+	return 0
+}
+
+func (x *LoyaltyAccount) GetNextTier() string {
+	if x != nil {
+		return x.NextTier
+	}
+	return ""
+}
+
+func (x *LoyaltyAccount) GetMilesToNextTier() int64 {
+	if x != nil {
+		return x.MilesToNextTier
+	}
+	return 0
+}
+
+func (x *LoyaltyAccount) GetMemberSince() string {
+	if x != nil {
+		return x.MemberSince
+	}
+	return ""
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ACCRUAL, REDEMPTION or REFUND.
+	Kind            string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Miles           int64  `protobuf:"varint,3,opt,name=miles,proto3" json:"miles,omitempty"`
+	QualifyingMiles int64  `protobuf:"varint,4,opt,name=qualifying_miles,json=qualifyingMiles,proto3" json:"qualifying_miles,omitempty"`
+	BookingId       int64  `protobuf:"varint,5,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Description     string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt       string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_loyalty_api_loyalty_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_loyalty_api_loyalty_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_api_loyalty_api_loyalty_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LedgerEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetMiles() int64 {
+	if x != nil {
+		return x.Miles
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetQualifyingMiles() int64 {
+	if x != nil {
+		return x.QualifyingMiles
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListLoyaltyHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defaults to 20, at most 100.
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListLoyaltyHistoryRequest) Reset() {
+	*x = ListLoyaltyHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_loyalty_api_loyalty_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoyaltyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoyaltyHistoryRequest) ProtoMessage() {}
+
+func (x *ListLoyaltyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_loyalty_api_loyalty_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoyaltyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLoyaltyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_loyalty_api_loyalty_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListLoyaltyHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoyaltyHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLoyaltyHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListLoyaltyHistoryResponse) Reset() {
+	*x = ListLoyaltyHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_loyalty_api_loyalty_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoyaltyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoyaltyHistoryResponse) ProtoMessage() {}
+
+func (x *ListLoyaltyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_loyalty_api_loyalty_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoyaltyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLoyaltyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_loyalty_api_loyalty_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListLoyaltyHistoryResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListLoyaltyHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_api_loyalty_api_loyalty_proto protoreflect.FileDescriptor
+
+var file_api_loyalty_api_loyalty_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70,
+	0x69, 0x2f, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x16, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x69, 0x65, 0x72, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x69, 0x65, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x69, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x69, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
+	0x69, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x4e, 0x65, 0x78, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x79, 0x69, 0x6e,
+	0x67, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x57, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x83, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x91, 0x03, 0x0a, 0x0e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x06, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x61, 0x69, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x2f, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x2f, 0x6d, 0x65, 0x12, 0x9f, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x31,
+	0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6c,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x2f, 0x6d,
+	0x65, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x63, 0x6b,
+	0x31, 0x39, 0x39, 0x31, 0x2f, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x3b, 0x6c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_loyalty_api_loyalty_proto_rawDescOnce sync.Once
+	file_api_loyalty_api_loyalty_proto_rawDescData = file_api_loyalty_api_loyalty_proto_rawDesc
+)
+
+func file_api_loyalty_api_loyalty_proto_rawDescGZIP() []byte {
+	file_api_loyalty_api_loyalty_proto_rawDescOnce.Do(func() {
+		file_api_loyalty_api_loyalty_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_loyalty_api_loyalty_proto_rawDescData)
+	})
+	return file_api_loyalty_api_loyalty_proto_rawDescData
+}
+
+var file_api_loyalty_api_loyalty_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_loyalty_api_loyalty_proto_goTypes = []interface{}{
+	(*LoyaltyAccount)(nil),             // 0: airbooking.loyalty_api.LoyaltyAccount
+	(*LedgerEntry)(nil),                // 1: airbooking.loyalty_api.LedgerEntry
+	(*ListLoyaltyHistoryRequest)(nil),  // 2: airbooking.loyalty_api.ListLoyaltyHistoryRequest
+	(*ListLoyaltyHistoryResponse)(nil), // 3: airbooking.loyalty_api.ListLoyaltyHistoryResponse
+	(*emptypb.Empty)(nil),              // 4: google.protobuf.Empty
+}
+var file_api_loyalty_api_loyalty_proto_depIdxs = []int32{
+	1, // 0: airbooking.loyalty_api.ListLoyaltyHistoryResponse.entries:type_name -> airbooking.loyalty_api.LedgerEntry
+	4, // 1: airbooking.loyalty_api.LoyaltyService.Enroll:input_type -> google.protobuf.Empty
+	4, // 2: airbooking.loyalty_api.LoyaltyService.GetLoyaltyAccount:input_type -> google.protobuf.Empty
+	2, // 3: airbooking.loyalty_api.LoyaltyService.ListLoyaltyHistory:input_type -> airbooking.loyalty_api.ListLoyaltyHistoryRequest
+	0, // 4: airbooking.loyalty_api.LoyaltyService.Enroll:output_type -> airbooking.loyalty_api.LoyaltyAccount
+	0, // 5: airbooking.loyalty_api.LoyaltyService.GetLoyaltyAccount:output_type -> airbooking.loyalty_api.LoyaltyAccount
+	3, // 6: airbooking.loyalty_api.LoyaltyService.ListLoyaltyHistory:output_type -> airbooking.loyalty_api.ListLoyaltyHistoryResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_loyalty_api_loyalty_proto_init() }
+func file_api_loyalty_api_loyalty_proto_init() {
+	if File_api_loyalty_api_loyalty_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_loyalty_api_loyalty_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_loyalty_api_loyalty_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_loyalty_api_loyalty_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoyaltyHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_loyalty_api_loyalty_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoyaltyHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_loyalty_api_loyalty_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_loyalty_api_loyalty_proto_goTypes,
+		DependencyIndexes: file_api_loyalty_api_loyalty_proto_depIdxs,
+		MessageInfos:      file_api_loyalty_api_loyalty_proto_msgTypes,
+	}.Build()
+	File_api_loyalty_api_loyalty_proto = out.File
+	file_api_loyalty_api_loyalty_proto_rawDesc = nil
+	file_api_loyalty_api_loyalty_proto_goTypes = nil
+	file_api_loyalty_api_loyalty_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// LoyaltyServiceClient is the client API for LoyaltyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LoyaltyServiceClient interface {
+	// Enroll opens the loyalty account of the caller. Only bookings flown
+	// after enrolling earn miles.
+	Enroll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoyaltyAccount, error)
+	// GetLoyaltyAccount returns the miles balance and tier of the caller.
+	GetLoyaltyAccount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoyaltyAccount, error)
+	// ListLoyaltyHistory returns the miles ledger of the caller, newest first.
+	ListLoyaltyHistory(ctx context.Context, in *ListLoyaltyHistoryRequest, opts ...grpc.CallOption) (*ListLoyaltyHistoryResponse, error)
+}
+
+type loyaltyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoyaltyServiceClient(cc grpc.ClientConnInterface) LoyaltyServiceClient {
+	return &loyaltyServiceClient{cc}
+}
+
+func (c *loyaltyServiceClient) Enroll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoyaltyAccount, error) {
+	out := new(LoyaltyAccount)
+	err := c.cc.Invoke(ctx, "/airbooking.loyalty_api.LoyaltyService/Enroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loyaltyServiceClient) GetLoyaltyAccount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoyaltyAccount, error) {
+	out := new(LoyaltyAccount)
+	err := c.cc.Invoke(ctx, "/airbooking.loyalty_api.LoyaltyService/GetLoyaltyAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loyaltyServiceClient) ListLoyaltyHistory(ctx context.Context, in *ListLoyaltyHistoryRequest, opts ...grpc.CallOption) (*ListLoyaltyHistoryResponse, error) {
+	out := new(ListLoyaltyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.loyalty_api.LoyaltyService/ListLoyaltyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LoyaltyServiceServer is the server API for LoyaltyService service.
+type LoyaltyServiceServer interface {
+	// Enroll opens the loyalty account of the caller. Only bookings flown
+	// after enrolling earn miles.
+	Enroll(context.Context, *emptypb.Empty) (*LoyaltyAccount, error)
+	// GetLoyaltyAccount returns the miles balance and tier of the caller.
+	GetLoyaltyAccount(context.Context, *emptypb.Empty) (*LoyaltyAccount, error)
+	// ListLoyaltyHistory returns the miles ledger of the caller, newest first.
+	ListLoyaltyHistory(context.Context, *ListLoyaltyHistoryRequest) (*ListLoyaltyHistoryResponse, error)
+}
+
+// UnimplementedLoyaltyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLoyaltyServiceServer struct {
+}
+
+func (*UnimplementedLoyaltyServiceServer) Enroll(context.Context, *emptypb.Empty) (*LoyaltyAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enroll not implemented")
+}
+func (*UnimplementedLoyaltyServiceServer) GetLoyaltyAccount(context.Context, *emptypb.Empty) (*LoyaltyAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoyaltyAccount not implemented")
+}
+func (*UnimplementedLoyaltyServiceServer) ListLoyaltyHistory(context.Context, *ListLoyaltyHistoryRequest) (*ListLoyaltyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoyaltyHistory not implemented")
+}
+
+func RegisterLoyaltyServiceServer(s *grpc.Server, srv LoyaltyServiceServer) {
+	s.RegisterService(&_LoyaltyService_serviceDesc, srv)
+}
+
+func _LoyaltyService_Enroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoyaltyServiceServer).Enroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.loyalty_api.LoyaltyService/Enroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoyaltyServiceServer).Enroll(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoyaltyService_GetLoyaltyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoyaltyServiceServer).GetLoyaltyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.loyalty_api.LoyaltyService/GetLoyaltyAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoyaltyServiceServer).GetLoyaltyAccount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoyaltyService_ListLoyaltyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoyaltyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoyaltyServiceServer).ListLoyaltyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.loyalty_api.LoyaltyService/ListLoyaltyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoyaltyServiceServer).ListLoyaltyHistory(ctx, req.(*ListLoyaltyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LoyaltyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "airbooking.loyalty_api.LoyaltyService",
+	HandlerType: (*LoyaltyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Enroll",
+			Handler:    _LoyaltyService_Enroll_Handler,
+		},
+		{
+			MethodName: "GetLoyaltyAccount",
+			Handler:    _LoyaltyService_GetLoyaltyAccount_Handler,
+		},
+		{
+			MethodName: "ListLoyaltyHistory",
+			Handler:    _LoyaltyService_ListLoyaltyHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/loyalty_api/loyalty.proto",
+}
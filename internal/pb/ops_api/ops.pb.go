@@ -0,0 +1,1596 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0-devel
+// 	protoc        v3.14.0
+// source: api/ops_api/ops.proto
+
+package ops_api
+
+import (
+	context "context"
+	models "github.com/Domenick1991/airbooking/internal/pb/models"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancelFlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Only offer the alternatives to passengers instead of moving their bookings.
+	OfferOnly bool `protobuf:"varint,3,opt,name=offer_only,json=offerOnly,proto3" json:"offer_only,omitempty"`
+}
+
+func (x *CancelFlightRequest) Reset() {
+	*x = CancelFlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelFlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlightRequest) ProtoMessage() {}
+
+func (x *CancelFlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlightRequest.ProtoReflect.Descriptor instead.
+func (*CancelFlightRequest) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CancelFlightRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelFlightRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelFlightRequest) GetOfferOnly() bool {
+	if x != nil {
+		return x.OfferOnly
+	}
+	return false
+}
+
+type Rebooking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// REBOOKED, OFFERED or NO_ALTERNATIVE.
+	Outcome            string `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	PreviousFlightId   int64  `protobuf:"varint,3,opt,name=previous_flight_id,json=previousFlightId,proto3" json:"previous_flight_id,omitempty"`
+	PreviousSeatNumber int32  `protobuf:"varint,4,opt,name=previous_seat_number,json=previousSeatNumber,proto3" json:"previous_seat_number,omitempty"`
+	// Current flight and seat of the booking.
+	FlightId   int64 `protobuf:"varint,5,opt,name=flight_id,json=flightId,proto3" json:"flight_id,omitempty"`
+	SeatNumber int32 `protobuf:"varint,6,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	// Offered flights, set when outcome is OFFERED.
+	Alternatives []*models.Flight `protobuf:"bytes,7,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+}
+
+func (x *Rebooking) Reset() {
+	*x = Rebooking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rebooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rebooking) ProtoMessage() {}
+
+func (x *Rebooking) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rebooking.ProtoReflect.Descriptor instead.
+func (*Rebooking) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rebooking) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Rebooking) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *Rebooking) GetPreviousFlightId() int64 {
+	if x != nil {
+		return x.PreviousFlightId
+	}
+	return 0
+}
+
+func (x *Rebooking) GetPreviousSeatNumber() int32 {
+	if x != nil {
+		return x.PreviousSeatNumber
+	}
+	return 0
+}
+
+func (x *Rebooking) GetFlightId() int64 {
+	if x != nil {
+		return x.FlightId
+	}
+	return 0
+}
+
+func (x *Rebooking) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *Rebooking) GetAlternatives() []*models.Flight {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+type CancelFlightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flight     *models.Flight `protobuf:"bytes,1,opt,name=flight,proto3" json:"flight,omitempty"`
+	Rebookings []*Rebooking   `protobuf:"bytes,2,rep,name=rebookings,proto3" json:"rebookings,omitempty"`
+	// Tokens of the unconfirmed bookings cancelled together with the flight.
+	CancelledPendingTokens []string `protobuf:"bytes,3,rep,name=cancelled_pending_tokens,json=cancelledPendingTokens,proto3" json:"cancelled_pending_tokens,omitempty"`
+}
+
+func (x *CancelFlightResponse) Reset() {
+	*x = CancelFlightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelFlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlightResponse) ProtoMessage() {}
+
+func (x *CancelFlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlightResponse.ProtoReflect.Descriptor instead.
+func (*CancelFlightResponse) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CancelFlightResponse) GetFlight() *models.Flight {
+	if x != nil {
+		return x.Flight
+	}
+	return nil
+}
+
+func (x *CancelFlightResponse) GetRebookings() []*Rebooking {
+	if x != nil {
+		return x.Rebookings
+	}
+	return nil
+}
+
+func (x *CancelFlightResponse) GetCancelledPendingTokens() []string {
+	if x != nil {
+		return x.CancelledPendingTokens
+	}
+	return nil
+}
+
+type UpdateFlightStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// SCHEDULED, DELAYED, BOARDING, DEPARTED, DIVERTED or ARRIVED.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// RFC3339, required for DELAYED unless already known.
+	EstimatedDepartureTime string `protobuf:"bytes,3,opt,name=estimated_departure_time,json=estimatedDepartureTime,proto3" json:"estimated_departure_time,omitempty"`
+	Gate                   string `protobuf:"bytes,4,opt,name=gate,proto3" json:"gate,omitempty"`
+	Terminal               string `protobuf:"bytes,5,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	// Airport code, required for DIVERTED.
+	DivertedTo string `protobuf:"bytes,6,opt,name=diverted_to,json=divertedTo,proto3" json:"diverted_to,omitempty"`
+	// RFC3339 time of the report, defaults to now. Older reports are rejected.
+	ReportedAt string `protobuf:"bytes,7,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+}
+
+func (x *UpdateFlightStatusRequest) Reset() {
+	*x = UpdateFlightStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFlightStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFlightStatusRequest) ProtoMessage() {}
+
+func (x *UpdateFlightStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFlightStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFlightStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateFlightStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateFlightStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateFlightStatusRequest) GetEstimatedDepartureTime() string {
+	if x != nil {
+		return x.EstimatedDepartureTime
+	}
+	return ""
+}
+
+func (x *UpdateFlightStatusRequest) GetGate() string {
+	if x != nil {
+		return x.Gate
+	}
+	return ""
+}
+
+func (x *UpdateFlightStatusRequest) GetTerminal() string {
+	if x != nil {
+		return x.Terminal
+	}
+	return ""
+}
+
+func (x *UpdateFlightStatusRequest) GetDivertedTo() string {
+	if x != nil {
+		return x.DivertedTo
+	}
+	return ""
+}
+
+func (x *UpdateFlightStatusRequest) GetReportedAt() string {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return ""
+}
+
+type UpdateFlightStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status             *models.FlightStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Changes            []string             `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	NotifiedPassengers int32                `protobuf:"varint,3,opt,name=notified_passengers,json=notifiedPassengers,proto3" json:"notified_passengers,omitempty"`
+}
+
+func (x *UpdateFlightStatusResponse) Reset() {
+	*x = UpdateFlightStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFlightStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFlightStatusResponse) ProtoMessage() {}
+
+func (x *UpdateFlightStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFlightStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFlightStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateFlightStatusResponse) GetStatus() *models.FlightStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateFlightStatusResponse) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *UpdateFlightStatusResponse) GetNotifiedPassengers() int32 {
+	if x != nil {
+		return x.NotifiedPassengers
+	}
+	return 0
+}
+
+type ListOversoldFlightsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Look-ahead window, defaults to ops.oversold_report_hours.
+	WithinHours int32 `protobuf:"varint,1,opt,name=within_hours,json=withinHours,proto3" json:"within_hours,omitempty"`
+}
+
+func (x *ListOversoldFlightsRequest) Reset() {
+	*x = ListOversoldFlightsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOversoldFlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOversoldFlightsRequest) ProtoMessage() {}
+
+func (x *ListOversoldFlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOversoldFlightsRequest.ProtoReflect.Descriptor instead.
+func (*ListOversoldFlightsRequest) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOversoldFlightsRequest) GetWithinHours() int32 {
+	if x != nil {
+		return x.WithinHours
+	}
+	return 0
+}
+
+type ListOversoldFlightsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flights []*models.Flight `protobuf:"bytes,1,rep,name=flights,proto3" json:"flights,omitempty"`
+}
+
+func (x *ListOversoldFlightsResponse) Reset() {
+	*x = ListOversoldFlightsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOversoldFlightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOversoldFlightsResponse) ProtoMessage() {}
+
+func (x *ListOversoldFlightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOversoldFlightsResponse.ProtoReflect.Descriptor instead.
+func (*ListOversoldFlightsResponse) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOversoldFlightsResponse) GetFlights() []*models.Flight {
+	if x != nil {
+		return x.Flights
+	}
+	return nil
+}
+
+type DenyBoardingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Bookings whose passengers volunteered, in the order they volunteered.
+	VolunteerTokens []string `protobuf:"bytes,2,rep,name=volunteer_tokens,json=volunteerTokens,proto3" json:"volunteer_tokens,omitempty"`
+	// Overrides the configured voluntary compensation when positive.
+	VoluntaryCompensationCents int64 `protobuf:"varint,3,opt,name=voluntary_compensation_cents,json=voluntaryCompensationCents,proto3" json:"voluntary_compensation_cents,omitempty"`
+}
+
+func (x *DenyBoardingRequest) Reset() {
+	*x = DenyBoardingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenyBoardingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyBoardingRequest) ProtoMessage() {}
+
+func (x *DenyBoardingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyBoardingRequest.ProtoReflect.Descriptor instead.
+func (*DenyBoardingRequest) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DenyBoardingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DenyBoardingRequest) GetVolunteerTokens() []string {
+	if x != nil {
+		return x.VolunteerTokens
+	}
+	return nil
+}
+
+func (x *DenyBoardingRequest) GetVoluntaryCompensationCents() int64 {
+	if x != nil {
+		return x.VoluntaryCompensationCents
+	}
+	return 0
+}
+
+type DeniedBoarding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Email      string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	SeatNumber int32  `protobuf:"varint,3,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	// VOLUNTARY or INVOLUNTARY.
+	Kind              string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	CompensationCents int64  `protobuf:"varint,5,opt,name=compensation_cents,json=compensationCents,proto3" json:"compensation_cents,omitempty"`
+	CreatedAt         string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DeniedBoarding) Reset() {
+	*x = DeniedBoarding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeniedBoarding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeniedBoarding) ProtoMessage() {}
+
+func (x *DeniedBoarding) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeniedBoarding.ProtoReflect.Descriptor instead.
+func (*DeniedBoarding) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeniedBoarding) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeniedBoarding) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DeniedBoarding) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *DeniedBoarding) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DeniedBoarding) GetCompensationCents() int64 {
+	if x != nil {
+		return x.CompensationCents
+	}
+	return 0
+}
+
+func (x *DeniedBoarding) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DenyBoardingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flight *models.Flight    `protobuf:"bytes,1,opt,name=flight,proto3" json:"flight,omitempty"`
+	Denied []*DeniedBoarding `protobuf:"bytes,2,rep,name=denied,proto3" json:"denied,omitempty"`
+}
+
+func (x *DenyBoardingResponse) Reset() {
+	*x = DenyBoardingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenyBoardingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyBoardingResponse) ProtoMessage() {}
+
+func (x *DenyBoardingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyBoardingResponse.ProtoReflect.Descriptor instead.
+func (*DenyBoardingResponse) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DenyBoardingResponse) GetFlight() *models.Flight {
+	if x != nil {
+		return x.Flight
+	}
+	return nil
+}
+
+func (x *DenyBoardingResponse) GetDenied() []*DeniedBoarding {
+	if x != nil {
+		return x.Denied
+	}
+	return nil
+}
+
+type ListDeniedBoardingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListDeniedBoardingsRequest) Reset() {
+	*x = ListDeniedBoardingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeniedBoardingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeniedBoardingsRequest) ProtoMessage() {}
+
+func (x *ListDeniedBoardingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeniedBoardingsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeniedBoardingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListDeniedBoardingsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListDeniedBoardingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denied []*DeniedBoarding `protobuf:"bytes,1,rep,name=denied,proto3" json:"denied,omitempty"`
+}
+
+func (x *ListDeniedBoardingsResponse) Reset() {
+	*x = ListDeniedBoardingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeniedBoardingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeniedBoardingsResponse) ProtoMessage() {}
+
+func (x *ListDeniedBoardingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeniedBoardingsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeniedBoardingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDeniedBoardingsResponse) GetDenied() []*DeniedBoarding {
+	if x != nil {
+		return x.Denied
+	}
+	return nil
+}
+
+type SetBookingStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// FLOWN, NO_SHOW or REFUNDED.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetBookingStatusRequest) Reset() {
+	*x = SetBookingStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ops_api_ops_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBookingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBookingStatusRequest) ProtoMessage() {}
+
+func (x *SetBookingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ops_api_ops_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBookingStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetBookingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_ops_api_ops_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetBookingStatusRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetBookingStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetBookingStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_api_ops_api_ops_proto protoreflect.FileDescriptor
+
+var file_api_ops_api_ops_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x13, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5c, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x98, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3d,
+	0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0xc2, 0x01,
+	0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x72, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x54, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x69, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x52, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x07, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a,
+	0x13, 0x44, 0x65, 0x6e, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65,
+	0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x65, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x40, 0x0a, 0x1c, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x76, 0x6f, 0x6c, 0x75, 0x6e, 0x74, 0x61, 0x72, 0x79,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6e, 0x79, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x3a, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x69,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x64, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x32, 0xb1, 0x07, 0x0a, 0x0a, 0x4f, 0x70, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x73, 0x2f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x9f, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x61, 0x69,
+	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x69, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x73, 0x2f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9c, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x2e,
+	0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x73, 0x6f, 0x6c, 0x64,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6f, 0x70, 0x73, 0x2f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x6f, 0x76, 0x65,
+	0x72, 0x73, 0x6f, 0x6c, 0x64, 0x12, 0x97, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6e, 0x79, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6e, 0x79,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2e, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x73, 0x2f, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6e, 0x69,
+	0x65, 0x64, 0x2d, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0xa9, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
+	0x12, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x73, 0x2f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6e, 0x69, 0x65,
+	0x64, 0x2d, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2b, 0x2e, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6f, 0x70,
+	0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x73, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x63, 0x6b,
+	0x31, 0x39, 0x39, 0x31, 0x2f, 0x61, 0x69, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x6f, 0x70, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x3b, 0x6f, 0x70, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_ops_api_ops_proto_rawDescOnce sync.Once
+	file_api_ops_api_ops_proto_rawDescData = file_api_ops_api_ops_proto_rawDesc
+)
+
+func file_api_ops_api_ops_proto_rawDescGZIP() []byte {
+	file_api_ops_api_ops_proto_rawDescOnce.Do(func() {
+		file_api_ops_api_ops_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_ops_api_ops_proto_rawDescData)
+	})
+	return file_api_ops_api_ops_proto_rawDescData
+}
+
+var file_api_ops_api_ops_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_ops_api_ops_proto_goTypes = []interface{}{
+	(*CancelFlightRequest)(nil),         // 0: airbooking.ops_api.CancelFlightRequest
+	(*Rebooking)(nil),                   // 1: airbooking.ops_api.Rebooking
+	(*CancelFlightResponse)(nil),        // 2: airbooking.ops_api.CancelFlightResponse
+	(*UpdateFlightStatusRequest)(nil),   // 3: airbooking.ops_api.UpdateFlightStatusRequest
+	(*UpdateFlightStatusResponse)(nil),  // 4: airbooking.ops_api.UpdateFlightStatusResponse
+	(*ListOversoldFlightsRequest)(nil),  // 5: airbooking.ops_api.ListOversoldFlightsRequest
+	(*ListOversoldFlightsResponse)(nil), // 6: airbooking.ops_api.ListOversoldFlightsResponse
+	(*DenyBoardingRequest)(nil),         // 7: airbooking.ops_api.DenyBoardingRequest
+	(*DeniedBoarding)(nil),              // 8: airbooking.ops_api.DeniedBoarding
+	(*DenyBoardingResponse)(nil),        // 9: airbooking.ops_api.DenyBoardingResponse
+	(*ListDeniedBoardingsRequest)(nil),  // 10: airbooking.ops_api.ListDeniedBoardingsRequest
+	(*ListDeniedBoardingsResponse)(nil), // 11: airbooking.ops_api.ListDeniedBoardingsResponse
+	(*SetBookingStatusRequest)(nil),     // 12: airbooking.ops_api.SetBookingStatusRequest
+	(*models.Flight)(nil),               // 13: airbooking.models.Flight
+	(*models.FlightStatus)(nil),         // 14: airbooking.models.FlightStatus
+	(*models.Booking)(nil),              // 15: airbooking.models.Booking
+}
+var file_api_ops_api_ops_proto_depIdxs = []int32{
+	13, // 0: airbooking.ops_api.Rebooking.alternatives:type_name -> airbooking.models.Flight
+	13, // 1: airbooking.ops_api.CancelFlightResponse.flight:type_name -> airbooking.models.Flight
+	1,  // 2: airbooking.ops_api.CancelFlightResponse.rebookings:type_name -> airbooking.ops_api.Rebooking
+	14, // 3: airbooking.ops_api.UpdateFlightStatusResponse.status:type_name -> airbooking.models.FlightStatus
+	13, // 4: airbooking.ops_api.ListOversoldFlightsResponse.flights:type_name -> airbooking.models.Flight
+	13, // 5: airbooking.ops_api.DenyBoardingResponse.flight:type_name -> airbooking.models.Flight
+	8,  // 6: airbooking.ops_api.DenyBoardingResponse.denied:type_name -> airbooking.ops_api.DeniedBoarding
+	8,  // 7: airbooking.ops_api.ListDeniedBoardingsResponse.denied:type_name -> airbooking.ops_api.DeniedBoarding
+	0,  // 8: airbooking.ops_api.OpsService.CancelFlight:input_type -> airbooking.ops_api.CancelFlightRequest
+	3,  // 9: airbooking.ops_api.OpsService.UpdateFlightStatus:input_type -> airbooking.ops_api.UpdateFlightStatusRequest
+	5,  // 10: airbooking.ops_api.OpsService.ListOversoldFlights:input_type -> airbooking.ops_api.ListOversoldFlightsRequest
+	7,  // 11: airbooking.ops_api.OpsService.DenyBoarding:input_type -> airbooking.ops_api.DenyBoardingRequest
+	10, // 12: airbooking.ops_api.OpsService.ListDeniedBoardings:input_type -> airbooking.ops_api.ListDeniedBoardingsRequest
+	12, // 13: airbooking.ops_api.OpsService.SetBookingStatus:input_type -> airbooking.ops_api.SetBookingStatusRequest
+	2,  // 14: airbooking.ops_api.OpsService.CancelFlight:output_type -> airbooking.ops_api.CancelFlightResponse
+	4,  // 15: airbooking.ops_api.OpsService.UpdateFlightStatus:output_type -> airbooking.ops_api.UpdateFlightStatusResponse
+	6,  // 16: airbooking.ops_api.OpsService.ListOversoldFlights:output_type -> airbooking.ops_api.ListOversoldFlightsResponse
+	9,  // 17: airbooking.ops_api.OpsService.DenyBoarding:output_type -> airbooking.ops_api.DenyBoardingResponse
+	11, // 18: airbooking.ops_api.OpsService.ListDeniedBoardings:output_type -> airbooking.ops_api.ListDeniedBoardingsResponse
+	15, // 19: airbooking.ops_api.OpsService.SetBookingStatus:output_type -> airbooking.models.Booking
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_ops_api_ops_proto_init() }
+func file_api_ops_api_ops_proto_init() {
+	if File_api_ops_api_ops_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_ops_api_ops_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelFlightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rebooking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelFlightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFlightStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFlightStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOversoldFlightsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOversoldFlightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenyBoardingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeniedBoarding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenyBoardingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeniedBoardingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeniedBoardingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ops_api_ops_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBookingStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_ops_api_ops_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_ops_api_ops_proto_goTypes,
+		DependencyIndexes: file_api_ops_api_ops_proto_depIdxs,
+		MessageInfos:      file_api_ops_api_ops_proto_msgTypes,
+	}.Build()
+	File_api_ops_api_ops_proto = out.File
+	file_api_ops_api_ops_proto_rawDesc = nil
+	file_api_ops_api_ops_proto_goTypes = nil
+	file_api_ops_api_ops_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// OpsServiceClient is the client API for OpsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OpsServiceClient interface {
+	// CancelFlight cancels the flight and rebooks its confirmed passengers onto
+	// alternative flights on the same route. Calling it again for a cancelled
+	// flight retries the bookings that are still on it.
+	CancelFlight(ctx context.Context, in *CancelFlightRequest, opts ...grpc.CallOption) (*CancelFlightResponse, error)
+	// UpdateFlightStatus ingests a status report. Empty fields keep the current
+	// value. Significant changes are sent to booked passengers.
+	UpdateFlightStatus(ctx context.Context, in *UpdateFlightStatusRequest, opts ...grpc.CallOption) (*UpdateFlightStatusResponse, error)
+	// ListOversoldFlights reports the flights that sold more seats than they
+	// have and depart within the given window, closest departure first.
+	ListOversoldFlights(ctx context.Context, in *ListOversoldFlightsRequest, opts ...grpc.CallOption) (*ListOversoldFlightsResponse, error)
+	// DenyBoarding takes passengers off an oversold flight until it fits:
+	// volunteers first, then the most recent bookings. Each passenger is
+	// compensated and notified.
+	DenyBoarding(ctx context.Context, in *DenyBoardingRequest, opts ...grpc.CallOption) (*DenyBoardingResponse, error)
+	ListDeniedBoardings(ctx context.Context, in *ListDeniedBoardingsRequest, opts ...grpc.CallOption) (*ListDeniedBoardingsResponse, error)
+	// SetBookingStatus records that a passenger flew (FLOWN), did not show up
+	// (NO_SHOW) or was refunded (REFUNDED). Invalid transitions are rejected.
+	// A cancelled booking is refunded by the token it was issued with.
+	SetBookingStatus(ctx context.Context, in *SetBookingStatusRequest, opts ...grpc.CallOption) (*models.Booking, error)
+}
+
+type opsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOpsServiceClient(cc grpc.ClientConnInterface) OpsServiceClient {
+	return &opsServiceClient{cc}
+}
+
+func (c *opsServiceClient) CancelFlight(ctx context.Context, in *CancelFlightRequest, opts ...grpc.CallOption) (*CancelFlightResponse, error) {
+	out := new(CancelFlightResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.ops_api.OpsService/CancelFlight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsServiceClient) UpdateFlightStatus(ctx context.Context, in *UpdateFlightStatusRequest, opts ...grpc.CallOption) (*UpdateFlightStatusResponse, error) {
+	out := new(UpdateFlightStatusResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.ops_api.OpsService/UpdateFlightStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsServiceClient) ListOversoldFlights(ctx context.Context, in *ListOversoldFlightsRequest, opts ...grpc.CallOption) (*ListOversoldFlightsResponse, error) {
+	out := new(ListOversoldFlightsResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.ops_api.OpsService/ListOversoldFlights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsServiceClient) DenyBoarding(ctx context.Context, in *DenyBoardingRequest, opts ...grpc.CallOption) (*DenyBoardingResponse, error) {
+	out := new(DenyBoardingResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.ops_api.OpsService/DenyBoarding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsServiceClient) ListDeniedBoardings(ctx context.Context, in *ListDeniedBoardingsRequest, opts ...grpc.CallOption) (*ListDeniedBoardingsResponse, error) {
+	out := new(ListDeniedBoardingsResponse)
+	err := c.cc.Invoke(ctx, "/airbooking.ops_api.OpsService/ListDeniedBoardings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opsServiceClient) SetBookingStatus(ctx context.Context, in *SetBookingStatusRequest, opts ...grpc.CallOption) (*models.Booking, error) {
+	out := new(models.Booking)
+	err := c.cc.Invoke(ctx, "/airbooking.ops_api.OpsService/SetBookingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpsServiceServer is the server API for OpsService service.
+type OpsServiceServer interface {
+	// CancelFlight cancels the flight and rebooks its confirmed passengers onto
+	// alternative flights on the same route. Calling it again for a cancelled
+	// flight retries the bookings that are still on it.
+	CancelFlight(context.Context, *CancelFlightRequest) (*CancelFlightResponse, error)
+	// UpdateFlightStatus ingests a status report. Empty fields keep the current
+	// value. Significant changes are sent to booked passengers.
+	UpdateFlightStatus(context.Context, *UpdateFlightStatusRequest) (*UpdateFlightStatusResponse, error)
+	// ListOversoldFlights reports the flights that sold more seats than they
+	// have and depart within the given window, closest departure first.
+	ListOversoldFlights(context.Context, *ListOversoldFlightsRequest) (*ListOversoldFlightsResponse, error)
+	// DenyBoarding takes passengers off an oversold flight until it fits:
+	// volunteers first, then the most recent bookings. Each passenger is
+	// compensated and notified.
+	DenyBoarding(context.Context, *DenyBoardingRequest) (*DenyBoardingResponse, error)
+	ListDeniedBoardings(context.Context, *ListDeniedBoardingsRequest) (*ListDeniedBoardingsResponse, error)
+	// SetBookingStatus records that a passenger flew (FLOWN), did not show up
+	// (NO_SHOW) or was refunded (REFUNDED). Invalid transitions are rejected.
+	// A cancelled booking is refunded by the token it was issued with.
+	SetBookingStatus(context.Context, *SetBookingStatusRequest) (*models.Booking, error)
+}
+
+// UnimplementedOpsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedOpsServiceServer struct {
+}
+
+func (*UnimplementedOpsServiceServer) CancelFlight(context.Context, *CancelFlightRequest) (*CancelFlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFlight not implemented")
+}
+func (*UnimplementedOpsServiceServer) UpdateFlightStatus(context.Context, *UpdateFlightStatusRequest) (*UpdateFlightStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlightStatus not implemented")
+}
+func (*UnimplementedOpsServiceServer) ListOversoldFlights(context.Context, *ListOversoldFlightsRequest) (*ListOversoldFlightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOversoldFlights not implemented")
+}
+func (*UnimplementedOpsServiceServer) DenyBoarding(context.Context, *DenyBoardingRequest) (*DenyBoardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyBoarding not implemented")
+}
+func (*UnimplementedOpsServiceServer) ListDeniedBoardings(context.Context, *ListDeniedBoardingsRequest) (*ListDeniedBoardingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeniedBoardings not implemented")
+}
+func (*UnimplementedOpsServiceServer) SetBookingStatus(context.Context, *SetBookingStatusRequest) (*models.Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBookingStatus not implemented")
+}
+
+func RegisterOpsServiceServer(s *grpc.Server, srv OpsServiceServer) {
+	s.RegisterService(&_OpsService_serviceDesc, srv)
+}
+
+func _OpsService_CancelFlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServiceServer).CancelFlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.ops_api.OpsService/CancelFlight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServiceServer).CancelFlight(ctx, req.(*CancelFlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpsService_UpdateFlightStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFlightStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServiceServer).UpdateFlightStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.ops_api.OpsService/UpdateFlightStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServiceServer).UpdateFlightStatus(ctx, req.(*UpdateFlightStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpsService_ListOversoldFlights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOversoldFlightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServiceServer).ListOversoldFlights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.ops_api.OpsService/ListOversoldFlights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServiceServer).ListOversoldFlights(ctx, req.(*ListOversoldFlightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpsService_DenyBoarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyBoardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServiceServer).DenyBoarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.ops_api.OpsService/DenyBoarding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServiceServer).DenyBoarding(ctx, req.(*DenyBoardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpsService_ListDeniedBoardings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeniedBoardingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServiceServer).ListDeniedBoardings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.ops_api.OpsService/ListDeniedBoardings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServiceServer).ListDeniedBoardings(ctx, req.(*ListDeniedBoardingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpsService_SetBookingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBookingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpsServiceServer).SetBookingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/airbooking.ops_api.OpsService/SetBookingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpsServiceServer).SetBookingStatus(ctx, req.(*SetBookingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OpsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "airbooking.ops_api.OpsService",
+	HandlerType: (*OpsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CancelFlight",
+			Handler:    _OpsService_CancelFlight_Handler,
+		},
+		{
+			MethodName: "UpdateFlightStatus",
+			Handler:    _OpsService_UpdateFlightStatus_Handler,
+		},
+		{
+			MethodName: "ListOversoldFlights",
+			Handler:    _OpsService_ListOversoldFlights_Handler,
+		},
+		{
+			MethodName: "DenyBoarding",
+			Handler:    _OpsService_DenyBoarding_Handler,
+		},
+		{
+			MethodName: "ListDeniedBoardings",
+			Handler:    _OpsService_ListDeniedBoardings_Handler,
+		},
+		{
+			MethodName: "SetBookingStatus",
+			Handler:    _OpsService_SetBookingStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/ops_api/ops.proto",
+}
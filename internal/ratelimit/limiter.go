@@ -0,0 +1,99 @@
+// Package ratelimit throttles API callers with token buckets kept in Redis,
+// so that every API instance shares the same budget.
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Limit is a token bucket refilled at PerMinute tokens a minute that holds
+// at most Burst tokens. A zero Burst allows a minute's worth of calls at
+// once.
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+// Enabled reports whether calls are limited at all.
+func (l Limit) Enabled() bool {
+	return l.PerMinute > 0
+}
+
+func (l Limit) burst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.PerMinute
+}
+
+// Result is the outcome of a call. RetryAfter is set when the call is not
+// allowed.
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	// Allow takes a token from the bucket of key.
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// tokenBucket refills the bucket for the time elapsed since the last call,
+// by the Redis clock, and takes a token if one is left. The bucket expires
+// once it would be full again.
+var tokenBucket = redis.NewScript(`
+local rate = tonumber(ARGV[1]) / 60000
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry = math.ceil((1 - tokens) / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate) + 1000)
+return {allowed, math.floor(tokens), retry}
+`)
+
+type RedisLimiter struct {
+	client *redis.Client
+}
+
+func NewRedisLimiter(client *redis.Client) *RedisLimiter {
+	return &RedisLimiter{client: client}
+}
+
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	if !limit.Enabled() {
+		return Result{Allowed: true}, nil
+	}
+	res, err := tokenBucket.Run(ctx, l.client, []string{"ratelimit:" + key}, limit.PerMinute, limit.burst()).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
+
+var _ Limiter = (*RedisLimiter)(nil)
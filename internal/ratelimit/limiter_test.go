@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisLimiter(t *testing.T) {
+	server := miniredis.RunT(t)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	server.SetTime(now)
+	limiter := NewRedisLimiter(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+	ctx := context.Background()
+	limit := Limit{PerMinute: 60, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		res, err := limiter.Allow(ctx, "ip:10.0.0.1", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+	res, err := limiter.Allow(ctx, "ip:10.0.0.1", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed, "burst spent")
+	assert.Equal(t, time.Second, res.RetryAfter)
+
+	res, err = limiter.Allow(ctx, "ip:10.0.0.2", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed, "buckets are per key")
+
+	server.SetTime(now.Add(1500 * time.Millisecond))
+	res, err = limiter.Allow(ctx, "ip:10.0.0.1", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed, "refilled")
+	assert.Equal(t, 0, res.Remaining)
+}
+
+func TestRedisLimiterDisabled(t *testing.T) {
+	limiter := NewRedisLimiter(redis.NewClient(&redis.Options{Addr: "127.0.0.1:1"}))
+	res, err := limiter.Allow(context.Background(), "ip:10.0.0.1", Limit{})
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
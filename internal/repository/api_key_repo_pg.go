@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) error
+	GetByHash(ctx context.Context, hash string) (*domain.APIKey, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	// Revoke revokes the key with id. Revoking a revoked key keeps its
+	// original revocation time.
+	Revoke(ctx context.Context, id int64) (*domain.APIKey, error)
+}
+
+type PGAPIKeyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAPIKeyRepository(db *pgxpool.Pool) APIKeyRepository {
+	return &PGAPIKeyRepository{db: db}
+}
+
+const apiKeyColumns = `id, name, prefix, key_hash, scopes, COALESCE(rate_limit_per_minute, 0), created_at, revoked_at`
+
+func scanAPIKey(row pgx.Row) (*domain.APIKey, error) {
+	var k domain.APIKey
+	var revokedAt *time.Time
+	if err := row.Scan(&k.ID, &k.Name, &k.Prefix, &k.Hash, &k.Scopes, &k.RateLimitPerMinute, &k.CreatedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+	k.RevokedAt = derefTime(revokedAt)
+	return &k, nil
+}
+
+func (r *PGAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	var rateLimit *int
+	if key.RateLimitPerMinute > 0 {
+		rateLimit = &key.RateLimitPerMinute
+	}
+	scopes := key.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return r.db.QueryRow(ctx, `INSERT INTO api_keys (name, prefix, key_hash, scopes, rate_limit_per_minute)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`, key.Name, key.Prefix, key.Hash, scopes, rateLimit).Scan(&key.ID, &key.CreatedAt)
+}
+
+func (r *PGAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRow(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash=$1`, hash))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	return key, err
+}
+
+func (r *PGAPIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *k)
+	}
+	return keys, rows.Err()
+}
+
+func (r *PGAPIKeyRepository) Revoke(ctx context.Context, id int64) (*domain.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRow(ctx, `UPDATE api_keys SET revoked_at=COALESCE(revoked_at, now()) WHERE id=$1 RETURNING `+apiKeyColumns, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	return key, err
+}
+
+var _ APIKeyRepository = (*PGAPIKeyRepository)(nil)
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIKeyRepository(t *testing.T) {
+	repo := NewAPIKeyRepository(&pgxpool.Pool{})
+	assert.NotNil(t, repo)
+}
@@ -5,17 +5,59 @@ import (
 	"errors"
 	"time"
 
+	"github.com/Domenick1991/airbooking/internal/audit"
 	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type BookingRepository interface {
 	CreatePending(ctx context.Context, booking *domain.Booking) error
 	GetByToken(ctx context.Context, token string) (*domain.Booking, error)
-	UpdateStatus(ctx context.Context, token string, status domain.BookingStatus) (*domain.Booking, error)
+	GetByID(ctx context.Context, id int64) (*domain.Booking, error)
+	UpdateStatus(ctx context.Context, token string, from, to domain.BookingStatus) (*domain.Booking, error)
 	ExpirePendingBefore(ctx context.Context, deadline time.Time) ([]domain.Booking, error)
 	ReleaseSeat(ctx context.Context, flightID int64) error
+	ListByFlight(ctx context.Context, flightID int64, statuses ...domain.BookingStatus) ([]domain.Booking, error)
+	Rebook(ctx context.Context, token string, fromFlightID, toFlightID int64, charges domain.FareCharges) (*domain.Booking, error)
+	ExtendHold(ctx context.Context, token string, expiresAt time.Time, maxExtensions int) (*domain.Booking, error)
+	ChangeSeat(ctx context.Context, token string, seatNumber int) (*domain.Booking, error)
+	ChangeFlight(ctx context.Context, change *domain.FlightChange, seatNumber int) (*domain.Booking, error)
+	// History returns the status history of the booking with token, or that
+	// had it before the token was rotated, oldest first.
+	History(ctx context.Context, token string) ([]domain.BookingTransition, error)
+	// ListByCustomer returns a page of the bookings of a customer, newest
+	// first.
+	ListByCustomer(ctx context.Context, customerID int64, page domain.BookingPage) ([]domain.Booking, error)
+	// Cancel moves the booking from status from to CANCELLED like
+	// UpdateStatus and rotates its token in the same transaction, which
+	// revokes the token and the manage links signed with it.
+	Cancel(ctx context.Context, token string, from domain.BookingStatus) (*domain.Booking, error)
+}
+
+const bookingColumns = `id, flight_id, seat_number, token, status, expires_at, email, COALESCE(customer_id, 0), COALESCE(api_key_id, 0), channel, fare_class, price_cents, promo_code, discount_cents, taxes, fuel_surcharge_cents, booking_fee_cents, seat_fee_cents, currency, hold_extensions, created_at, updated_at`
+
+func scanBooking(row pgx.Row) (*domain.Booking, error) {
+	var b domain.Booking
+	if err := row.Scan(&b.ID, &b.FlightID, &b.SeatNumber, &b.Token, &b.Status, &b.ExpiresAt, &b.Email, &b.CustomerID, &b.APIKeyID, &b.Channel, &b.FareClass, &b.PriceCents, &b.PromoCode, &b.DiscountCents, &b.Charges.Taxes, &b.Charges.FuelSurchargeCents, &b.Charges.BookingFeeCents, &b.Charges.SeatFeeCents, &b.Currency, &b.HoldExtensions, &b.CreatedAt, &b.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func scanBookings(rows pgx.Rows) ([]domain.Booking, error) {
+	defer rows.Close()
+
+	var bookings []domain.Booking
+	for rows.Next() {
+		b, err := scanBooking(rows)
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, *b)
+	}
+	return bookings, rows.Err()
 }
 
 type PGBookingRepository struct {
@@ -33,61 +75,158 @@ func (r *PGBookingRepository) CreatePending(ctx context.Context, booking *domain
 	}
 	defer tx.Rollback(ctx)
 
+	// available_seats may go down to -overbooking_limit: the flight is then
+	// oversold and denied boardings are resolved by operations. The booking
+	// was priced from the fare of the flight, which must still hold.
+	fareCents := booking.PriceCents + booking.DiscountCents
 	var available int
-	if err := tx.QueryRow(ctx, `UPDATE flights SET available_seats = available_seats - 1, updated_at = now() WHERE id=$1 AND available_seats > 0 RETURNING available_seats`, booking.FlightID).Scan(&available); err != nil {
-		return err
+	err = tx.QueryRow(ctx, `UPDATE flights SET available_seats = available_seats - 1, updated_at = now() WHERE id=$1 AND status IN `+bookableStatuses+` AND available_seats > -overbooking_limit AND price_cents=$2 AND currency=$3 RETURNING available_seats`, booking.FlightID, fareCents, booking.Currency).Scan(&available)
+	if errors.Is(err, pgx.ErrNoRows) {
+		var price int64
+		var currency string
+		if err := tx.QueryRow(ctx, `SELECT price_cents, currency FROM flights WHERE id=$1`, booking.FlightID).Scan(&price, &currency); err == nil && (price != fareCents || currency != booking.Currency) {
+			return domain.ErrPriceChanged
+		}
+		return domain.ErrNoSeatsAvailable
 	}
-	if available < 0 {
-		return errors.New("no available seats")
+	if err != nil {
+		return err
 	}
 
 	booking.Status = domain.BookingStatusPending
-	if err := tx.QueryRow(ctx, `INSERT INTO bookings (flight_id, seat_number, token, status, expires_at, email)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, created_at, updated_at`, booking.FlightID, booking.SeatNumber, booking.Token, booking.Status, booking.ExpiresAt, booking.Email).
+	if booking.Channel == "" {
+		booking.Channel = domain.ChannelWeb
+	}
+	if booking.FareClass == "" {
+		booking.FareClass = domain.FareClassEconomy
+	}
+	var promotionID int64
+	if booking.PromoCode != "" {
+		if promotionID, err = applyPromotion(ctx, tx, booking); err != nil {
+			return err
+		}
+	}
+	if booking.Charges.Taxes == nil {
+		booking.Charges.Taxes = make([]domain.TaxCharge, 0)
+	}
+	if err := tx.QueryRow(ctx, `INSERT INTO bookings (flight_id, seat_number, token, status, expires_at, email, channel, fare_class, price_cents, customer_id, promo_code, discount_cents,
+			taxes, fuel_surcharge_cents, booking_fee_cents, seat_fee_cents, currency, api_key_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, 0), $11, $12, $13, $14, $15, $16, $17, NULLIF($18, 0))
+		RETURNING id, created_at, updated_at`, booking.FlightID, booking.SeatNumber, booking.Token, booking.Status, booking.ExpiresAt, booking.Email, booking.Channel, booking.FareClass, booking.PriceCents, booking.CustomerID, booking.PromoCode, booking.DiscountCents,
+		booking.Charges.Taxes, booking.Charges.FuelSurchargeCents, booking.Charges.BookingFeeCents, booking.Charges.SeatFeeCents, booking.Currency, booking.APIKeyID).
 		Scan(&booking.ID, &booking.CreatedAt, &booking.UpdatedAt); err != nil {
 		return err
 	}
+	if promotionID != 0 {
+		if _, err := tx.Exec(ctx, `INSERT INTO promotion_redemptions (promotion_id, booking_id, email, customer_id, discount_cents)
+			VALUES ($1, $2, $3, NULLIF($4, 0), $5)`, promotionID, booking.ID, booking.Email, booking.CustomerID, booking.DiscountCents); err != nil {
+			return err
+		}
+	}
+	if err := recordTransition(ctx, tx, "", booking.Status, *booking); err != nil {
+		return err
+	}
 
 	return tx.Commit(ctx)
 }
 
 func (r *PGBookingRepository) GetByToken(ctx context.Context, token string) (*domain.Booking, error) {
-	row := r.db.QueryRow(ctx, `SELECT id, flight_id, seat_number, token, status, expires_at, email, created_at, updated_at FROM bookings WHERE token=$1`, token)
-	var b domain.Booking
-	if err := row.Scan(&b.ID, &b.FlightID, &b.SeatNumber, &b.Token, &b.Status, &b.ExpiresAt, &b.Email, &b.CreatedAt, &b.UpdatedAt); err != nil {
-		return nil, err
+	booking, err := scanBooking(r.db.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE token=$1`, token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBookingNotFound
 	}
-	return &b, nil
+	return booking, err
 }
 
-func (r *PGBookingRepository) UpdateStatus(ctx context.Context, token string, status domain.BookingStatus) (*domain.Booking, error) {
-	row := r.db.QueryRow(ctx, `UPDATE bookings SET status=$1, updated_at=now() WHERE token=$2 RETURNING id, flight_id, seat_number, token, status, expires_at, email, created_at, updated_at`, status, token)
-	var b domain.Booking
-	if err := row.Scan(&b.ID, &b.FlightID, &b.SeatNumber, &b.Token, &b.Status, &b.ExpiresAt, &b.Email, &b.CreatedAt, &b.UpdatedAt); err != nil {
-		return nil, err
+func (r *PGBookingRepository) GetByID(ctx context.Context, id int64) (*domain.Booking, error) {
+	booking, err := scanBooking(r.db.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE id=$1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBookingNotFound
 	}
-	return &b, nil
+	return booking, err
 }
 
-func (r *PGBookingRepository) ExpirePendingBefore(ctx context.Context, deadline time.Time) ([]domain.Booking, error) {
-	rows, err := r.db.Query(ctx, `UPDATE bookings SET status=$1, updated_at=now() WHERE status=$2 AND expires_at <= $3 RETURNING id, flight_id, seat_number, token, status, expires_at, email, created_at, updated_at`, domain.BookingStatusExpired, domain.BookingStatusPending, deadline)
+// UpdateStatus moves the booking from status from to status to. The update is
+// conditional: when the booking is no longer in from, because another request
+// changed it first, a *domain.BookingTransitionError with its current status
+// is returned.
+func (r *PGBookingRepository) UpdateStatus(ctx context.Context, token string, from, to domain.BookingStatus) (*domain.Booking, error) {
+	return r.updateStatus(ctx, token, from, to, false)
+}
+
+// Cancel returns the booking with the token it had; the new token is not
+// handed out.
+func (r *PGBookingRepository) Cancel(ctx context.Context, token string, from domain.BookingStatus) (*domain.Booking, error) {
+	return r.updateStatus(ctx, token, from, domain.BookingStatusCancelled, true)
+}
+
+func (r *PGBookingRepository) updateStatus(ctx context.Context, token string, from, to domain.BookingStatus, rotateToken bool) (*domain.Booking, error) {
+	if !from.CanTransitionTo(to) {
+		return nil, &domain.BookingTransitionError{From: from, To: to}
+	}
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	defer tx.Rollback(ctx)
 
-	var expired []domain.Booking
-	for rows.Next() {
-		var b domain.Booking
-		if err := rows.Scan(&b.ID, &b.FlightID, &b.SeatNumber, &b.Token, &b.Status, &b.ExpiresAt, &b.Email, &b.CreatedAt, &b.UpdatedAt); err != nil {
+	b, err := scanBooking(tx.QueryRow(ctx, `UPDATE bookings SET status=$3, updated_at=now() WHERE token=$1 AND status=$2 RETURNING `+bookingColumns, token, from, to))
+	if errors.Is(err, pgx.ErrNoRows) {
+		var current domain.BookingStatus
+		if err := tx.QueryRow(ctx, `SELECT status FROM bookings WHERE token=$1`, token).Scan(&current); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, domain.ErrBookingNotFound
+			}
 			return nil, err
 		}
-		expired = append(expired, b)
+		return nil, &domain.BookingTransitionError{From: current, To: to}
 	}
-	return expired, rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	if err := recordTransition(ctx, tx, from, to, *b); err != nil {
+		return nil, err
+	}
+	if rotateToken {
+		if err := rotateTokens(ctx, tx, b.ID); err != nil {
+			return nil, err
+		}
+	}
+	return b, tx.Commit(ctx)
+}
+
+// rotateTokens gives the bookings new random tokens in tx.
+func rotateTokens(ctx context.Context, tx pgx.Tx, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `UPDATE bookings SET token=gen_random_uuid()::text, updated_at=now() WHERE id = ANY($1)`, ids)
+	return err
+}
+
+func (r *PGBookingRepository) ExpirePendingBefore(ctx context.Context, deadline time.Time) ([]domain.Booking, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `UPDATE bookings SET status=$1, updated_at=now() WHERE status=$2 AND expires_at <= $3 RETURNING `+bookingColumns, domain.BookingStatusExpired, domain.BookingStatusPending, deadline)
+	if err != nil {
+		return nil, err
+	}
+	expired, err := scanBookings(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordTransition(ctx, tx, domain.BookingStatusPending, domain.BookingStatusExpired, expired...); err != nil {
+		return nil, err
+	}
+	return expired, tx.Commit(ctx)
 }
 
+// ReleaseSeat returns a seat of the flight to sale. The booking that held it
+// is kept: its status already frees the seat number.
 func (r *PGBookingRepository) ReleaseSeat(ctx context.Context, flightID int64) error {
 	cmd, err := r.db.Exec(ctx, `
         UPDATE flights 
@@ -99,19 +238,275 @@ func (r *PGBookingRepository) ReleaseSeat(ctx context.Context, flightID int64) e
 		return err
 	}
 	if cmd.RowsAffected() == 0 {
-		return errors.New("flight not found")
+		return domain.ErrFlightNotFound
 	}
+	return nil
+}
 
-	_, err = r.db.Exec(ctx, `
-        DELETE FROM bookings 
-        WHERE flight_id = $1 
-        AND status = 'CANCELLED'
-    `, flightID)
+// ListByFlight returns the bookings of a flight in any of the given statuses, oldest first.
+func (r *PGBookingRepository) ListByFlight(ctx context.Context, flightID int64, statuses ...domain.BookingStatus) ([]domain.Booking, error) {
+	names := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		names = append(names, string(status))
+	}
+	rows, err := r.db.Query(ctx, `SELECT `+bookingColumns+` FROM bookings WHERE flight_id=$1 AND status = ANY($2) ORDER BY created_at, id`, flightID, names)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return scanBookings(rows)
+}
 
+func (r *PGBookingRepository) ListByCustomer(ctx context.Context, customerID int64, page domain.BookingPage) ([]domain.Booking, error) {
+	names := make([]string, 0, len(page.Statuses))
+	for _, status := range page.Statuses {
+		names = append(names, string(status))
+	}
+	rows, err := r.db.Query(ctx, `SELECT `+bookingColumns+` FROM bookings
+		WHERE customer_id=$1 AND (cardinality($2::text[]) = 0 OR status = ANY($2)) AND ($3::bigint = 0 OR id < $3)
+		ORDER BY id DESC LIMIT $4`, customerID, names, page.BeforeID, page.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanBookings(rows)
+}
+
+// Rebook moves a confirmed or checked-in booking from one flight to another
+// in a single transaction: a seat is taken from the target inventory, which
+// like new bookings may be oversold up to its overbooking limit, and the
+// lowest seat number no live booking holds on that flight is given to the
+// booking. The token and the fare stay the same; the taxes and fees become
+// charges, priced for the target. A checked-in booking is confirmed on the
+// target, which is recorded in its history. Returns
+// domain.ErrNoSeatsAvailable when the target is full or not bookable and
+// domain.ErrBookingNotFound when the booking is no longer a confirmed or
+// checked-in booking on fromFlightID.
+func (r *PGBookingRepository) Rebook(ctx context.Context, token string, fromFlightID, toFlightID int64, charges domain.FareCharges) (*domain.Booking, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings
+		WHERE token=$1 AND flight_id=$2 AND status IN ($3, $4)
+		FOR UPDATE`, token, fromFlightID, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBookingNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var seats int
+	err = tx.QueryRow(ctx, `UPDATE flights SET available_seats = available_seats - 1, updated_at = now()
+		WHERE id=$1 AND status IN `+bookableStatuses+` AND available_seats > -overbooking_limit
+		RETURNING total_seats + overbooking_limit`, toFlightID).Scan(&seats)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrNoSeatsAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seat, err := nextFreeSeat(ctx, tx, toFlightID, seats)
+	if err != nil {
+		return nil, err
+	}
+
+	if charges.Taxes == nil {
+		charges.Taxes = make([]domain.TaxCharge, 0)
+	}
+	b, err := scanBooking(tx.QueryRow(ctx, `UPDATE bookings SET flight_id=$2, seat_number=$3, status=$4,
+			taxes=$5, fuel_surcharge_cents=$6, booking_fee_cents=$7, seat_fee_cents=$8, updated_at=now()
+		WHERE id=$1
+		RETURNING `+bookingColumns, current.ID, toFlightID, seat, domain.BookingStatusConfirmed,
+		charges.Taxes, charges.FuelSurchargeCents, charges.BookingFeeCents, charges.SeatFeeCents))
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != b.Status {
+		if err := recordTransition(ctx, tx, current.Status, b.Status, *b); err != nil {
+			return nil, err
+		}
+	}
+	return b, tx.Commit(ctx)
+}
+
+// ExtendHold moves the expiry of a pending booking that has not expired yet
+// and counts the extension. It returns domain.ErrHoldNotExtendable when the
+// booking is no longer pending, has expired or reached maxExtensions.
+func (r *PGBookingRepository) ExtendHold(ctx context.Context, token string, expiresAt time.Time, maxExtensions int) (*domain.Booking, error) {
+	b, err := scanBooking(r.db.QueryRow(ctx, `UPDATE bookings SET expires_at=$2, hold_extensions=hold_extensions+1, updated_at=now()
+		WHERE token=$1 AND status=$3 AND expires_at > now() AND hold_extensions < $4
+		RETURNING `+bookingColumns, token, expiresAt, domain.BookingStatusPending, maxExtensions))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrHoldNotExtendable
+	}
+	return b, err
+}
+
+// ChangeSeat moves a pending or confirmed booking to another seat of its
+// flight. The unique index on the seats of live bookings decides races with
+// other bookings: domain.ErrSeatTaken is returned when the seat is held.
+func (r *PGBookingRepository) ChangeSeat(ctx context.Context, token string, seatNumber int) (*domain.Booking, error) {
+	b, err := scanBooking(r.db.QueryRow(ctx, `UPDATE bookings SET seat_number=$2, updated_at=now()
+		WHERE token=$1 AND status IN ($3, $4)
+		RETURNING `+bookingColumns, token, seatNumber, domain.BookingStatusPending, domain.BookingStatusConfirmed))
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, domain.ErrSeatTaken
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBookingNotActive
+	}
+	return b, err
+}
+
+// ChangeFlight moves a pending or confirmed booking to change.ToFlightID in one
+// transaction and records the change, also in the booking history under the
+// audit info of ctx. The booking keeps its token and pays the full new fare
+// and the taxes and fees in change.Charges: the discount of its promo code
+// was priced into the fare difference and is dropped. The miles and credit
+// held for a pending booking were reserved for the old price and are
+// released. seatNumber picks the new seat; zero takes the lowest free one.
+// The quote in change must still hold: domain.ErrFareChanged is returned
+// when either fare moved since it was computed.
+func (r *PGBookingRepository) ChangeFlight(ctx context.Context, change *domain.FlightChange, seatNumber int) (*domain.Booking, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns+` FROM bookings
+		WHERE token=$1 AND flight_id=$2 AND status IN ($3, $4)
+		FOR UPDATE`, change.Token, change.FromFlightID, domain.BookingStatusPending, domain.BookingStatusConfirmed))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrBookingNotActive
+	}
+	if err != nil {
+		return nil, err
+	}
+	if current.PriceCents != change.PreviousFareCents {
+		return nil, domain.ErrFareChanged
+	}
+
+	var seats int
+	err = tx.QueryRow(ctx, `UPDATE flights SET available_seats = available_seats - 1, updated_at = now()
+		WHERE id=$1 AND status IN `+bookableStatuses+` AND available_seats > -overbooking_limit AND price_cents=$2
+		RETURNING total_seats + overbooking_limit`, change.ToFlightID, change.NewFareCents).Scan(&seats)
+	if errors.Is(err, pgx.ErrNoRows) {
+		var price int64
+		if err := tx.QueryRow(ctx, `SELECT price_cents FROM flights WHERE id=$1`, change.ToFlightID).Scan(&price); err == nil && price != change.NewFareCents {
+			return nil, domain.ErrFareChanged
+		}
+		return nil, domain.ErrNoSeatsAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	if seatNumber <= 0 {
+		if seatNumber, err = nextFreeSeat(ctx, tx, change.ToFlightID, seats); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := tx.Exec(ctx, `UPDATE flights SET available_seats = available_seats + 1, updated_at = now() WHERE id=$1`, change.FromFlightID); err != nil {
+		return nil, err
+	}
+
+	if change.Charges.Taxes == nil {
+		change.Charges.Taxes = make([]domain.TaxCharge, 0)
+	}
+	moved, err := scanBooking(tx.QueryRow(ctx, `UPDATE bookings SET flight_id=$2, seat_number=$3, price_cents=$4, discount_cents=0, promo_code='',
+			taxes=$5, fuel_surcharge_cents=$6, booking_fee_cents=$7, seat_fee_cents=$8, updated_at=now()
+		WHERE id=$1
+		RETURNING `+bookingColumns, current.ID, change.ToFlightID, seatNumber, change.NewFareCents,
+		change.Charges.Taxes, change.Charges.FuelSurchargeCents, change.Charges.BookingFeeCents, change.Charges.SeatFeeCents))
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, domain.ErrSeatTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if current.Status == domain.BookingStatusPending {
+		if _, err := tx.Exec(ctx, `UPDATE payment_reservations SET status=$2, updated_at=now() WHERE booking_id=$1 AND status=$3`,
+			current.ID, domain.ReservationReleased, domain.ReservationHeld); err != nil {
+			return nil, err
+		}
+	}
+
+	change.BookingID = current.ID
+	change.FromSeatNumber = current.SeatNumber
+	change.ToSeatNumber = moved.SeatNumber
+	if err := tx.QueryRow(ctx, `INSERT INTO booking_changes (booking_id, from_flight_id, to_flight_id, from_seat_number, to_seat_number,
+			previous_fare_cents, new_fare_cents, fare_difference_cents, change_fee_cents)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at`, change.BookingID, change.FromFlightID, change.ToFlightID, change.FromSeatNumber, change.ToSeatNumber,
+		change.PreviousFareCents, change.NewFareCents, change.FareDifferenceCents, change.ChangeFeeCents).Scan(&change.ID, &change.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := recordTransition(ctx, tx, current.Status, moved.Status, *moved); err != nil {
+		return nil, err
+	}
+	return moved, tx.Commit(ctx)
+}
+
+// History finds the booking by its current token or by the tokens recorded
+// in its history, so the history stays whole across rotations.
+func (r *PGBookingRepository) History(ctx context.Context, token string) ([]domain.BookingTransition, error) {
+	rows, err := r.db.Query(ctx, `SELECT id, booking_id, token, COALESCE(from_status, ''), to_status, actor, reason, request_id, created_at
+		FROM booking_events
+		WHERE booking_id IN (SELECT id FROM bookings WHERE token=$1 UNION SELECT booking_id FROM booking_events WHERE token=$1)
+		ORDER BY created_at, id`, token)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]domain.BookingTransition, 0)
+	for rows.Next() {
+		var t domain.BookingTransition
+		if err := rows.Scan(&t.ID, &t.BookingID, &t.Token, &t.From, &t.To, &t.Actor, &t.Reason, &t.RequestID, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		history = append(history, t)
+	}
+	return history, rows.Err()
+}
+
+// recordTransition adds the status change of the bookings to their history
+// in tx, attributed to the audit info of ctx. from is empty for new bookings.
+func recordTransition(ctx context.Context, tx pgx.Tx, from, to domain.BookingStatus, bookings ...domain.Booking) error {
+	if len(bookings) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(bookings))
+	tokens := make([]string, 0, len(bookings))
+	for _, b := range bookings {
+		ids = append(ids, b.ID)
+		tokens = append(tokens, b.Token)
+	}
+	info := audit.FromContext(ctx)
+	_, err := tx.Exec(ctx, `INSERT INTO booking_events (booking_id, token, from_status, to_status, actor, reason, request_id)
+		SELECT id, token, NULLIF($3, ''), $4, $5, $6, $7
+		FROM unnest($1::bigint[], $2::text[]) AS b(id, token)`, ids, tokens, string(from), string(to), info.Actor, info.Reason, info.RequestID)
 	return err
 }
 
+// nextFreeSeat returns the lowest seat number of the flight that no live
+// booking holds. Cancelled, expired and refunded bookings keep their rows
+// but not their seat numbers, like the idx_bookings_flight_seat_live index.
+func nextFreeSeat(ctx context.Context, tx pgx.Tx, flightID int64, totalSeats int) (int, error) {
+	var seat int
+	err := tx.QueryRow(ctx, `SELECT s FROM generate_series(1, $2::int) s
+		WHERE NOT EXISTS (SELECT 1 FROM bookings b WHERE b.flight_id=$1 AND b.seat_number=s
+			AND b.status NOT IN ('CANCELLED', 'EXPIRED', 'REFUNDED'))
+		ORDER BY s LIMIT 1`, flightID, totalSeats).Scan(&seat)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domain.ErrNoSeatsAvailable
+	}
+	return seat, err
+}
+
 var _ BookingRepository = (*PGBookingRepository)(nil)
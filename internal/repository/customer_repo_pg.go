@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CustomerRepository interface {
+	// Create stores a new customer; the email must be normalized.
+	Create(ctx context.Context, customer *domain.Customer) error
+	GetByID(ctx context.Context, id int64) (*domain.Customer, error)
+	GetByEmail(ctx context.Context, email string) (*domain.Customer, error)
+	CreateVerification(ctx context.Context, verification *domain.EmailVerification) error
+	// VerifyEmail uses the VERIFY_EMAIL code with tokenHash, marks the email
+	// of its customer verified and claims the guest bookings made with it.
+	// It returns the customer and the number of bookings claimed.
+	VerifyEmail(ctx context.Context, tokenHash string) (*domain.Customer, int, error)
+	// ClaimBookings uses the CLAIM_BOOKINGS code with tokenHash sent to
+	// customerID and links the guest bookings made with its email to the
+	// customer. It returns the number of bookings claimed.
+	ClaimBookings(ctx context.Context, customerID int64, tokenHash string) (int, error)
+}
+
+type PGCustomerRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCustomerRepository(db *pgxpool.Pool) CustomerRepository {
+	return &PGCustomerRepository{db: db}
+}
+
+const customerColumns = `id, email, password_hash, email_verified_at, created_at, updated_at`
+
+func scanCustomer(row pgx.Row) (*domain.Customer, error) {
+	var c domain.Customer
+	var verifiedAt *time.Time
+	if err := row.Scan(&c.ID, &c.Email, &c.PasswordHash, &verifiedAt, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	c.EmailVerifiedAt = derefTime(verifiedAt)
+	return &c, nil
+}
+
+func (r *PGCustomerRepository) Create(ctx context.Context, customer *domain.Customer) error {
+	err := r.db.QueryRow(ctx, `INSERT INTO customers (email, password_hash) VALUES ($1, $2)
+		RETURNING id, created_at, updated_at`, customer.Email, customer.PasswordHash).
+		Scan(&customer.ID, &customer.CreatedAt, &customer.UpdatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrEmailTaken
+	}
+	return err
+}
+
+func (r *PGCustomerRepository) GetByID(ctx context.Context, id int64) (*domain.Customer, error) {
+	customer, err := scanCustomer(r.db.QueryRow(ctx, `SELECT `+customerColumns+` FROM customers WHERE id=$1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrCustomerNotFound
+	}
+	return customer, err
+}
+
+func (r *PGCustomerRepository) GetByEmail(ctx context.Context, email string) (*domain.Customer, error) {
+	customer, err := scanCustomer(r.db.QueryRow(ctx, `SELECT `+customerColumns+` FROM customers WHERE email=$1`, email))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrCustomerNotFound
+	}
+	return customer, err
+}
+
+func (r *PGCustomerRepository) CreateVerification(ctx context.Context, v *domain.EmailVerification) error {
+	return r.db.QueryRow(ctx, `INSERT INTO email_verifications (customer_id, email, purpose, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`, v.CustomerID, v.Email, v.Purpose, v.TokenHash, v.ExpiresAt).Scan(&v.ID, &v.CreatedAt)
+}
+
+func (r *PGCustomerRepository) VerifyEmail(ctx context.Context, tokenHash string) (*domain.Customer, int, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	v, err := useVerification(ctx, tx, tokenHash, domain.VerificationPurposeEmail)
+	if err != nil {
+		return nil, 0, err
+	}
+	customer, err := scanCustomer(tx.QueryRow(ctx, `UPDATE customers SET email_verified_at=COALESCE(email_verified_at, now()), updated_at=now()
+		WHERE id=$1 RETURNING `+customerColumns, v.CustomerID))
+	if err != nil {
+		return nil, 0, err
+	}
+	claimed, err := claimGuestBookings(ctx, tx, customer.ID, customer.Email)
+	if err != nil {
+		return nil, 0, err
+	}
+	return customer, claimed, tx.Commit(ctx)
+}
+
+func (r *PGCustomerRepository) ClaimBookings(ctx context.Context, customerID int64, tokenHash string) (int, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	v, err := useVerification(ctx, tx, tokenHash, domain.VerificationPurposeClaim)
+	if err != nil {
+		return 0, err
+	}
+	// A code sent to another customer is as good as a wrong one; the
+	// rollback leaves it unused.
+	if v.CustomerID != customerID {
+		return 0, domain.ErrVerificationInvalid
+	}
+	claimed, err := claimGuestBookings(ctx, tx, customerID, v.Email)
+	if err != nil {
+		return 0, err
+	}
+	return claimed, tx.Commit(ctx)
+}
+
+// useVerification marks the unexpired, unused code with tokenHash used.
+func useVerification(ctx context.Context, tx pgx.Tx, tokenHash string, purpose domain.VerificationPurpose) (*domain.EmailVerification, error) {
+	v := domain.EmailVerification{TokenHash: tokenHash, Purpose: purpose}
+	err := tx.QueryRow(ctx, `UPDATE email_verifications SET used_at=now()
+		WHERE token_hash=$1 AND purpose=$2 AND used_at IS NULL AND expires_at > now()
+		RETURNING id, customer_id, email, expires_at, used_at, created_at`, tokenHash, purpose).
+		Scan(&v.ID, &v.CustomerID, &v.Email, &v.ExpiresAt, &v.UsedAt, &v.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrVerificationInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// claimGuestBookings links the bookings made with email without an account
+// to the customer.
+func claimGuestBookings(ctx context.Context, tx pgx.Tx, customerID int64, email string) (int, error) {
+	cmd, err := tx.Exec(ctx, `UPDATE bookings SET customer_id=$1, updated_at=now()
+		WHERE customer_id IS NULL AND lower(email)=lower($2)`, customerID, email)
+	if err != nil {
+		return 0, err
+	}
+	return int(cmd.RowsAffected()), nil
+}
+
+var _ CustomerRepository = (*PGCustomerRepository)(nil)
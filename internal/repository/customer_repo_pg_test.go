@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCustomerRepository(t *testing.T) {
+	repo := NewCustomerRepository(&pgxpool.Pool{})
+	assert.NotNil(t, repo)
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DeniedBoardingRepository interface {
+	DenyBoarding(ctx context.Context, flightID int64, volunteerTokens []string, compensation domain.DeniedBoardingCompensation) ([]domain.DeniedBoarding, error)
+	ListByFlight(ctx context.Context, flightID int64) ([]domain.DeniedBoarding, error)
+}
+
+type PGDeniedBoardingRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDeniedBoardingRepository(db *pgxpool.Pool) DeniedBoardingRepository {
+	return &PGDeniedBoardingRepository{db: db}
+}
+
+// DenyBoarding takes as many confirmed bookings off the flight as it is
+// oversold, in one transaction. Volunteers go first, in the order given;
+// the remaining seats are taken from the most recent bookings. The bookings
+// are marked DENIED_BOARDING, the compensation of each is recorded and the
+// seats are returned to the flight inventory. Volunteer tokens that are not
+// confirmed bookings of the flight are ignored.
+func (r *PGDeniedBoardingRepository) DenyBoarding(ctx context.Context, flightID int64, volunteerTokens []string, compensation domain.DeniedBoardingCompensation) ([]domain.DeniedBoarding, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var status domain.FlightStatus
+	var available int
+	err = tx.QueryRow(ctx, `SELECT status, available_seats FROM flights WHERE id=$1 FOR UPDATE`, flightID).Scan(&status, &available)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrFlightNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case domain.FlightStatusCancelled:
+		return nil, domain.ErrFlightCancelled
+	case domain.FlightStatusDeparted, domain.FlightStatusDiverted, domain.FlightStatusArrived:
+		return nil, fmt.Errorf("%w: flight is %s", domain.ErrInvalidStatusTransition, status)
+	}
+	if available >= 0 {
+		return nil, domain.ErrFlightNotOversold
+	}
+
+	if volunteerTokens == nil {
+		volunteerTokens = []string{}
+	}
+	rows, err := tx.Query(ctx, `SELECT id, seat_number, token, email, array_position($2::text[], token) IS NOT NULL
+		FROM bookings
+		WHERE flight_id=$1 AND status=$3
+		ORDER BY array_position($2::text[], token) NULLS LAST, created_at DESC, id DESC
+		LIMIT $4
+		FOR UPDATE`, flightID, volunteerTokens, domain.BookingStatusConfirmed, -available)
+	if err != nil {
+		return nil, err
+	}
+	var denied []domain.DeniedBoarding
+	for rows.Next() {
+		var b domain.Booking
+		var volunteer bool
+		if err := rows.Scan(&b.ID, &b.SeatNumber, &b.Token, &b.Email, &volunteer); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		kind := domain.DeniedBoardingInvoluntary
+		if volunteer {
+			kind = domain.DeniedBoardingVoluntary
+		}
+		denied = append(denied, domain.DeniedBoarding{
+			FlightID:          flightID,
+			BookingID:         b.ID,
+			Token:             b.Token,
+			Email:             b.Email,
+			SeatNumber:        b.SeatNumber,
+			Kind:              kind,
+			CompensationCents: compensation.For(kind),
+		})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	offloaded := make([]domain.Booking, 0, len(denied))
+	for i := range denied {
+		d := &denied[i]
+		if _, err := tx.Exec(ctx, `UPDATE bookings SET status=$2, updated_at=now() WHERE id=$1`, d.BookingID, domain.BookingStatusDeniedBoarding); err != nil {
+			return nil, err
+		}
+		offloaded = append(offloaded, domain.Booking{ID: d.BookingID, Token: d.Token})
+		if err := tx.QueryRow(ctx, `INSERT INTO denied_boardings (flight_id, booking_id, kind, compensation_cents)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id, created_at`, d.FlightID, d.BookingID, d.Kind, d.CompensationCents).Scan(&d.ID, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+	if err := recordTransition(ctx, tx, domain.BookingStatusConfirmed, domain.BookingStatusDeniedBoarding, offloaded...); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE flights SET available_seats = available_seats + $2, updated_at = now() WHERE id=$1`, flightID, len(denied)); err != nil {
+		return nil, err
+	}
+	return denied, tx.Commit(ctx)
+}
+
+// ListByFlight returns the denied boardings recorded for a flight, oldest first.
+func (r *PGDeniedBoardingRepository) ListByFlight(ctx context.Context, flightID int64) ([]domain.DeniedBoarding, error) {
+	rows, err := r.db.Query(ctx, `SELECT d.id, d.flight_id, d.booking_id, b.token, b.email, b.seat_number, d.kind, d.compensation_cents, d.created_at
+		FROM denied_boardings d
+		JOIN bookings b ON b.id = d.booking_id
+		WHERE d.flight_id=$1
+		ORDER BY d.created_at, d.id`, flightID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	denied := make([]domain.DeniedBoarding, 0)
+	for rows.Next() {
+		var d domain.DeniedBoarding
+		if err := rows.Scan(&d.ID, &d.FlightID, &d.BookingID, &d.Token, &d.Email, &d.SeatNumber, &d.Kind, &d.CompensationCents, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+		denied = append(denied, d)
+	}
+	return denied, rows.Err()
+}
+
+var _ DeniedBoardingRepository = (*PGDeniedBoardingRepository)(nil)
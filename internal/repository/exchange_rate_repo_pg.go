@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ExchangeRateRepository interface {
+	// List returns the exchange rates ordered by pair.
+	List(ctx context.Context) ([]domain.ExchangeRate, error)
+	// Upsert sets the rates of the given pairs in one transaction; other
+	// pairs keep their rates.
+	Upsert(ctx context.Context, rates []domain.ExchangeRate) error
+}
+
+type PGExchangeRateRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewExchangeRateRepository(db *pgxpool.Pool) ExchangeRateRepository {
+	return &PGExchangeRateRepository{db: db}
+}
+
+func scanExchangeRate(row pgx.Row) (*domain.ExchangeRate, error) {
+	var r domain.ExchangeRate
+	var rate string
+	if err := row.Scan(&r.Base, &r.Quote, &rate, &r.UpdatedAt); err != nil {
+		return nil, err
+	}
+	parsed, err := domain.ParseRate(rate)
+	if err != nil {
+		return nil, err
+	}
+	r.Rate = parsed
+	return &r, nil
+}
+
+func (r *PGExchangeRateRepository) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	rows, err := r.db.Query(ctx, `SELECT base_currency, quote_currency, rate::text, updated_at FROM exchange_rates ORDER BY base_currency, quote_currency`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := make([]domain.ExchangeRate, 0)
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, *rate)
+	}
+	return rates, rows.Err()
+}
+
+func (r *PGExchangeRateRepository) Upsert(ctx context.Context, rates []domain.ExchangeRate) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for i := range rates {
+		rate := &rates[i]
+		if err := tx.QueryRow(ctx, `INSERT INTO exchange_rates (base_currency, quote_currency, rate)
+			VALUES ($1, $2, $3::numeric)
+			ON CONFLICT (base_currency, quote_currency) DO UPDATE SET rate = EXCLUDED.rate, updated_at = now()
+			RETURNING updated_at`, rate.Base, rate.Quote, domain.FormatRate(rate.Rate)).Scan(&rate.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+var _ ExchangeRateRepository = (*PGExchangeRateRepository)(nil)
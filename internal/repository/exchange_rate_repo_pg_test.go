@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewExchangeRateRepository(t *testing.T) {
+	repo := NewExchangeRateRepository(&pgxpool.Pool{})
+	assert.NotNil(t, repo)
+}
@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -13,6 +16,14 @@ type FlightRepository interface {
 	GetByID(ctx context.Context, id int64) (*domain.Flight, error)
 	ReserveSeat(ctx context.Context, flightID int64) error
 	ReleaseSeat(ctx context.Context, flightID int64) error
+	Create(ctx context.Context, flight *domain.Flight) (*domain.Flight, error)
+	Update(ctx context.Context, flight *domain.Flight) (*domain.Flight, error)
+	Delete(ctx context.Context, id int64) error
+	AirportExists(ctx context.Context, code string) (bool, error)
+	Cancel(ctx context.Context, id int64) (*domain.Flight, []domain.Booking, error)
+	FindAlternatives(ctx context.Context, flight *domain.Flight, window time.Duration, limit int) ([]domain.Flight, error)
+	UpdateStatus(ctx context.Context, flight *domain.Flight, previousStatus domain.FlightStatus, previousUpdate time.Time) (*domain.Flight, error)
+	ListOversold(ctx context.Context, departingBefore time.Time) ([]domain.Flight, error)
 }
 
 type PGFlightRepository struct {
@@ -23,8 +34,46 @@ func NewFlightRepository(db *pgxpool.Pool) FlightRepository {
 	return &PGFlightRepository{db: db}
 }
 
+const flightSelect = `SELECT f.id, COALESCE(f.flight_number, ''), COALESCE(f.schedule_id, 0), f.from_airport, f.to_airport, f.departure_time, f.arrival_time, dep.timezone, arr.timezone, f.total_seats, f.available_seats, f.price_cents, f.overbooking_limit, f.status, f.created_at, f.updated_at,
+		f.estimated_departure_time, f.actual_departure_time, f.actual_arrival_time, COALESCE(f.gate, ''), COALESCE(f.terminal, ''), COALESCE(f.diverted_to, ''), f.status_updated_at,
+		COALESCE(dep.country, ''), COALESCE(arr.country, ''), f.currency
+	FROM flights f
+	JOIN airports dep ON dep.code = f.from_airport
+	JOIN airports arr ON arr.code = f.to_airport`
+
+// bookableStatuses matches domain.FlightStatus.Bookable.
+const bookableStatuses = `('SCHEDULED', 'DELAYED')`
+
+func scanFlight(row pgx.Row) (*domain.Flight, error) {
+	var f domain.Flight
+	var estimated, departed, arrived, statusUpdated *time.Time
+	if err := row.Scan(&f.ID, &f.FlightNumber, &f.ScheduleID, &f.FromAirport, &f.ToAirport, &f.DepartureTime, &f.ArrivalTime, &f.DepartureTimeZone, &f.ArrivalTimeZone, &f.TotalSeats, &f.AvailableSeats, &f.PriceCents, &f.OverbookingLimit, &f.Status, &f.CreatedAt, &f.UpdatedAt,
+		&estimated, &departed, &arrived, &f.Gate, &f.Terminal, &f.DivertedTo, &statusUpdated, &f.DepartureCountry, &f.ArrivalCountry, &f.Currency); err != nil {
+		return nil, err
+	}
+	f.EstimatedDeparture = derefTime(estimated)
+	f.ActualDeparture = derefTime(departed)
+	f.ActualArrival = derefTime(arrived)
+	f.StatusUpdatedAt = derefTime(statusUpdated)
+	return &f, nil
+}
+
+func derefTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
 func (r *PGFlightRepository) List(ctx context.Context) ([]domain.Flight, error) {
-	rows, err := r.db.Query(ctx, `SELECT id, from_airport, to_airport, departure_time, arrival_time, total_seats, available_seats, price_cents, created_at, updated_at FROM flights ORDER BY departure_time`)
+	rows, err := r.db.Query(ctx, flightSelect+` ORDER BY f.departure_time`)
 	if err != nil {
 		return nil, err
 	}
@@ -32,31 +81,30 @@ func (r *PGFlightRepository) List(ctx context.Context) ([]domain.Flight, error)
 
 	flights := make([]domain.Flight, 0)
 	for rows.Next() {
-		var f domain.Flight
-		if err := rows.Scan(&f.ID, &f.FromAirport, &f.ToAirport, &f.DepartureTime, &f.ArrivalTime, &f.TotalSeats, &f.AvailableSeats, &f.PriceCents, &f.CreatedAt, &f.UpdatedAt); err != nil {
+		f, err := scanFlight(rows)
+		if err != nil {
 			return nil, err
 		}
-		flights = append(flights, f)
+		flights = append(flights, *f)
 	}
 	return flights, rows.Err()
 }
 
 func (r *PGFlightRepository) GetByID(ctx context.Context, id int64) (*domain.Flight, error) {
-	row := r.db.QueryRow(ctx, `SELECT id, from_airport, to_airport, departure_time, arrival_time, total_seats, available_seats, price_cents, created_at, updated_at FROM flights WHERE id=$1`, id)
-	var f domain.Flight
-	if err := row.Scan(&f.ID, &f.FromAirport, &f.ToAirport, &f.DepartureTime, &f.ArrivalTime, &f.TotalSeats, &f.AvailableSeats, &f.PriceCents, &f.CreatedAt, &f.UpdatedAt); err != nil {
-		return nil, err
+	flight, err := scanFlight(r.db.QueryRow(ctx, flightSelect+` WHERE f.id=$1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrFlightNotFound
 	}
-	return &f, nil
+	return flight, err
 }
 
 func (r *PGFlightRepository) ReserveSeat(ctx context.Context, flightID int64) error {
-	res, err := r.db.Exec(ctx, `UPDATE flights SET available_seats = available_seats - 1, updated_at = now() WHERE id=$1 AND available_seats > 0`, flightID)
+	res, err := r.db.Exec(ctx, `UPDATE flights SET available_seats = available_seats - 1, updated_at = now() WHERE id=$1 AND available_seats > -overbooking_limit`, flightID)
 	if err != nil {
 		return err
 	}
 	if res.RowsAffected() == 0 {
-		return errors.New("no available seats")
+		return domain.ErrNoSeatsAvailable
 	}
 	return nil
 }
@@ -66,4 +114,231 @@ func (r *PGFlightRepository) ReleaseSeat(ctx context.Context, flightID int64) er
 	return err
 }
 
+func (r *PGFlightRepository) Create(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	var id int64
+	if err := r.db.QueryRow(ctx, `INSERT INTO flights (flight_number, from_airport, to_airport, departure_time, arrival_time, total_seats, available_seats, price_cents, overbooking_limit, currency)
+		VALUES (NULLIF($1, ''), $2, $3, $4, $5, $6, $6, $7, $8, $9)
+		RETURNING id`, flight.FlightNumber, flight.FromAirport, flight.ToAirport, flight.DepartureTime, flight.ArrivalTime, flight.TotalSeats, flight.PriceCents, flight.OverbookingLimit, flight.Currency).
+		Scan(&id); err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+// Update rewrites the schedule and inventory of a flight. available_seats is
+// recalculated from the active bookings, which are counted under a row lock;
+// it is negative when the flight stays oversold.
+func (r *PGFlightRepository) Update(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	booked, err := lockAndCountActiveBookings(ctx, tx, flight.ID)
+	if err != nil {
+		return nil, err
+	}
+	if flight.TotalSeats+flight.OverbookingLimit < booked {
+		return nil, domain.ErrTotalSeatsBelowBooked
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE flights
+		SET from_airport=$2, to_airport=$3, departure_time=$4, arrival_time=$5, total_seats=$6, available_seats=$7, price_cents=$8, overbooking_limit=$9, currency=$10, updated_at=now()
+		WHERE id=$1`, flight.ID, flight.FromAirport, flight.ToAirport, flight.DepartureTime, flight.ArrivalTime, flight.TotalSeats, flight.TotalSeats-booked, flight.PriceCents, flight.OverbookingLimit, flight.Currency); err != nil {
+		return nil, err
+	}
+
+	updated, err := scanFlight(tx.QueryRow(ctx, flightSelect+` WHERE f.id=$1`, flight.ID))
+	if err != nil {
+		return nil, err
+	}
+	return updated, tx.Commit(ctx)
+}
+
+// Delete removes a flight that was never booked. Bookings in any status,
+// including cancelled, expired and flown ones, are kept for accrual, refunds
+// and audit, so a flight with bookings can only be cancelled; bookings
+// reference flights with ON DELETE RESTRICT.
+func (r *PGFlightRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockAndCountActiveBookings(ctx, tx, id); err != nil {
+		return err
+	}
+	var booked bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM bookings WHERE flight_id=$1)`, id).Scan(&booked); err != nil {
+		return err
+	}
+	if booked {
+		return domain.ErrFlightHasBookings
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM flights WHERE id=$1`, id); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// Cancel marks the flight cancelled and closes its inventory. Pending bookings
+// are cancelled, their tokens rotated and the miles and credit they held
+// released in the same transaction; they are returned with the tokens they
+// had. Confirmed and checked-in bookings stay on the flight until they are
+// rebooked. Cancelling a cancelled flight is a no-op.
+func (r *PGFlightRepository) Cancel(ctx context.Context, id int64) (*domain.Flight, []domain.Booking, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockAndCountActiveBookings(ctx, tx, id); err != nil {
+		return nil, nil, err
+	}
+	var current domain.FlightStatus
+	if err := tx.QueryRow(ctx, `SELECT status FROM flights WHERE id=$1`, id).Scan(&current); err != nil {
+		return nil, nil, err
+	}
+	switch current {
+	case domain.FlightStatusDeparted, domain.FlightStatusDiverted, domain.FlightStatusArrived:
+		return nil, nil, fmt.Errorf("%w: flight is %s", domain.ErrInvalidStatusTransition, current)
+	}
+	// Bumping status_updated_at makes status reports read before the
+	// cancellation stale.
+	if _, err := tx.Exec(ctx, `UPDATE flights SET status=$2, available_seats=0, status_updated_at=now(), updated_at=now() WHERE id=$1 AND status<>$2`, id, domain.FlightStatusCancelled); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := tx.Query(ctx, `UPDATE bookings SET status=$3, updated_at=now() WHERE flight_id=$1 AND status=$2
+		RETURNING `+bookingColumns, id, domain.BookingStatusPending, domain.BookingStatusCancelled)
+	if err != nil {
+		return nil, nil, err
+	}
+	cancelled, err := scanBookings(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := recordTransition(ctx, tx, domain.BookingStatusPending, domain.BookingStatusCancelled, cancelled...); err != nil {
+		return nil, nil, err
+	}
+	ids := make([]int64, 0, len(cancelled))
+	for _, b := range cancelled {
+		ids = append(ids, b.ID)
+	}
+	if err := rotateTokens(ctx, tx, ids...); err != nil {
+		return nil, nil, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE payment_reservations SET status=$2, updated_at=now() WHERE booking_id = ANY($1) AND status=$3`,
+		ids, domain.ReservationReleased, domain.ReservationHeld); err != nil {
+		return nil, nil, err
+	}
+
+	flight, err := scanFlight(tx.QueryRow(ctx, flightSelect+` WHERE f.id=$1`, id))
+	if err != nil {
+		return nil, nil, err
+	}
+	return flight, cancelled, tx.Commit(ctx)
+}
+
+// FindAlternatives returns scheduled flights on the same route that still have
+// seats to sell, counting the overbooking limit, and depart in the future within window of the given flight,
+// closest departure first.
+func (r *PGFlightRepository) FindAlternatives(ctx context.Context, flight *domain.Flight, window time.Duration, limit int) ([]domain.Flight, error) {
+	rows, err := r.db.Query(ctx, flightSelect+`
+		WHERE f.from_airport=$1 AND f.to_airport=$2 AND f.id<>$3
+		  AND f.status IN `+bookableStatuses+` AND f.available_seats > -f.overbooking_limit
+		  AND f.departure_time > now()
+		  AND f.departure_time BETWEEN $4 AND $5
+		ORDER BY abs(extract(epoch FROM f.departure_time - $6::timestamptz)), f.departure_time
+		LIMIT $7`,
+		flight.FromAirport, flight.ToAirport, flight.ID,
+		flight.DepartureTime.Add(-window), flight.DepartureTime.Add(window), flight.DepartureTime, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alternatives := make([]domain.Flight, 0)
+	for rows.Next() {
+		f, err := scanFlight(rows)
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, *f)
+	}
+	return alternatives, rows.Err()
+}
+
+// ListOversold returns the flights that have sold more seats than they have
+// and have not departed yet, closest departure first. Cancelled flights are
+// skipped: their passengers are rebooked instead.
+func (r *PGFlightRepository) ListOversold(ctx context.Context, departingBefore time.Time) ([]domain.Flight, error) {
+	rows, err := r.db.Query(ctx, flightSelect+`
+		WHERE f.available_seats < 0
+		  AND f.status IN ('SCHEDULED', 'DELAYED', 'BOARDING')
+		  AND f.departure_time > now() AND f.departure_time <= $1
+		ORDER BY f.departure_time, f.id`, departingBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	flights := make([]domain.Flight, 0)
+	for rows.Next() {
+		f, err := scanFlight(rows)
+		if err != nil {
+			return nil, err
+		}
+		flights = append(flights, *f)
+	}
+	return flights, rows.Err()
+}
+
+func (r *PGFlightRepository) AirportExists(ctx context.Context, code string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM airports WHERE code=$1)`, code).Scan(&exists)
+	return exists, err
+}
+
+// UpdateStatus stores the operational state of a flight. previousStatus and
+// previousUpdate are the Status and StatusUpdatedAt the caller read; if
+// another report was stored or the flight was cancelled in between,
+// domain.ErrStaleStatusUpdate is returned and nothing is written.
+func (r *PGFlightRepository) UpdateStatus(ctx context.Context, flight *domain.Flight, previousStatus domain.FlightStatus, previousUpdate time.Time) (*domain.Flight, error) {
+	cmd, err := r.db.Exec(ctx, `UPDATE flights
+		SET status=$2, estimated_departure_time=$3, actual_departure_time=$4, actual_arrival_time=$5,
+		    gate=NULLIF($6, ''), terminal=NULLIF($7, ''), diverted_to=NULLIF($8, ''), status_updated_at=$9, updated_at=now()
+		WHERE id=$1 AND status=$11 AND status_updated_at IS NOT DISTINCT FROM $10`,
+		flight.ID, flight.Status, nullTime(flight.EstimatedDeparture), nullTime(flight.ActualDeparture), nullTime(flight.ActualArrival),
+		flight.Gate, flight.Terminal, flight.DivertedTo, nullTime(flight.StatusUpdatedAt), nullTime(previousUpdate), previousStatus)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.RowsAffected() == 0 {
+		return nil, domain.ErrStaleStatusUpdate
+	}
+	return r.GetByID(ctx, flight.ID)
+}
+
+func lockAndCountActiveBookings(ctx context.Context, tx pgx.Tx, flightID int64) (int, error) {
+	var locked int64
+	if err := tx.QueryRow(ctx, `SELECT id FROM flights WHERE id=$1 FOR UPDATE`, flightID).Scan(&locked); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrFlightNotFound
+		}
+		return 0, err
+	}
+
+	var booked int
+	if err := tx.QueryRow(ctx, `SELECT count(*) FROM bookings WHERE flight_id=$1 AND status IN ($2, $3, $4)`,
+		flightID, domain.BookingStatusPending, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn).Scan(&booked); err != nil {
+		return 0, err
+	}
+	return booked, nil
+}
+
 var _ FlightRepository = (*PGFlightRepository)(nil)
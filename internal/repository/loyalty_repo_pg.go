@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LoyaltyRepository interface {
+	CreateMember(ctx context.Context, member *domain.LoyaltyMember) error
+	GetMemberByCustomer(ctx context.Context, customerID int64) (*domain.LoyaltyMember, error)
+	// Balance returns the miles balance of the member and the qualifying
+	// miles it earned since qualifyingSince.
+	Balance(ctx context.Context, memberID int64, qualifyingSince time.Time) (balance, qualifying int64, err error)
+	// ListEntries returns a page of the ledger of the member, newest first.
+	ListEntries(ctx context.Context, memberID int64, page domain.LedgerPage) ([]domain.LoyaltyLedgerEntry, error)
+	// Accrue adds the accrual entry of a booking. It reports false, and adds
+	// nothing, when the booking has already accrued.
+	Accrue(ctx context.Context, entry *domain.LoyaltyLedgerEntry) (bool, error)
+	SetTier(ctx context.Context, memberID int64, tier domain.LoyaltyTier, expiresAt time.Time) (*domain.LoyaltyMember, error)
+	// AirportLocation returns the coordinates of the airport with code.
+	AirportLocation(ctx context.Context, code string) (domain.GeoPoint, error)
+}
+
+type PGLoyaltyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLoyaltyRepository(db *pgxpool.Pool) LoyaltyRepository {
+	return &PGLoyaltyRepository{db: db}
+}
+
+const loyaltyMemberColumns = `id, customer_id, tier, tier_expires_at, created_at, updated_at`
+
+func scanLoyaltyMember(row pgx.Row) (*domain.LoyaltyMember, error) {
+	var m domain.LoyaltyMember
+	var expiresAt *time.Time
+	if err := row.Scan(&m.ID, &m.CustomerID, &m.Tier, &expiresAt, &m.CreatedAt, &m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	m.TierExpiresAt = derefTime(expiresAt)
+	return &m, nil
+}
+
+func (r *PGLoyaltyRepository) CreateMember(ctx context.Context, member *domain.LoyaltyMember) error {
+	err := r.db.QueryRow(ctx, `INSERT INTO loyalty_members (customer_id) VALUES ($1)
+		RETURNING id, tier, created_at, updated_at`, member.CustomerID).
+		Scan(&member.ID, &member.Tier, &member.CreatedAt, &member.UpdatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrAlreadyLoyaltyMember
+	}
+	return err
+}
+
+func (r *PGLoyaltyRepository) GetMemberByCustomer(ctx context.Context, customerID int64) (*domain.LoyaltyMember, error) {
+	member, err := scanLoyaltyMember(r.db.QueryRow(ctx, `SELECT `+loyaltyMemberColumns+` FROM loyalty_members WHERE customer_id=$1`, customerID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrLoyaltyMemberNotFound
+	}
+	return member, err
+}
+
+func (r *PGLoyaltyRepository) Balance(ctx context.Context, memberID int64, qualifyingSince time.Time) (int64, int64, error) {
+	var balance, qualifying int64
+	err := r.db.QueryRow(ctx, `SELECT COALESCE(SUM(miles), 0), COALESCE(SUM(qualifying_miles) FILTER (WHERE created_at >= $2), 0)
+		FROM loyalty_ledger WHERE member_id=$1`, memberID, qualifyingSince).Scan(&balance, &qualifying)
+	return balance, qualifying, err
+}
+
+func (r *PGLoyaltyRepository) ListEntries(ctx context.Context, memberID int64, page domain.LedgerPage) ([]domain.LoyaltyLedgerEntry, error) {
+	rows, err := r.db.Query(ctx, `SELECT id, member_id, kind, miles, qualifying_miles, COALESCE(booking_id, 0), description, created_at
+		FROM loyalty_ledger
+		WHERE member_id=$1 AND ($2::bigint = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3`, memberID, page.BeforeID, page.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.LoyaltyLedgerEntry, 0)
+	for rows.Next() {
+		var e domain.LoyaltyLedgerEntry
+		if err := rows.Scan(&e.ID, &e.MemberID, &e.Kind, &e.Miles, &e.QualifyingMiles, &e.BookingID, &e.Description, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (r *PGLoyaltyRepository) Accrue(ctx context.Context, e *domain.LoyaltyLedgerEntry) (bool, error) {
+	err := r.db.QueryRow(ctx, `INSERT INTO loyalty_ledger (member_id, kind, miles, qualifying_miles, booking_id, description)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (booking_id) WHERE kind = 'ACCRUAL' DO NOTHING
+		RETURNING id, created_at`, e.MemberID, domain.LedgerEntryAccrual, e.Miles, e.QualifyingMiles, e.BookingID, e.Description).
+		Scan(&e.ID, &e.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	e.Kind = domain.LedgerEntryAccrual
+	return true, nil
+}
+
+func (r *PGLoyaltyRepository) SetTier(ctx context.Context, memberID int64, tier domain.LoyaltyTier, expiresAt time.Time) (*domain.LoyaltyMember, error) {
+	var expires *time.Time
+	if !expiresAt.IsZero() {
+		expires = &expiresAt
+	}
+	member, err := scanLoyaltyMember(r.db.QueryRow(ctx, `UPDATE loyalty_members SET tier=$2, tier_expires_at=$3, updated_at=now()
+		WHERE id=$1 RETURNING `+loyaltyMemberColumns, memberID, tier, expires))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrLoyaltyMemberNotFound
+	}
+	return member, err
+}
+
+func (r *PGLoyaltyRepository) AirportLocation(ctx context.Context, code string) (domain.GeoPoint, error) {
+	var lat, lon *float64
+	err := r.db.QueryRow(ctx, `SELECT latitude, longitude FROM airports WHERE code=$1`, code).Scan(&lat, &lon)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.GeoPoint{}, domain.ErrAirportNotFound
+	}
+	if err != nil {
+		return domain.GeoPoint{}, err
+	}
+	if lat == nil || lon == nil {
+		return domain.GeoPoint{}, domain.ErrAirportLocationUnknown
+	}
+	return domain.GeoPoint{Latitude: *lat, Longitude: *lon}, nil
+}
+
+var _ LoyaltyRepository = (*PGLoyaltyRepository)(nil)
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLoyaltyRepository(t *testing.T) {
+	repo := NewLoyaltyRepository(&pgxpool.Pool{})
+	assert.NotNil(t, repo)
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PromotionRepository interface {
+	// Create stores a promotion. It fails with domain.ErrPromoCodeTaken when
+	// another promotion has the code.
+	Create(ctx context.Context, promotion *domain.Promotion) error
+	// Update replaces the terms of a promotion. Bookings already made keep
+	// the discount they were given.
+	Update(ctx context.Context, promotion *domain.Promotion) error
+	GetByID(ctx context.Context, id int64) (*domain.Promotion, error)
+	GetByCode(ctx context.Context, code string) (*domain.Promotion, error)
+	// List returns the promotions, newest first.
+	List(ctx context.Context) ([]domain.Promotion, error)
+	// Usage reports the bookings made with a promotion.
+	Usage(ctx context.Context, id int64) (*domain.PromotionUsage, error)
+}
+
+type PGPromotionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPromotionRepository(db *pgxpool.Pool) PromotionRepository {
+	return &PGPromotionRepository{db: db}
+}
+
+const promotionColumns = `id, code, description, discount_type, percent_off, amount_off_cents, currency, valid_from, valid_until,
+	from_airport, to_airport, travel_from, travel_until, max_uses, max_uses_per_customer, active, created_at, updated_at`
+
+// promotionUseHeld is true for the redemptions of bookings holding a use of
+// their promotion: expired and cancelled bookings give it back.
+const promotionUseHeld = `b.status NOT IN ('EXPIRED', 'CANCELLED', 'REFUNDED')`
+
+// promotionCustomer identifies who made a redemption: the customer account,
+// or the normalized email of a guest.
+const promotionCustomer = `COALESCE('customer:' || r.customer_id, 'email:' || lower(btrim(r.email)))`
+
+func scanPromotion(row pgx.Row) (*domain.Promotion, error) {
+	var p domain.Promotion
+	var validFrom, validUntil, travelFrom, travelUntil *time.Time
+	if err := row.Scan(&p.ID, &p.Code, &p.Description, &p.Type, &p.PercentOff, &p.AmountOffCents, &p.Currency, &validFrom, &validUntil,
+		&p.FromAirport, &p.ToAirport, &travelFrom, &travelUntil, &p.MaxUses, &p.MaxUsesPerCustomer, &p.Active, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		return nil, err
+	}
+	p.ValidFrom = derefTime(validFrom)
+	p.ValidUntil = derefTime(validUntil)
+	p.TravelFrom = derefTime(travelFrom)
+	p.TravelUntil = derefTime(travelUntil)
+	return &p, nil
+}
+
+func (r *PGPromotionRepository) Create(ctx context.Context, p *domain.Promotion) error {
+	err := r.db.QueryRow(ctx, `INSERT INTO promotions (code, description, discount_type, percent_off, amount_off_cents, valid_from, valid_until,
+			from_airport, to_airport, travel_from, travel_until, max_uses, max_uses_per_customer, active, currency)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id, created_at, updated_at`,
+		p.Code, p.Description, p.Type, p.PercentOff, p.AmountOffCents, nullTime(p.ValidFrom), nullTime(p.ValidUntil),
+		p.FromAirport, p.ToAirport, nullTime(p.TravelFrom), nullTime(p.TravelUntil), p.MaxUses, p.MaxUsesPerCustomer, p.Active, p.Currency).
+		Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrPromoCodeTaken
+	}
+	return err
+}
+
+func (r *PGPromotionRepository) Update(ctx context.Context, p *domain.Promotion) error {
+	err := r.db.QueryRow(ctx, `UPDATE promotions SET code=$2, description=$3, discount_type=$4, percent_off=$5, amount_off_cents=$6,
+			valid_from=$7, valid_until=$8, from_airport=$9, to_airport=$10, travel_from=$11, travel_until=$12,
+			max_uses=$13, max_uses_per_customer=$14, active=$15, currency=$16, updated_at=now()
+		WHERE id=$1
+		RETURNING created_at, updated_at`,
+		p.ID, p.Code, p.Description, p.Type, p.PercentOff, p.AmountOffCents, nullTime(p.ValidFrom), nullTime(p.ValidUntil),
+		p.FromAirport, p.ToAirport, nullTime(p.TravelFrom), nullTime(p.TravelUntil), p.MaxUses, p.MaxUsesPerCustomer, p.Active, p.Currency).
+		Scan(&p.CreatedAt, &p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrPromotionNotFound
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrPromoCodeTaken
+	}
+	return err
+}
+
+func (r *PGPromotionRepository) GetByID(ctx context.Context, id int64) (*domain.Promotion, error) {
+	p, err := scanPromotion(r.db.QueryRow(ctx, `SELECT `+promotionColumns+` FROM promotions WHERE id=$1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPromotionNotFound
+	}
+	return p, err
+}
+
+func (r *PGPromotionRepository) GetByCode(ctx context.Context, code string) (*domain.Promotion, error) {
+	p, err := scanPromotion(r.db.QueryRow(ctx, `SELECT `+promotionColumns+` FROM promotions WHERE code=$1`, domain.NormalizePromoCode(code)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPromotionNotFound
+	}
+	return p, err
+}
+
+func (r *PGPromotionRepository) List(ctx context.Context) ([]domain.Promotion, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+promotionColumns+` FROM promotions ORDER BY id DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	promotions := make([]domain.Promotion, 0)
+	for rows.Next() {
+		p, err := scanPromotion(rows)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, *p)
+	}
+	return promotions, rows.Err()
+}
+
+func (r *PGPromotionRepository) Usage(ctx context.Context, id int64) (*domain.PromotionUsage, error) {
+	p, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	usage := &domain.PromotionUsage{Promotion: *p, ByStatus: make(map[domain.BookingStatus]int)}
+	if err := r.db.QueryRow(ctx, `SELECT count(DISTINCT `+promotionCustomer+`),
+			count(*) FILTER (WHERE `+promotionUseHeld+`),
+			COALESCE(SUM(r.discount_cents) FILTER (WHERE `+promotionUseHeld+`), 0)
+		FROM promotion_redemptions r JOIN bookings b ON b.id = r.booking_id
+		WHERE r.promotion_id=$1`, id).Scan(&usage.Customers, &usage.Uses, &usage.DiscountCents); err != nil {
+		return nil, err
+	}
+
+	// Bookings deleted before they were kept in every status left
+	// redemptions without a booking; they were cancelled.
+	rows, err := r.db.Query(ctx, `SELECT COALESCE(b.status, $2), count(*)
+		FROM promotion_redemptions r LEFT JOIN bookings b ON b.id = r.booking_id
+		WHERE r.promotion_id=$1
+		GROUP BY 1`, id, domain.BookingStatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var status domain.BookingStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		usage.ByStatus[status] = count
+		usage.Redemptions += count
+	}
+	return usage, rows.Err()
+}
+
+// applyPromotion checks the promotion with the promo code of booking in tx
+// against the flight of the booking: it must still be valid for the flight,
+// give the discount the booking was priced with and be within its usage
+// limits. The promotion is locked until tx ends so that concurrent bookings
+// cannot go over the limits. Uses per customer are counted by customer
+// account, or by normalized email for guests. It returns the id of the
+// promotion.
+func applyPromotion(ctx context.Context, tx pgx.Tx, booking *domain.Booking) (int64, error) {
+	p, err := scanPromotion(tx.QueryRow(ctx, `SELECT `+promotionColumns+` FROM promotions WHERE code=$1 FOR UPDATE`, booking.PromoCode))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domain.ErrPromoCodeNotValid
+	}
+	if err != nil {
+		return 0, err
+	}
+	flight := domain.Flight{ID: booking.FlightID}
+	if err := tx.QueryRow(ctx, `SELECT from_airport, to_airport, departure_time, price_cents, currency FROM flights WHERE id=$1`, booking.FlightID).
+		Scan(&flight.FromAirport, &flight.ToAirport, &flight.DepartureTime, &flight.PriceCents, &flight.Currency); err != nil {
+		return 0, err
+	}
+	discount, err := p.Apply(flight, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if p.MaxUses > 0 || p.MaxUsesPerCustomer > 0 {
+		var uses, customerUses int
+		if err := tx.QueryRow(ctx, `SELECT count(*),
+				count(*) FILTER (WHERE CASE WHEN $3::bigint <> 0 THEN r.customer_id = $3
+					ELSE lower(btrim(r.email)) = lower(btrim($2)) END)
+			FROM promotion_redemptions r JOIN bookings b ON b.id = r.booking_id AND `+promotionUseHeld+`
+			WHERE r.promotion_id=$1`, p.ID, booking.Email, booking.CustomerID).Scan(&uses, &customerUses); err != nil {
+			return 0, err
+		}
+		if p.MaxUses > 0 && uses >= p.MaxUses {
+			return 0, domain.ErrPromoCodeExhausted
+		}
+		if p.MaxUsesPerCustomer > 0 && customerUses >= p.MaxUsesPerCustomer {
+			return 0, domain.ErrPromoCodeLimitReached
+		}
+	}
+	if discount != booking.DiscountCents {
+		return 0, domain.ErrPriceChanged
+	}
+	return p.ID, nil
+}
+
+var _ PromotionRepository = (*PGPromotionRepository)(nil)
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ScheduleSyncResult counts the flights rows touched while materializing a schedule.
+type ScheduleSyncResult struct {
+	Created int
+	Updated int
+	Removed int
+}
+
+type ScheduleRepository interface {
+	List(ctx context.Context) ([]domain.Schedule, error)
+	Create(ctx context.Context, schedule *domain.Schedule) error
+	Update(ctx context.Context, schedule *domain.Schedule) error
+	Delete(ctx context.Context, id int64) error
+	// ListActive returns schedules that operate on or after since, or that
+	// still own flights departing after since.
+	ListActive(ctx context.Context, since time.Time) ([]domain.Schedule, error)
+	// SyncFlights makes the schedule's flights departing in [from, to) match
+	// the given instances. Flights with bookings are never modified or removed.
+	SyncFlights(ctx context.Context, scheduleID int64, from, to time.Time, flights []domain.Flight) (ScheduleSyncResult, error)
+}
+
+type PGScheduleRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewScheduleRepository(db *pgxpool.Pool) ScheduleRepository {
+	return &PGScheduleRepository{db: db}
+}
+
+const scheduleSelect = `SELECT s.id, s.flight_number, s.from_airport, s.to_airport, s.days_of_week, s.departure_local, s.block_minutes,
+		s.effective_from, s.effective_to, s.aircraft, s.total_seats, s.price_cents, dep.timezone, arr.timezone, s.created_at, s.updated_at
+	FROM schedules s
+	JOIN airports dep ON dep.code = s.from_airport
+	JOIN airports arr ON arr.code = s.to_airport`
+
+func (r *PGScheduleRepository) List(ctx context.Context) ([]domain.Schedule, error) {
+	return r.query(ctx, scheduleSelect+` ORDER BY s.id`)
+}
+
+func (r *PGScheduleRepository) ListActive(ctx context.Context, since time.Time) ([]domain.Schedule, error) {
+	return r.query(ctx, scheduleSelect+`
+		WHERE s.effective_to >= $1::date
+		   OR EXISTS (SELECT 1 FROM flights f WHERE f.schedule_id = s.id AND f.departure_time >= $1)
+		ORDER BY s.id`, since)
+}
+
+func (r *PGScheduleRepository) query(ctx context.Context, sql string, args ...interface{}) ([]domain.Schedule, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := make([]domain.Schedule, 0)
+	for rows.Next() {
+		var (
+			s            domain.Schedule
+			days         int16
+			departure    pgtype.Time
+			blockMinutes int
+		)
+		if err := rows.Scan(&s.ID, &s.FlightNumber, &s.FromAirport, &s.ToAirport, &days, &departure, &blockMinutes,
+			&s.EffectiveFrom, &s.EffectiveTo, &s.Aircraft, &s.TotalSeats, &s.PriceCents, &s.DepartureTimeZone, &s.ArrivalTimeZone, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			return nil, err
+		}
+		s.DaysOfWeek = domain.Weekdays(days)
+		s.DepartureLocal = time.Duration(departure.Microseconds) * time.Microsecond
+		s.BlockTime = time.Duration(blockMinutes) * time.Minute
+		schedules = append(schedules, s)
+	}
+	return schedules, rows.Err()
+}
+
+func (r *PGScheduleRepository) Create(ctx context.Context, s *domain.Schedule) error {
+	return r.db.QueryRow(ctx, `INSERT INTO schedules (flight_number, from_airport, to_airport, days_of_week, departure_local, block_minutes,
+			effective_from, effective_to, aircraft, total_seats, price_cents)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id, created_at, updated_at`, scheduleArgs(s)...).
+		Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+}
+
+func (r *PGScheduleRepository) Update(ctx context.Context, s *domain.Schedule) error {
+	err := r.db.QueryRow(ctx, `UPDATE schedules
+		SET flight_number=$1, from_airport=$2, to_airport=$3, days_of_week=$4, departure_local=$5, block_minutes=$6,
+		    effective_from=$7, effective_to=$8, aircraft=$9, total_seats=$10, price_cents=$11, updated_at=now()
+		WHERE id=$12
+		RETURNING updated_at`, append(scheduleArgs(s), s.ID)...).Scan(&s.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrScheduleNotFound
+	}
+	return err
+}
+
+// Delete removes a schedule. Its flights are kept and detached
+// (ON DELETE SET NULL); the materializer no longer manages them.
+func (r *PGScheduleRepository) Delete(ctx context.Context, id int64) error {
+	cmd, err := r.db.Exec(ctx, `DELETE FROM schedules WHERE id=$1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrScheduleNotFound
+	}
+	return nil
+}
+
+func scheduleArgs(s *domain.Schedule) []interface{} {
+	departure := pgtype.Time{Microseconds: s.DepartureLocal.Microseconds(), Valid: true}
+	return []interface{}{
+		s.FlightNumber, s.FromAirport, s.ToAirport, int16(s.DaysOfWeek), departure, int(s.BlockTime / time.Minute),
+		s.EffectiveFrom, s.EffectiveTo, s.Aircraft, s.TotalSeats, s.PriceCents,
+	}
+}
+
+func (r *PGScheduleRepository) SyncFlights(ctx context.Context, scheduleID int64, from, to time.Time, flights []domain.Flight) (ScheduleSyncResult, error) {
+	var result ScheduleSyncResult
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return result, err
+	}
+	defer tx.Rollback(ctx)
+
+	departures := make([]time.Time, 0, len(flights))
+	for _, f := range flights {
+		departures = append(departures, f.DepartureTime)
+
+		// Unchanged rows and rows with bookings are filtered out by the
+		// DO UPDATE ... WHERE clause and return nothing.
+		var inserted bool
+		err := tx.QueryRow(ctx, `INSERT INTO flights (schedule_id, flight_number, from_airport, to_airport, departure_time, arrival_time, total_seats, available_seats, price_cents)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8)
+			ON CONFLICT (schedule_id, departure_time) DO UPDATE
+			SET flight_number = EXCLUDED.flight_number,
+			    from_airport = EXCLUDED.from_airport,
+			    to_airport = EXCLUDED.to_airport,
+			    arrival_time = EXCLUDED.arrival_time,
+			    total_seats = EXCLUDED.total_seats,
+			    available_seats = EXCLUDED.available_seats,
+			    price_cents = EXCLUDED.price_cents,
+			    updated_at = now()
+			WHERE NOT EXISTS (SELECT 1 FROM bookings b WHERE b.flight_id = flights.id)
+			  AND (flights.flight_number, flights.from_airport, flights.to_airport, flights.arrival_time, flights.total_seats, flights.price_cents)
+			      IS DISTINCT FROM (EXCLUDED.flight_number, EXCLUDED.from_airport, EXCLUDED.to_airport, EXCLUDED.arrival_time, EXCLUDED.total_seats, EXCLUDED.price_cents)
+			RETURNING (xmax = 0)`,
+			scheduleID, f.FlightNumber, f.FromAirport, f.ToAirport, f.DepartureTime, f.ArrivalTime, f.TotalSeats, f.PriceCents).Scan(&inserted)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return result, err
+		case inserted:
+			result.Created++
+		default:
+			result.Updated++
+		}
+	}
+
+	cmd, err := tx.Exec(ctx, `DELETE FROM flights f
+		WHERE f.schedule_id = $1
+		  AND f.departure_time >= $2 AND f.departure_time < $3
+		  AND NOT (f.departure_time = ANY($4))
+		  AND NOT EXISTS (SELECT 1 FROM bookings b WHERE b.flight_id = f.id)`, scheduleID, from, to, departures)
+	if err != nil {
+		return result, err
+	}
+	result.Removed = int(cmd.RowsAffected())
+
+	return result, tx.Commit(ctx)
+}
+
+var _ ScheduleRepository = (*PGScheduleRepository)(nil)
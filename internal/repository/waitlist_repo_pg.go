@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const uniqueViolation = "23505"
+
+type WaitlistRepository interface {
+	Join(ctx context.Context, entry *domain.WaitlistEntry) error
+	OfferNext(ctx context.Context, flightID int64, token string, expiresAt time.Time, pricing domain.PricingRules, rates domain.ExchangeRates) (*domain.WaitlistEntry, *domain.Booking, error)
+}
+
+type PGWaitlistRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewWaitlistRepository(db *pgxpool.Pool) WaitlistRepository {
+	return &PGWaitlistRepository{db: db}
+}
+
+const waitlistColumns = `id, flight_id, email, fare_class, loyalty_tier, priority, status, COALESCE(booking_token, ''), offered_at, created_at`
+
+func scanWaitlistEntry(row pgx.Row) (*domain.WaitlistEntry, error) {
+	var e domain.WaitlistEntry
+	var offeredAt *time.Time
+	if err := row.Scan(&e.ID, &e.FlightID, &e.Email, &e.FareClass, &e.LoyaltyTier, &e.Priority, &e.Status, &e.BookingToken, &offeredAt, &e.CreatedAt); err != nil {
+		return nil, err
+	}
+	e.OfferedAt = derefTime(offeredAt)
+	return &e, nil
+}
+
+// Join puts the customer on the waitlist of a sold-out flight and fills in
+// the stored fields and the queue position of entry.
+func (r *PGWaitlistRepository) Join(ctx context.Context, entry *domain.WaitlistEntry) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var status domain.FlightStatus
+	var available, overbooking int
+	err = tx.QueryRow(ctx, `SELECT status, available_seats, overbooking_limit FROM flights WHERE id=$1 FOR SHARE`, entry.FlightID).Scan(&status, &available, &overbooking)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrFlightNotFound
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case status == domain.FlightStatusCancelled:
+		return domain.ErrFlightCancelled
+	case !status.Bookable():
+		return domain.ErrFlightNotBookable
+	case available > -overbooking:
+		return domain.ErrFlightNotSoldOut
+	}
+
+	stored, err := scanWaitlistEntry(tx.QueryRow(ctx, `INSERT INTO waitlist (flight_id, email, fare_class, loyalty_tier, priority, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+waitlistColumns, entry.FlightID, entry.Email, entry.FareClass, entry.LoyaltyTier, entry.Priority, domain.WaitlistStatusWaiting))
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrAlreadyWaitlisted
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := tx.QueryRow(ctx, `SELECT count(*) FROM waitlist
+		WHERE flight_id=$1 AND status=$2
+		  AND (priority > $3 OR (priority = $3 AND (created_at, id) <= ($4, $5)))`,
+		stored.FlightID, domain.WaitlistStatusWaiting, stored.Priority, stored.CreatedAt, stored.ID).Scan(&stored.Position); err != nil {
+		return err
+	}
+	*entry = *stored
+	return tx.Commit(ctx)
+}
+
+// OfferNext gives a released seat of the flight to the first waiting
+// customer: a pending booking with the given token and expiry is created and
+// the entry is marked offered, in one transaction. It returns nil values when
+// nobody is waiting or the seat has already been taken. Like CreatePending,
+// the offer may use the overbooking allowance of the flight. The booking is
+// priced at the current fare with pricing, converting its fixed amounts with
+// rates.
+func (r *PGWaitlistRepository) OfferNext(ctx context.Context, flightID int64, token string, expiresAt time.Time, pricing domain.PricingRules, rates domain.ExchangeRates) (*domain.WaitlistEntry, *domain.Booking, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	entry, err := scanWaitlistEntry(tx.QueryRow(ctx, `SELECT `+waitlistColumns+` FROM waitlist
+		WHERE flight_id=$1 AND status=$2
+		ORDER BY priority DESC, created_at, id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED`, flightID, domain.WaitlistStatusWaiting))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seats int
+	err = tx.QueryRow(ctx, `UPDATE flights SET available_seats = available_seats - 1, updated_at = now()
+		WHERE id=$1 AND status IN `+bookableStatuses+` AND available_seats > -overbooking_limit
+		RETURNING total_seats + overbooking_limit`, flightID).Scan(&seats)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	seat, err := nextFreeSeat(ctx, tx, flightID, seats)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flight, err := scanFlight(tx.QueryRow(ctx, flightSelect+` WHERE f.id=$1`, flightID))
+	if err != nil {
+		return nil, nil, err
+	}
+	charges, err := pricing.Charges(*flight, domain.ChannelWeb, entry.FareClass, flight.PriceCents, rates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	booking, err := scanBooking(tx.QueryRow(ctx, `INSERT INTO bookings (flight_id, seat_number, token, status, expires_at, email, fare_class, price_cents,
+			taxes, fuel_surcharge_cents, booking_fee_cents, seat_fee_cents, currency)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING `+bookingColumns, flightID, seat, token, domain.BookingStatusPending, expiresAt, entry.Email, entry.FareClass, flight.PriceCents,
+		charges.Taxes, charges.FuelSurchargeCents, charges.BookingFeeCents, charges.SeatFeeCents, flight.Currency))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := recordTransition(ctx, tx, "", domain.BookingStatusPending, *booking); err != nil {
+		return nil, nil, err
+	}
+
+	offered, err := scanWaitlistEntry(tx.QueryRow(ctx, `UPDATE waitlist SET status=$2, booking_token=$3, offered_at=now()
+		WHERE id=$1 RETURNING `+waitlistColumns, entry.ID, domain.WaitlistStatusOffered, token))
+	if err != nil {
+		return nil, nil, err
+	}
+	return offered, booking, tx.Commit(ctx)
+}
+
+var _ WaitlistRepository = (*PGWaitlistRepository)(nil)
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWaitlistRepository(t *testing.T) {
+	repo := NewWaitlistRepository(&pgxpool.Pool{})
+	assert.NotNil(t, repo)
+}
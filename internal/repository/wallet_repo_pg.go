@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WalletRepository interface {
+	// Balance returns the travel credit and miles of the customer and the
+	// amounts held by its pending bookings.
+	Balance(ctx context.Context, customerID int64) (domain.WalletBalance, error)
+	// ListEntries returns a page of the travel credit ledger of the
+	// customer, newest first.
+	ListEntries(ctx context.Context, customerID int64, page domain.LedgerPage) ([]domain.WalletEntry, error)
+	// Reserve holds the miles and credit of res for its pending booking,
+	// replacing the reservation the booking held before. It fails with
+	// domain.ErrInsufficientMiles or domain.ErrInsufficientCredit when the
+	// customer does not have them available.
+	Reserve(ctx context.Context, res *domain.PaymentReservation) error
+	// GetReservation returns the held or captured reservation of a booking.
+	GetReservation(ctx context.Context, bookingID int64) (*domain.PaymentReservation, error)
+	// Capture debits the held reservation of a booking from the ledgers. It
+	// returns domain.ErrPaymentReservationNotFound when none is held.
+	Capture(ctx context.Context, bookingID int64) (*domain.PaymentReservation, error)
+	// Release gives the held reservation of a booking back unused. Bookings
+	// without one are ignored.
+	Release(ctx context.Context, bookingID int64) error
+	// Refund credits the captured reservation of a booking back to the
+	// ledgers. It returns domain.ErrPaymentReservationNotFound when none was
+	// captured.
+	Refund(ctx context.Context, bookingID int64, description string) (*domain.PaymentReservation, error)
+	// IssueCredit adds travel credit. It reports false, and adds nothing,
+	// when the booking of the entry has already earned credit.
+	IssueCredit(ctx context.Context, entry *domain.WalletEntry) (bool, error)
+}
+
+type PGWalletRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewWalletRepository(db *pgxpool.Pool) WalletRepository {
+	return &PGWalletRepository{db: db}
+}
+
+// walletBalanceQuery sums the ledgers of customer $1. Held reservations of
+// bookings that are no longer pending have lapsed and are left out.
+const walletBalanceQuery = `SELECT
+	(SELECT COALESCE(SUM(amount_cents), 0) FROM wallet_ledger WHERE customer_id=$1),
+	(SELECT COALESCE(SUM(r.credit_cents), 0) FROM payment_reservations r JOIN bookings b ON b.id = r.booking_id AND b.status = 'PENDING'
+		WHERE r.customer_id=$1 AND r.status = 'HELD' AND r.booking_id <> $2),
+	(SELECT COALESCE(SUM(l.miles), 0) FROM loyalty_ledger l JOIN loyalty_members m ON m.id = l.member_id WHERE m.customer_id=$1),
+	(SELECT COALESCE(SUM(r.miles), 0) FROM payment_reservations r JOIN bookings b ON b.id = r.booking_id AND b.status = 'PENDING'
+		WHERE r.customer_id=$1 AND r.status = 'HELD' AND r.booking_id <> $2)`
+
+func scanWalletBalance(row pgx.Row) (domain.WalletBalance, error) {
+	var b domain.WalletBalance
+	err := row.Scan(&b.CreditCents, &b.ReservedCreditCents, &b.Miles, &b.ReservedMiles)
+	return b, err
+}
+
+const reservationColumns = `id, booking_id, customer_id, miles, miles_value_cents, credit_cents, price_cents, currency, status, created_at, updated_at`
+
+func scanReservation(row pgx.Row) (*domain.PaymentReservation, error) {
+	var r domain.PaymentReservation
+	if err := row.Scan(&r.ID, &r.BookingID, &r.CustomerID, &r.Miles, &r.MilesValueCents, &r.CreditCents, &r.PriceCents, &r.Currency, &r.Status, &r.CreatedAt, &r.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (r *PGWalletRepository) Balance(ctx context.Context, customerID int64) (domain.WalletBalance, error) {
+	return scanWalletBalance(r.db.QueryRow(ctx, walletBalanceQuery, customerID, 0))
+}
+
+func (r *PGWalletRepository) ListEntries(ctx context.Context, customerID int64, page domain.LedgerPage) ([]domain.WalletEntry, error) {
+	rows, err := r.db.Query(ctx, `SELECT id, customer_id, kind, amount_cents, COALESCE(booking_id, 0), description, created_at
+		FROM wallet_ledger
+		WHERE customer_id=$1 AND ($2::bigint = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3`, customerID, page.BeforeID, page.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.WalletEntry, 0)
+	for rows.Next() {
+		var e domain.WalletEntry
+		if err := rows.Scan(&e.ID, &e.CustomerID, &e.Kind, &e.AmountCents, &e.BookingID, &e.Description, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (r *PGWalletRepository) Reserve(ctx context.Context, res *domain.PaymentReservation) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Reservations of a customer are made one at a time so that two pending
+	// bookings cannot hold the same miles.
+	var locked int64
+	if err := tx.QueryRow(ctx, `SELECT id FROM customers WHERE id=$1 FOR UPDATE`, res.CustomerID).Scan(&locked); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrCustomerNotFound
+		}
+		return err
+	}
+	var status domain.BookingStatus
+	if err := tx.QueryRow(ctx, `SELECT status FROM bookings WHERE id=$1 FOR UPDATE`, res.BookingID).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrBookingNotFound
+		}
+		return err
+	}
+	if status != domain.BookingStatusPending {
+		return &domain.BookingTransitionError{From: status, To: domain.BookingStatusConfirmed}
+	}
+
+	balance, err := scanWalletBalance(tx.QueryRow(ctx, walletBalanceQuery, res.CustomerID, res.BookingID))
+	if err != nil {
+		return err
+	}
+	if res.Miles > balance.AvailableMiles() {
+		return domain.ErrInsufficientMiles
+	}
+	if res.CreditCents > balance.AvailableCreditCents() {
+		return domain.ErrInsufficientCredit
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE payment_reservations SET status=$2, updated_at=now() WHERE booking_id=$1 AND status=$3`,
+		res.BookingID, domain.ReservationReleased, domain.ReservationHeld); err != nil {
+		return err
+	}
+	res.Status = domain.ReservationHeld
+	if err := tx.QueryRow(ctx, `INSERT INTO payment_reservations (booking_id, customer_id, miles, miles_value_cents, credit_cents, price_cents, currency, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at`, res.BookingID, res.CustomerID, res.Miles, res.MilesValueCents, res.CreditCents, res.PriceCents, res.Currency, res.Status).
+		Scan(&res.ID, &res.CreatedAt, &res.UpdatedAt); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *PGWalletRepository) GetReservation(ctx context.Context, bookingID int64) (*domain.PaymentReservation, error) {
+	res, err := scanReservation(r.db.QueryRow(ctx, `SELECT `+reservationColumns+` FROM payment_reservations WHERE booking_id=$1 AND status IN ($2, $3)`,
+		bookingID, domain.ReservationHeld, domain.ReservationCaptured))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPaymentReservationNotFound
+	}
+	return res, err
+}
+
+func (r *PGWalletRepository) Capture(ctx context.Context, bookingID int64) (*domain.PaymentReservation, error) {
+	return r.settle(ctx, bookingID, domain.ReservationHeld, domain.ReservationCaptured, -1, domain.LedgerEntryRedemption, domain.WalletEntryRedemption, "paid booking")
+}
+
+func (r *PGWalletRepository) Refund(ctx context.Context, bookingID int64, description string) (*domain.PaymentReservation, error) {
+	return r.settle(ctx, bookingID, domain.ReservationCaptured, domain.ReservationRefunded, 1, domain.LedgerEntryRefund, domain.WalletEntryRefund, description)
+}
+
+// settle moves the reservation of a booking from one status to another and
+// adds its miles and credit, times sign, to the ledgers.
+func (r *PGWalletRepository) settle(ctx context.Context, bookingID int64, from, to domain.ReservationStatus, sign int64, milesKind domain.LedgerEntryKind, creditKind domain.WalletEntryKind, description string) (*domain.PaymentReservation, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := scanReservation(tx.QueryRow(ctx, `UPDATE payment_reservations SET status=$3, updated_at=now()
+		WHERE booking_id=$1 AND status=$2 RETURNING `+reservationColumns, bookingID, from, to))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPaymentReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res.Miles > 0 {
+		cmd, err := tx.Exec(ctx, `INSERT INTO loyalty_ledger (member_id, kind, miles, booking_id, description)
+			SELECT id, $2, $3, $4, $5 FROM loyalty_members WHERE customer_id=$1`,
+			res.CustomerID, milesKind, sign*res.Miles, res.BookingID, description)
+		if err != nil {
+			return nil, err
+		}
+		if cmd.RowsAffected() == 0 {
+			return nil, domain.ErrLoyaltyMemberNotFound
+		}
+	}
+	if res.CreditCents > 0 {
+		if _, err := tx.Exec(ctx, `INSERT INTO wallet_ledger (customer_id, kind, amount_cents, booking_id, description) VALUES ($1, $2, $3, $4, $5)`,
+			res.CustomerID, creditKind, sign*res.CreditCents, res.BookingID, description); err != nil {
+			return nil, err
+		}
+	}
+	return res, tx.Commit(ctx)
+}
+
+func (r *PGWalletRepository) Release(ctx context.Context, bookingID int64) error {
+	_, err := r.db.Exec(ctx, `UPDATE payment_reservations SET status=$2, updated_at=now() WHERE booking_id=$1 AND status=$3`,
+		bookingID, domain.ReservationReleased, domain.ReservationHeld)
+	return err
+}
+
+func (r *PGWalletRepository) IssueCredit(ctx context.Context, e *domain.WalletEntry) (bool, error) {
+	err := r.db.QueryRow(ctx, `INSERT INTO wallet_ledger (customer_id, kind, amount_cents, booking_id, description)
+		VALUES ($1, $2, $3, NULLIF($4, 0), $5)
+		ON CONFLICT (booking_id) WHERE kind = 'CREDIT' DO NOTHING
+		RETURNING id, created_at`, e.CustomerID, domain.WalletEntryCredit, e.AmountCents, e.BookingID, e.Description).
+		Scan(&e.ID, &e.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	e.Kind = domain.WalletEntryCredit
+	return true, nil
+}
+
+var _ WalletRepository = (*PGWalletRepository)(nil)
@@ -0,0 +1,77 @@
+package apikeys
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+type APIKeyUseCase interface {
+	// Create stores a new API key and returns it; the key is not shown
+	// again.
+	Create(ctx context.Context, input CreateInput) (string, *domain.APIKey, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, id int64) (*domain.APIKey, error)
+	// Authenticate returns the active API key matching key.
+	Authenticate(ctx context.Context, key string) (*domain.APIKey, error)
+}
+
+type CreateInput struct {
+	Name string
+	// Scopes are permission names, e.g. "flights:read". Admin permissions
+	// are not accepted.
+	Scopes             []string
+	RateLimitPerMinute int
+}
+
+type Service struct {
+	keys repository.APIKeyRepository
+}
+
+func NewService(keys repository.APIKeyRepository) *Service {
+	return &Service{keys: keys}
+}
+
+func (s *Service) Create(ctx context.Context, input CreateInput) (string, *domain.APIKey, error) {
+	for _, scope := range input.Scopes {
+		permission, ok := auth.ParsePermission(scope)
+		if !ok {
+			return "", nil, domain.NewValidationError("scopes", fmt.Sprintf("unknown scope %q", scope))
+		}
+		if permission.IsAdmin() {
+			return "", nil, domain.NewValidationError("scopes", fmt.Sprintf("scope %q is not available to API keys", scope))
+		}
+	}
+	plain, key, err := domain.NewAPIKey(input.Name, input.Scopes, input.RateLimitPerMinute)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := s.keys.Create(ctx, &key); err != nil {
+		return "", nil, err
+	}
+	return plain, &key, nil
+}
+
+func (s *Service) List(ctx context.Context) ([]domain.APIKey, error) {
+	return s.keys.List(ctx)
+}
+
+func (s *Service) Revoke(ctx context.Context, id int64) (*domain.APIKey, error) {
+	return s.keys.Revoke(ctx, id)
+}
+
+func (s *Service) Authenticate(ctx context.Context, key string) (*domain.APIKey, error) {
+	stored, err := s.keys.GetByHash(ctx, domain.HashAPIKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if stored.Revoked() {
+		return nil, domain.ErrAPIKeyRevoked
+	}
+	return stored, nil
+}
+
+var _ APIKeyUseCase = (*Service)(nil)
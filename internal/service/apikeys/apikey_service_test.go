@@ -0,0 +1,88 @@
+package apikeys
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	return m.Called(ctx, key).Error(0)
+}
+
+func (m *MockAPIKeyRepository) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	args := m.Called(ctx, hash)
+	key, _ := args.Get(0).(*domain.APIKey)
+	return key, args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	args := m.Called(ctx)
+	keys, _ := args.Get(0).([]domain.APIKey)
+	return keys, args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, id int64) (*domain.APIKey, error) {
+	args := m.Called(ctx, id)
+	key, _ := args.Get(0).(*domain.APIKey)
+	return key, args.Error(1)
+}
+
+func TestCreateAndAuthenticate(t *testing.T) {
+	repo := &MockAPIKeyRepository{}
+	svc := NewService(repo)
+	ctx := context.Background()
+
+	var stored *domain.APIKey
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.APIKey")).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*domain.APIKey)
+		stored.ID = 7
+	}).Return(nil)
+
+	plain, key, err := svc.Create(ctx, CreateInput{Name: "partner", Scopes: []string{"flights:read", "bookings:create"}, RateLimitPerMinute: 600})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(plain, domain.APIKeyPrefix))
+	assert.True(t, strings.HasPrefix(plain, key.Prefix))
+	assert.NotContains(t, key.Hash, plain, "only the hash is stored")
+	assert.Equal(t, domain.HashAPIKey(plain), stored.Hash)
+	assert.Equal(t, int64(7), key.ID)
+
+	repo.On("GetByHash", ctx, stored.Hash).Return(stored, nil).Once()
+	got, err := svc.Authenticate(ctx, plain)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flights:read", "bookings:create"}, got.Scopes)
+
+	revoked := *stored
+	revoked.RevokedAt = time.Now()
+	repo.On("GetByHash", ctx, stored.Hash).Return(&revoked, nil).Once()
+	_, err = svc.Authenticate(ctx, plain)
+	assert.ErrorIs(t, err, domain.ErrAPIKeyRevoked)
+
+	repo.On("GetByHash", ctx, domain.HashAPIKey("ak_unknown")).Return(nil, domain.ErrAPIKeyNotFound)
+	_, err = svc.Authenticate(ctx, "ak_unknown")
+	assert.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateValidation(t *testing.T) {
+	svc := NewService(&MockAPIKeyRepository{})
+
+	_, _, err := svc.Create(context.Background(), CreateInput{Name: "partner", Scopes: []string{"bookings:delete-all"}})
+	assert.EqualError(t, err, `unknown scope "bookings:delete-all"`)
+
+	_, _, err = svc.Create(context.Background(), CreateInput{Name: "partner", Scopes: []string{"flights:read", "flights:manage"}})
+	assert.EqualError(t, err, `scope "flights:manage" is not available to API keys`)
+
+	_, _, err = svc.Create(context.Background(), CreateInput{Name: " "})
+	assert.EqualError(t, err, "API key name is required")
+}
@@ -0,0 +1,138 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+// SnapshotEvent is the event of the first update sent to a new watcher.
+const SnapshotEvent = "snapshot"
+
+type SeatState string
+
+const (
+	SeatHeld     SeatState = "HELD"
+	SeatBooked   SeatState = "BOOKED"
+	SeatReleased SeatState = "RELEASED"
+)
+
+// Update is the seat availability of a flight after a booking event.
+// SeatNumber is zero for snapshots.
+type Update struct {
+	FlightID       int64
+	TotalSeats     int
+	AvailableSeats int
+	Event          string
+	SeatNumber     int
+	SeatState      SeatState
+	At             time.Time
+}
+
+type AvailabilityUseCase interface {
+	// Watch streams availability updates of a flight, starting with a
+	// snapshot. The channel is closed when ctx is done.
+	Watch(ctx context.Context, flightID int64) (<-chan Update, error)
+	// HandleBookingEvent turns an event from the booking events topic into
+	// updates for the watchers of the affected flights.
+	HandleBookingEvent(ctx context.Context, event kafka.BookingEvent) error
+}
+
+type Service struct {
+	flights repository.FlightRepository
+	hub     *Hub
+	now     func() time.Time
+}
+
+func NewService(flights repository.FlightRepository, hub *Hub) *Service {
+	return &Service{flights: flights, hub: hub, now: time.Now}
+}
+
+func (s *Service) Watch(ctx context.Context, flightID int64) (<-chan Update, error) {
+	// Subscribe before reading the snapshot so that no event is lost in between.
+	updates, unsubscribe := s.hub.Subscribe(flightID)
+	flight, err := s.flights.GetByID(ctx, flightID)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan Update, 1)
+	out <- Update{
+		FlightID:       flight.ID,
+		TotalSeats:     flight.TotalSeats,
+		AvailableSeats: flight.AvailableSeats,
+		Event:          SnapshotEvent,
+		At:             s.now(),
+	}
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case u := <-updates:
+				select {
+				case out <- u:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (s *Service) HandleBookingEvent(ctx context.Context, event kafka.BookingEvent) error {
+	switch event.Type {
+	case "booking_created", "waitlist_offered":
+		return s.seatChanged(ctx, event, event.FlightID, event.SeatNumber, SeatHeld)
+	case "booking_confirmed":
+		return s.seatChanged(ctx, event, event.FlightID, event.SeatNumber, SeatBooked)
+	case "booking_cancelled", "booking_expired", "booking_denied_boarding":
+		return s.seatChanged(ctx, event, event.FlightID, event.SeatNumber, SeatReleased)
+	case "booking_rebooked":
+		if err := s.seatChanged(ctx, event, event.PreviousFlightID, event.PreviousSeatNumber, SeatReleased); err != nil {
+			return err
+		}
+		return s.seatChanged(ctx, event, event.FlightID, event.SeatNumber, SeatBooked)
+	case "booking_seat_changed", "booking_flight_changed":
+		if err := s.seatChanged(ctx, event, event.PreviousFlightID, event.PreviousSeatNumber, SeatReleased); err != nil {
+			return err
+		}
+		state := SeatBooked
+		if event.Status == "PENDING" {
+			state = SeatHeld
+		}
+		return s.seatChanged(ctx, event, event.FlightID, event.SeatNumber, state)
+	}
+	return nil
+}
+
+// seatChanged publishes the current seat count of the flight. The flight is
+// read from the database rather than the flights cache, and only when
+// somebody is watching it.
+func (s *Service) seatChanged(ctx context.Context, event kafka.BookingEvent, flightID int64, seat int, state SeatState) error {
+	if flightID == 0 || !s.hub.Watched(flightID) {
+		return nil
+	}
+	flight, err := s.flights.GetByID(ctx, flightID)
+	if err != nil {
+		return err
+	}
+	s.hub.Publish(Update{
+		FlightID:       flight.ID,
+		TotalSeats:     flight.TotalSeats,
+		AvailableSeats: flight.AvailableSeats,
+		Event:          event.Type,
+		SeatNumber:     seat,
+		SeatState:      state,
+		At:             s.now(),
+	})
+	return nil
+}
+
+var _ AvailabilityUseCase = (*Service)(nil)
@@ -0,0 +1,143 @@
+package availability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFlightRepository implements only GetByID; the embedded interface makes
+// any other call panic.
+type MockFlightRepository struct {
+	mock.Mock
+	repository.FlightRepository
+}
+
+func (m *MockFlightRepository) GetByID(ctx context.Context, id int64) (*domain.Flight, error) {
+	args := m.Called(ctx, id)
+	flight, _ := args.Get(0).(*domain.Flight)
+	return flight, args.Error(1)
+}
+
+var testNow = time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC)
+
+func newTestService() (*Service, *MockFlightRepository) {
+	repo := &MockFlightRepository{}
+	svc := NewService(repo, NewHub(8))
+	svc.now = func() time.Time { return testNow }
+	return svc, repo
+}
+
+func receive(t *testing.T, updates <-chan Update) Update {
+	t.Helper()
+	select {
+	case u := <-updates:
+		return u
+	case <-time.After(time.Second):
+		t.Fatal("no update received")
+		return Update{}
+	}
+}
+
+func TestHub_SubscribePublish(t *testing.T) {
+	hub := NewHub(1)
+	updates, unsubscribe := hub.Subscribe(1)
+	assert.True(t, hub.Watched(1))
+	assert.False(t, hub.Watched(2))
+
+	assert.Equal(t, 1, hub.Publish(Update{FlightID: 1, AvailableSeats: 5}))
+	assert.Equal(t, 0, hub.Publish(Update{FlightID: 1, AvailableSeats: 4}), "full buffer must not block")
+	assert.Equal(t, 0, hub.Publish(Update{FlightID: 2}))
+	assert.Equal(t, 5, (<-updates).AvailableSeats)
+
+	unsubscribe()
+	unsubscribe()
+	assert.False(t, hub.Watched(1))
+	_, open := <-updates
+	assert.False(t, open)
+}
+
+func TestService_WatchStreamsSnapshotAndSeatChanges(t *testing.T) {
+	svc, repo := newTestService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo.On("GetByID", mock.Anything, int64(7)).Return(&domain.Flight{ID: 7, TotalSeats: 10, AvailableSeats: 3}, nil).Once()
+	updates, err := svc.Watch(ctx, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, Update{FlightID: 7, TotalSeats: 10, AvailableSeats: 3, Event: SnapshotEvent, At: testNow}, receive(t, updates))
+
+	repo.On("GetByID", mock.Anything, int64(7)).Return(&domain.Flight{ID: 7, TotalSeats: 10, AvailableSeats: 2}, nil).Once()
+	assert.NoError(t, svc.HandleBookingEvent(ctx, kafka.BookingEvent{Type: "booking_created", FlightID: 7, SeatNumber: 4}))
+	u := receive(t, updates)
+	assert.Equal(t, 2, u.AvailableSeats)
+	assert.Equal(t, 4, u.SeatNumber)
+	assert.Equal(t, SeatHeld, u.SeatState)
+	assert.Equal(t, "booking_created", u.Event)
+
+	cancel()
+	for range updates {
+	}
+	assert.Eventually(t, func() bool { return !svc.hub.Watched(7) }, time.Second, 10*time.Millisecond)
+}
+
+func TestService_HandleBookingEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unwatched flight is not loaded", func(t *testing.T) {
+		svc, repo := newTestService()
+		assert.NoError(t, svc.HandleBookingEvent(ctx, kafka.BookingEvent{Type: "booking_confirmed", FlightID: 7, SeatNumber: 1}))
+		repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rebooking releases the old seat and books the new one", func(t *testing.T) {
+		svc, repo := newTestService()
+		from, stopFrom := svc.hub.Subscribe(7)
+		defer stopFrom()
+		to, stopTo := svc.hub.Subscribe(8)
+		defer stopTo()
+		repo.On("GetByID", ctx, int64(7)).Return(&domain.Flight{ID: 7, AvailableSeats: 0}, nil)
+		repo.On("GetByID", ctx, int64(8)).Return(&domain.Flight{ID: 8, AvailableSeats: 4}, nil)
+
+		err := svc.HandleBookingEvent(ctx, kafka.BookingEvent{Type: "booking_rebooked", FlightID: 8, SeatNumber: 2, PreviousFlightID: 7, PreviousSeatNumber: 5})
+
+		assert.NoError(t, err)
+		released := receive(t, from)
+		assert.Equal(t, 5, released.SeatNumber)
+		assert.Equal(t, SeatReleased, released.SeatState)
+		booked := receive(t, to)
+		assert.Equal(t, 2, booked.SeatNumber)
+		assert.Equal(t, SeatBooked, booked.SeatState)
+	})
+
+	t.Run("seat change of a pending booking releases the old seat and holds the new one", func(t *testing.T) {
+		svc, repo := newTestService()
+		updates, stop := svc.hub.Subscribe(7)
+		defer stop()
+		repo.On("GetByID", ctx, int64(7)).Return(&domain.Flight{ID: 7, AvailableSeats: 3}, nil)
+
+		err := svc.HandleBookingEvent(ctx, kafka.BookingEvent{Type: "booking_seat_changed", FlightID: 7, SeatNumber: 9, Status: "PENDING", PreviousFlightID: 7, PreviousSeatNumber: 5})
+
+		assert.NoError(t, err)
+		released := receive(t, updates)
+		assert.Equal(t, 5, released.SeatNumber)
+		assert.Equal(t, SeatReleased, released.SeatState)
+		held := receive(t, updates)
+		assert.Equal(t, 9, held.SeatNumber)
+		assert.Equal(t, SeatHeld, held.SeatState)
+	})
+
+	t.Run("other events are ignored", func(t *testing.T) {
+		svc, repo := newTestService()
+		_, stop := svc.hub.Subscribe(7)
+		defer stop()
+		assert.NoError(t, svc.HandleBookingEvent(ctx, kafka.BookingEvent{Type: "flight_status_changed", FlightID: 7}))
+		repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
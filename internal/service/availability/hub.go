@@ -0,0 +1,69 @@
+package availability
+
+import "sync"
+
+// Hub fans availability updates out to the watchers of each flight. Sends
+// never block: a watcher that does not keep up misses updates, which is
+// acceptable because every update carries the absolute seat count.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan Update]struct{}
+	buffer      int
+}
+
+func NewHub(buffer int) *Hub {
+	if buffer <= 0 {
+		buffer = 1
+	}
+	return &Hub{subscribers: make(map[int64]map[chan Update]struct{}), buffer: buffer}
+}
+
+// Subscribe registers a watcher of flightID. The returned function
+// unsubscribes and closes the channel; it is safe to call more than once.
+func (h *Hub) Subscribe(flightID int64) (<-chan Update, func()) {
+	ch := make(chan Update, h.buffer)
+
+	h.mu.Lock()
+	if h.subscribers[flightID] == nil {
+		h.subscribers[flightID] = make(map[chan Update]struct{})
+	}
+	h.subscribers[flightID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			delete(h.subscribers[flightID], ch)
+			if len(h.subscribers[flightID]) == 0 {
+				delete(h.subscribers, flightID)
+			}
+			close(ch)
+		})
+	}
+}
+
+// Watched reports whether anyone is subscribed to flightID.
+func (h *Hub) Watched(flightID int64) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers[flightID]) > 0
+}
+
+// Publish delivers u to the watchers of u.FlightID and returns the number of
+// watchers that received it.
+func (h *Hub) Publish(u Update) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delivered := 0
+	for ch := range h.subscribers[u.FlightID] {
+		select {
+		case ch <- u:
+			delivered++
+		default:
+		}
+	}
+	return delivered
+}
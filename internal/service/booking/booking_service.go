@@ -2,11 +2,15 @@ package booking
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
+	"github.com/Domenick1991/airbooking/internal/audit"
+	"github.com/Domenick1991/airbooking/internal/auth"
 	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/kafka"
 	"github.com/Domenick1991/airbooking/internal/repository"
@@ -18,10 +22,42 @@ type BookingUseCase interface {
 	ConfirmBooking(ctx context.Context, token string) (*domain.Booking, error)
 	CancelBooking(ctx context.Context, token string) (*domain.Booking, error)
 	ExpirePendingBookings(ctx context.Context) ([]domain.Booking, error)
+	JoinWaitlist(ctx context.Context, input JoinWaitlistInput) (*domain.WaitlistEntry, error)
+	// ExtendHold pushes back the expiry of a pending booking by the
+	// extension of its hold policy, together with its seat lock.
+	ExtendHold(ctx context.Context, token string) (*domain.Booking, error)
+	// ChangeSeat moves a pending or confirmed booking to another seat of
+	// the same flight.
+	ChangeSeat(ctx context.Context, token string, seatNumber int) (*domain.Booking, error)
+	// ChangeFlight moves a pending or confirmed booking to another flight on
+	// the same route under the change rules of its fare class. The seat left
+	// on the old flight is offered to its waitlist.
+	ChangeFlight(ctx context.Context, input ChangeFlightInput) (*domain.Booking, *domain.FlightChange, error)
+	// CheckIn checks in a confirmed booking while the flight is open for
+	// check-in.
+	CheckIn(ctx context.Context, token string) (*domain.Booking, error)
+	// GetBookingHistory returns the status transitions of a booking, oldest
+	// first.
+	GetBookingHistory(ctx context.Context, token string) ([]domain.BookingTransition, error)
+	// GetBooking returns the booking with token.
+	GetBooking(ctx context.Context, token string) (*domain.Booking, error)
+	// ListCustomerBookings returns a page of the bookings of a customer
+	// account, newest first, and the token of the next page.
+	ListCustomerBookings(ctx context.Context, customerID int64, input ListBookingsInput) ([]domain.Booking, string, error)
+	// OpenManageLink checks a manage link emailed to the passenger and
+	// issues a session limited to its booking.
+	OpenManageLink(ctx context.Context, link domain.ManageLink) (*ManageSession, error)
+	// GetBookingByID returns the booking with id.
+	GetBookingByID(ctx context.Context, id int64) (*domain.Booking, error)
+	// ReservePayment holds miles and travel credit of the customer to pay a
+	// pending booking with when it is confirmed. A new reservation replaces
+	// the previous one; zero miles and credit give them back.
+	ReservePayment(ctx context.Context, input ReservePaymentInput) (*domain.PaymentReservation, error)
 }
 
 type Cache interface {
 	AcquireSeatLock(ctx context.Context, flightID int64, seatNumber int, ttl time.Duration) (bool, error)
+	ExtendSeatLock(ctx context.Context, flightID int64, seatNumber int, ttl time.Duration) error
 	ReleaseSeatLock(ctx context.Context, flightID int64, seatNumber int) error
 	GetFlights(ctx context.Context) ([]domain.Flight, error)
 	SetFlights(ctx context.Context, flights []domain.Flight) error
@@ -40,13 +76,96 @@ type BookingService struct {
 	notificationsTopic string
 	holdTTL            time.Duration
 	confirmationTTL    time.Duration
+	holdPolicies       *domain.HoldPolicies
+	waitlist           repository.WaitlistRepository
+	waitlistHoldTTL    time.Duration
+	fareRules          domain.FareRules
+	links              *auth.LinkSigner
+	issuer             *auth.Issuer
+	manageSessionTTL   time.Duration
+	wallet             repository.WalletRepository
+	redemption         domain.RedemptionRules
+	promotions         repository.PromotionRepository
+	pricing            domain.PricingRules
+	exchangeRates      ExchangeRateSource
+	loyalty            repository.LoyaltyRepository
+}
+
+// ExchangeRateSource provides the current exchange rates.
+type ExchangeRateSource interface {
+	Rates(ctx context.Context) (domain.ExchangeRates, error)
 }
 
 type CreateBookingInput struct {
 	FlightID   int64  `json:"flight_id"`
 	SeatNumber int    `json:"seat_number"`
 	Email      string `json:"email"`
+	// Channel and FareClass select the hold policy; empty means WEB and ECONOMY.
+	Channel   string `json:"channel"`
+	FareClass string `json:"fare_class"`
+	// CustomerID links the booking to the account of a logged-in customer.
+	CustomerID int64 `json:"-"`
+	// APIKeyID links the booking to the partner API key it is made with.
+	APIKeyID int64 `json:"-"`
+	// PromoCode is an optional promo code whose discount is taken off the
+	// fare.
+	PromoCode string `json:"promo_code"`
+}
+
+// ListBookingsInput selects a page of bookings.
+type ListBookingsInput struct {
+	// Statuses filters the bookings; empty means any status.
+	Statuses []string
+	// PageSize defaults to 20 and is capped at 100.
+	PageSize int
+	// PageToken is the token returned with the previous page.
+	PageToken string
+}
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type JoinWaitlistInput struct {
+	FlightID  int64
+	Email     string
+	FareClass string
+	// CustomerID is the account of a logged-in customer, whose loyalty tier
+	// raises the priority of the entry.
+	CustomerID int64
+}
+
+type ChangeFlightInput struct {
+	Token    string
+	FlightID int64
+	// SeatNumber on the new flight; zero takes the lowest free seat.
+	SeatNumber int
 }
+
+// ReservePaymentInput is the part of the price of a booking to pay with
+// miles and travel credit.
+type ReservePaymentInput struct {
+	Token       string
+	Miles       int64
+	CreditCents int64
+}
+
+// ManageSession is the access token a manage link is exchanged for.
+type ManageSession struct {
+	AccessToken string
+	ExpiresAt   time.Time
+	Booking     *domain.Booking
+}
+
+var (
+	ErrWalletDisabled      = &domain.InvalidStateError{Code: "WALLET_DISABLED", Message: "paying with miles and credit is not enabled"}
+	ErrPromotionsDisabled  = &domain.InvalidStateError{Code: "PROMOTIONS_DISABLED", Message: "promo codes are not enabled"}
+	ErrManageLinksDisabled = &domain.InvalidStateError{Code: "MANAGE_LINKS_DISABLED", Message: "manage links are not enabled"}
+	ErrWaitlistDisabled    = &domain.InvalidStateError{Code: "WAITLIST_DISABLED", Message: "waitlist is not enabled"}
+	ErrSeatLocked          = &domain.SeatUnavailableError{Code: "SEAT_LOCKED", Message: "seat is already locked"}
+)
+
 type BookingServiceOption func(*BookingService)
 
 // Интерфейсы для тестирования (оставляем в том же пакете)
@@ -61,6 +180,86 @@ func WithNotificationsTopic(topic string) BookingServiceOption {
 	}
 }
 
+// WithWaitlist enables the waitlist: seats released by cancellation or expiry
+// are offered to waitlisted customers as pending bookings held for holdTTL.
+func WithWaitlist(waitlist repository.WaitlistRepository, holdTTL time.Duration) BookingServiceOption {
+	return func(s *BookingService) {
+		s.waitlist = waitlist
+		s.waitlistHoldTTL = holdTTL
+	}
+}
+
+// WithHoldPolicies sets per-channel and per-fare hold policies. A zero
+// default TTL falls back to the TTL given to NewBookingService.
+func WithHoldPolicies(policies domain.HoldPolicies) BookingServiceOption {
+	return func(s *BookingService) {
+		if policies.Default.TTL == 0 {
+			policies.Default.TTL = s.defaultHoldPolicy().TTL
+		}
+		s.holdPolicies = &policies
+	}
+}
+
+// WithFareRules sets the change rules per fare class used by ChangeFlight.
+// Without it domain.DefaultFareRules apply.
+func WithFareRules(rules domain.FareRules) BookingServiceOption {
+	return func(s *BookingService) {
+		s.fareRules = rules
+	}
+}
+
+// WithManageLinks lets passengers exchange the manage links signed by links
+// for sessions of sessionTTL signed by issuer.
+func WithManageLinks(links *auth.LinkSigner, issuer *auth.Issuer, sessionTTL time.Duration) BookingServiceOption {
+	return func(s *BookingService) {
+		s.links = links
+		s.issuer = issuer
+		s.manageSessionTTL = sessionTTL
+	}
+}
+
+// WithWallet lets customers pay bookings with miles and travel credit under
+// rules, and gives credit for cancelled bookings.
+func WithWallet(wallet repository.WalletRepository, rules domain.RedemptionRules) BookingServiceOption {
+	return func(s *BookingService) {
+		s.wallet = wallet
+		s.redemption = rules
+	}
+}
+
+// WithPromotions lets bookings be made with the promo codes of promotions.
+func WithPromotions(promotions repository.PromotionRepository) BookingServiceOption {
+	return func(s *BookingService) {
+		s.promotions = promotions
+	}
+}
+
+// WithLoyalty ranks the waitlist entries of loyalty members by the tier of
+// their account. Without it every entry has the priority of no tier.
+func WithLoyalty(loyalty repository.LoyaltyRepository) BookingServiceOption {
+	return func(s *BookingService) {
+		s.loyalty = loyalty
+	}
+}
+
+// WithPricing sets the taxes and fees charged on top of the fare of new
+// bookings. Without it bookings pay the fare only.
+func WithPricing(rules domain.PricingRules) BookingServiceOption {
+	return func(s *BookingService) {
+		s.pricing = rules
+	}
+}
+
+// WithExchangeRates converts the fixed taxes and fees of bookings priced in
+// another currency than the pricing rules, and the prices of bookings paid
+// in another currency than the settlement currency. Without it those
+// bookings fail with domain.ErrExchangeRateNotFound.
+func WithExchangeRates(rates ExchangeRateSource) BookingServiceOption {
+	return func(s *BookingService) {
+		s.exchangeRates = rates
+	}
+}
+
 // Оригинальный конструктор
 func NewBookingService(
 	bookings repository.BookingRepository,
@@ -88,11 +287,39 @@ func NewBookingService(
 
 func (s *BookingService) CreateBooking(ctx context.Context, input CreateBookingInput) (*domain.Booking, error) {
 	if input.SeatNumber <= 0 {
-		return nil, errors.New("seat number must be positive")
+		return nil, domain.NewValidationError("seat_number", "seat number must be positive")
 	}
 	if input.Email == "" {
-		return nil, errors.New("email is required")
+		return nil, domain.NewValidationError("email", "email is required")
+	}
+	channel, err := domain.ParseChannel(input.Channel)
+	if err != nil {
+		return nil, err
+	}
+	class, err := domain.ParseFareClass(input.FareClass)
+	if err != nil {
+		return nil, err
 	}
+	// The booking is priced from the fare of the flight; the repository
+	// fails with domain.ErrPriceChanged when the fare moves meanwhile.
+	flight, err := s.flights.GetByID(ctx, input.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	promoCode, discount, err := s.checkPromoCode(ctx, input.PromoCode, *flight)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := s.rates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	charges, err := s.pricing.Charges(*flight, channel, class, flight.PriceCents-discount, rates)
+	if err != nil {
+		return nil, err
+	}
+	// The seat lock and the booking expire together.
+	policy := s.holdPolicy(channel, class)
 
 	log.Printf("Cache interface: %v", s.cache)
 	log.Printf("Cache is nil: %v", s.cache == nil)
@@ -100,31 +327,35 @@ func (s *BookingService) CreateBooking(ctx context.Context, input CreateBookingI
 	locked := false
 	if s.cache != nil {
 		log.Println("Cache is not nil, attempting to acquire seat lock...")
-		ok, err := s.cache.AcquireSeatLock(ctx, input.FlightID, input.SeatNumber, s.holdTTL)
+		ok, err := s.cache.AcquireSeatLock(ctx, input.FlightID, input.SeatNumber, policy.TTL)
 		if err != nil {
 			log.Printf("Error acquiring seat lock: %v", err)
 			return nil, err
 		}
 		if !ok {
 			log.Println("Seat is already locked")
-			return nil, errors.New("seat is already locked")
+			return nil, ErrSeatLocked
 		}
 		locked = true
 	} else {
 		log.Println("Cache is nil, skipping lock acquisition")
 	}
 
-	expiresIn := s.confirmationTTL
-	if expiresIn == 0 {
-		expiresIn = s.holdTTL
-	}
-
 	booking := &domain.Booking{
-		FlightID:   input.FlightID,
-		SeatNumber: input.SeatNumber,
-		Token:      uuid.NewString(),
-		ExpiresAt:  time.Now().Add(expiresIn),
-		Email:      input.Email,
+		FlightID:      input.FlightID,
+		SeatNumber:    input.SeatNumber,
+		Token:         uuid.NewString(),
+		ExpiresAt:     time.Now().Add(policy.TTL),
+		Email:         input.Email,
+		CustomerID:    input.CustomerID,
+		APIKeyID:      input.APIKeyID,
+		Channel:       channel,
+		FareClass:     class,
+		PromoCode:     promoCode,
+		PriceCents:    flight.PriceCents - discount,
+		DiscountCents: discount,
+		Charges:       charges,
+		Currency:      flight.Currency,
 	}
 
 	if err := s.bookings.CreatePending(ctx, booking); err != nil {
@@ -141,17 +372,57 @@ func (s *BookingService) CreateBooking(ctx context.Context, input CreateBookingI
 	return booking, nil
 }
 
+// rates returns the current exchange rates, none without a source.
+func (s *BookingService) rates(ctx context.Context) (domain.ExchangeRates, error) {
+	if s.exchangeRates == nil {
+		return domain.ExchangeRates{}, nil
+	}
+	return s.exchangeRates.Rates(ctx)
+}
+
+// checkPromoCode returns the normalized promo code and the discount it
+// gives on flight. Its usage limits are checked when the booking is stored.
+func (s *BookingService) checkPromoCode(ctx context.Context, code string, flight domain.Flight) (string, int64, error) {
+	code = domain.NormalizePromoCode(code)
+	if code == "" {
+		return "", 0, nil
+	}
+	if s.promotions == nil {
+		return "", 0, ErrPromotionsDisabled
+	}
+	promotion, err := s.promotions.GetByCode(ctx, code)
+	if errors.Is(err, domain.ErrPromotionNotFound) {
+		return "", 0, domain.ErrPromoCodeNotValid
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	discount, err := promotion.Apply(flight, time.Now())
+	if err != nil {
+		return "", 0, err
+	}
+	return promotion.Code, discount, nil
+}
+
 func (s *BookingService) ConfirmBooking(ctx context.Context, token string) (*domain.Booking, error) {
 	current, err := s.bookings.GetByToken(ctx, token)
 	if err != nil {
 		return nil, err
 	}
+	// Checked-in bookings are confirmed again only when rebooked.
 	if current.Status != domain.BookingStatusPending {
-		return nil, errors.New("booking is not pending")
+		return nil, &domain.BookingTransitionError{From: current.Status, To: domain.BookingStatusConfirmed}
+	}
+	paid, err := s.capturePayment(ctx, current)
+	if err != nil {
+		return nil, err
 	}
 
-	updated, err := s.bookings.UpdateStatus(ctx, token, domain.BookingStatusConfirmed)
+	updated, err := s.bookings.UpdateStatus(ctx, token, current.Status, domain.BookingStatusConfirmed)
 	if err != nil {
+		if paid != nil {
+			s.refundPayment(ctx, current.ID, "booking not confirmed")
+		}
 		return nil, err
 	}
 	if err := s.publish(ctx, "booking_confirmed", updated); err != nil {
@@ -168,14 +439,17 @@ func (s *BookingService) CancelBooking(ctx context.Context, token string) (*doma
 	if err != nil {
 		return nil, err
 	}
-	if current.Status == domain.BookingStatusCancelled || current.Status == domain.BookingStatusExpired {
-		return current, nil
+	if err := current.Transition(domain.BookingStatusCancelled); err != nil {
+		return nil, err
 	}
 
-	updated, err := s.bookings.UpdateStatus(ctx, token, domain.BookingStatusCancelled)
+	// The token is rotated with the cancellation: the emailed token and
+	// manage links of a cancelled booking stop working.
+	updated, err := s.bookings.Cancel(ctx, token, current.Status)
 	if err != nil {
 		return nil, err
 	}
+	s.settleCancelledPayment(ctx, current.Status, updated)
 	_ = s.bookings.ReleaseSeat(ctx, updated.FlightID)
 	if err := s.publish(ctx, "booking_cancelled", updated); err != nil {
 		fmt.Printf("WARNING: Failed to publish booking_cancelled event for booking %s: %v\n", updated.Token, err)
@@ -183,38 +457,538 @@ func (s *BookingService) CancelBooking(ctx context.Context, token string) (*doma
 	if s.cache != nil {
 		_ = s.cache.ReleaseSeatLock(ctx, updated.FlightID, updated.SeatNumber)
 	}
+	s.offerReleasedSeat(ctx, updated.FlightID)
+	return updated, nil
+}
+
+func (s *BookingService) CheckIn(ctx context.Context, token string) (*domain.Booking, error) {
+	current, err := s.bookings.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := current.Transition(domain.BookingStatusCheckedIn); err != nil {
+		return nil, err
+	}
+	flight, err := s.flights.GetByID(ctx, current.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	if !flight.Status.CheckInOpen() {
+		return nil, domain.ErrCheckInClosed
+	}
+
+	updated, err := s.bookings.UpdateStatus(ctx, token, current.Status, domain.BookingStatusCheckedIn)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.publish(ctx, "booking_checked_in", updated); err != nil {
+		fmt.Printf("WARNING: Failed to publish booking_checked_in event for booking %s: %v\n", updated.Token, err)
+	}
 	return updated, nil
 }
 
 func (s *BookingService) ExpirePendingBookings(ctx context.Context) ([]domain.Booking, error) {
 	deadline := time.Now()
-	expired, err := s.bookings.ExpirePendingBefore(ctx, deadline)
+	expired, err := s.bookings.ExpirePendingBefore(audit.WithReason(ctx, "hold expired"), deadline)
 	if err != nil {
 		return nil, err
 	}
 	for _, b := range expired {
+		s.releasePayment(ctx, b.ID)
 		_ = s.bookings.ReleaseSeat(ctx, b.FlightID)
 		_ = s.publish(ctx, "booking_expired", &b)
 		if s.cache != nil {
 			_ = s.cache.ReleaseSeatLock(ctx, b.FlightID, b.SeatNumber)
 		}
+		s.offerReleasedSeat(ctx, b.FlightID)
 	}
 	return expired, nil
 }
 
+func (s *BookingService) ExtendHold(ctx context.Context, token string) (*domain.Booking, error) {
+	current, err := s.bookings.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	policy := s.holdPolicy(current.Channel, current.FareClass)
+	expiresAt, err := current.ExtendHold(policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Extend the lock first: a lock that outlives its booking is released
+	// by the expiry sweep, a booking without a lock can lose its seat.
+	if s.cache != nil {
+		if err := s.cache.ExtendSeatLock(ctx, current.FlightID, current.SeatNumber, time.Until(expiresAt)); err != nil {
+			return nil, err
+		}
+	}
+	extended, err := s.bookings.ExtendHold(ctx, token, expiresAt, policy.MaxExtensions)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.publish(ctx, "booking_hold_extended", extended); err != nil {
+		fmt.Printf("WARNING: Failed to publish booking_hold_extended event for booking %s: %v\n", extended.Token, err)
+	}
+	return extended, nil
+}
+
+func (s *BookingService) ChangeSeat(ctx context.Context, token string, seatNumber int) (*domain.Booking, error) {
+	if seatNumber <= 0 {
+		return nil, domain.NewValidationError("seat_number", "seat number must be positive")
+	}
+	current, err := s.bookings.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != domain.BookingStatusPending && current.Status != domain.BookingStatusConfirmed {
+		return nil, domain.ErrBookingNotActive
+	}
+	if current.SeatNumber == seatNumber {
+		return current, nil
+	}
+	flight, err := s.flights.GetByID(ctx, current.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	if seatNumber > flight.TotalSeats {
+		return nil, domain.NewValidationError("seat_number", fmt.Sprintf("flight has %d seats", flight.TotalSeats))
+	}
+
+	// A pending booking keeps its lock until it expires. A confirmed booking
+	// holds no lock, so the new seat is locked only while the row is moved.
+	lockTTL := time.Until(current.ExpiresAt)
+	if current.Status == domain.BookingStatusConfirmed || lockTTL <= 0 {
+		lockTTL = s.holdPolicy(current.Channel, current.FareClass).TTL
+	}
+	locked := false
+	if s.cache != nil {
+		ok, err := s.cache.AcquireSeatLock(ctx, current.FlightID, seatNumber, lockTTL)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, domain.ErrSeatTaken
+		}
+		locked = true
+	}
+
+	updated, err := s.bookings.ChangeSeat(ctx, token, seatNumber)
+	if err != nil {
+		if locked {
+			_ = s.cache.ReleaseSeatLock(ctx, current.FlightID, seatNumber)
+		}
+		return nil, err
+	}
+	if locked {
+		_ = s.cache.ReleaseSeatLock(ctx, current.FlightID, current.SeatNumber)
+		if updated.Status == domain.BookingStatusConfirmed {
+			_ = s.cache.ReleaseSeatLock(ctx, updated.FlightID, updated.SeatNumber)
+		}
+	}
+	if err := s.publishEvent(ctx, "booking_seat_changed", updated, func(e *kafka.BookingEvent) {
+		e.PreviousFlightID = current.FlightID
+		e.PreviousSeatNumber = current.SeatNumber
+	}); err != nil {
+		fmt.Printf("WARNING: Failed to publish booking_seat_changed event for booking %s: %v\n", updated.Token, err)
+	}
+	return updated, nil
+}
+
+func (s *BookingService) ChangeFlight(ctx context.Context, input ChangeFlightInput) (*domain.Booking, *domain.FlightChange, error) {
+	if input.SeatNumber < 0 {
+		return nil, nil, domain.NewValidationError("seat_number", "seat number must not be negative")
+	}
+	current, err := s.bookings.GetByToken(ctx, input.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+	from, err := s.flights.GetByID(ctx, current.FlightID)
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := s.flights.GetByID(ctx, input.FlightID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rules := s.fareRules
+	if rules == nil {
+		rules = domain.DefaultFareRules()
+	}
+	quote, err := rules.QuoteChange(*current, *from, *to, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	// The booking pays the full fare of the new flight; its taxes and fees
+	// are priced again like for a new booking.
+	rates, err := s.rates(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	charges, err := s.pricing.Charges(*to, current.Channel, current.FareClass, quote.NewFareCents, rates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	change := &domain.FlightChange{
+		Token:             current.Token,
+		FromFlightID:      from.ID,
+		ToFlightID:        to.ID,
+		Charges:           charges,
+		FlightChangeQuote: quote,
+	}
+	// A requested seat is locked like in CreateBooking; a free seat picked
+	// by the repository is locked afterwards.
+	lockTTL := time.Until(current.ExpiresAt)
+	if current.Status == domain.BookingStatusConfirmed || lockTTL <= 0 {
+		lockTTL = s.holdPolicy(current.Channel, current.FareClass).TTL
+	}
+	locked := false
+	if s.cache != nil && input.SeatNumber > 0 {
+		ok, err := s.cache.AcquireSeatLock(ctx, to.ID, input.SeatNumber, lockTTL)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return nil, nil, domain.ErrSeatTaken
+		}
+		locked = true
+	}
+
+	updated, err := s.bookings.ChangeFlight(audit.WithReason(ctx, fmt.Sprintf("flight changed from %d to %d", from.ID, to.ID)), change, input.SeatNumber)
+	if err != nil {
+		if locked {
+			_ = s.cache.ReleaseSeatLock(ctx, to.ID, input.SeatNumber)
+		}
+		return nil, nil, err
+	}
+	if s.cache != nil {
+		_ = s.cache.ReleaseSeatLock(ctx, current.FlightID, current.SeatNumber)
+		switch {
+		case updated.Status == domain.BookingStatusConfirmed && locked:
+			_ = s.cache.ReleaseSeatLock(ctx, updated.FlightID, updated.SeatNumber)
+		case updated.Status == domain.BookingStatusPending && !locked:
+			_, _ = s.cache.AcquireSeatLock(ctx, updated.FlightID, updated.SeatNumber, lockTTL)
+		}
+	}
+	if err := s.publishEvent(ctx, "booking_flight_changed", updated, func(e *kafka.BookingEvent) {
+		e.PreviousFlightID = current.FlightID
+		e.PreviousSeatNumber = current.SeatNumber
+		e.AmountDueCents = quote.AmountDueCents()
+	}); err != nil {
+		fmt.Printf("WARNING: Failed to publish booking_flight_changed event for booking %s: %v\n", updated.Token, err)
+	}
+	// The seat given up on the old flight goes to its waitlist.
+	s.offerReleasedSeat(ctx, current.FlightID)
+	return updated, change, nil
+}
+
+// GetBookingHistory returns the history of the booking. Bookings made before
+// the history was recorded have an empty one.
+func (s *BookingService) GetBookingHistory(ctx context.Context, token string) ([]domain.BookingTransition, error) {
+	history, err := s.bookings.History(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		if _, err := s.bookings.GetByToken(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+func (s *BookingService) GetBooking(ctx context.Context, token string) (*domain.Booking, error) {
+	return s.bookings.GetByToken(ctx, token)
+}
+
+func (s *BookingService) GetBookingByID(ctx context.Context, id int64) (*domain.Booking, error) {
+	return s.bookings.GetByID(ctx, id)
+}
+
+func (s *BookingService) ReservePayment(ctx context.Context, input ReservePaymentInput) (*domain.PaymentReservation, error) {
+	if s.wallet == nil {
+		return nil, ErrWalletDisabled
+	}
+	b, err := s.bookings.GetByToken(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := s.rates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.redemption.Reserve(*b, rates, input.Miles, input.CreditCents)
+	if err != nil {
+		return nil, err
+	}
+	if res.Miles == 0 && res.CreditCents == 0 {
+		if err := s.wallet.Release(ctx, b.ID); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	if err := s.wallet.Reserve(ctx, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// capturePayment debits the miles and credit reserved for a booking being
+// confirmed. It returns nil when the booking is paid otherwise.
+func (s *BookingService) capturePayment(ctx context.Context, b *domain.Booking) (*domain.PaymentReservation, error) {
+	if s.wallet == nil || b.CustomerID == 0 {
+		return nil, nil
+	}
+	res, err := s.wallet.Capture(ctx, b.ID)
+	if errors.Is(err, domain.ErrPaymentReservationNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// refundPayment credits back the miles and credit a booking was paid with.
+// Failures are logged: the ledgers can be corrected with new entries.
+func (s *BookingService) refundPayment(ctx context.Context, bookingID int64, reason string) *domain.PaymentReservation {
+	res, err := s.wallet.Refund(ctx, bookingID, reason)
+	if err != nil && !errors.Is(err, domain.ErrPaymentReservationNotFound) {
+		log.Printf("WARNING: failed to refund the payment of booking %d: %v", bookingID, err)
+	}
+	return res
+}
+
+// releasePayment gives back the miles and credit held for a booking that is
+// no longer pending.
+func (s *BookingService) releasePayment(ctx context.Context, bookingID int64) {
+	if s.wallet == nil {
+		return
+	}
+	if err := s.wallet.Release(ctx, bookingID); err != nil {
+		log.Printf("WARNING: failed to release the payment reservation of booking %d: %v", bookingID, err)
+	}
+}
+
+// settleCancelledPayment releases what a pending booking held, or refunds
+// the miles and credit a confirmed booking was paid with and issues travel
+// credit for the rest of its price. Bookings paid only otherwise earn no
+// credit: nothing says they were paid.
+func (s *BookingService) settleCancelledPayment(ctx context.Context, from domain.BookingStatus, b *domain.Booking) {
+	if s.wallet == nil || b.CustomerID == 0 {
+		return
+	}
+	if from == domain.BookingStatusPending {
+		s.releasePayment(ctx, b.ID)
+		return
+	}
+	res := s.refundPayment(ctx, b.ID, "booking cancelled")
+	credit := s.redemption.CancellationCreditCents(res)
+	if credit <= 0 {
+		return
+	}
+	// IssueCredit adds nothing when the booking has already earned credit.
+	entry := &domain.WalletEntry{
+		CustomerID:  b.CustomerID,
+		AmountCents: credit,
+		BookingID:   b.ID,
+		Description: "booking cancelled",
+	}
+	if _, err := s.wallet.IssueCredit(ctx, entry); err != nil {
+		log.Printf("WARNING: failed to issue travel credit for cancelled booking %d: %v", b.ID, err)
+	}
+}
+
+func (s *BookingService) OpenManageLink(ctx context.Context, link domain.ManageLink) (*ManageSession, error) {
+	if !s.links.Enabled() || !s.issuer.Enabled() {
+		return nil, ErrManageLinksDisabled
+	}
+	b, err := s.bookings.GetByID(ctx, link.BookingID)
+	if errors.Is(err, domain.ErrBookingNotFound) {
+		// Unknown bookings are as good as a forged link.
+		return nil, domain.ErrManageLinkInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.links.Verify(link, b.Token); err != nil {
+		return nil, err
+	}
+	token, expiresAt, err := s.issuer.IssueBookingToken(b.ID, s.manageSessionTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &ManageSession{AccessToken: token, ExpiresAt: expiresAt, Booking: b}, nil
+}
+
+func (s *BookingService) ListCustomerBookings(ctx context.Context, customerID int64, input ListBookingsInput) ([]domain.Booking, string, error) {
+	page := domain.BookingPage{Limit: input.PageSize}
+	switch {
+	case input.PageSize < 0:
+		return nil, "", domain.NewValidationError("page_size", "page size must not be negative")
+	case input.PageSize == 0:
+		page.Limit = defaultPageSize
+	case input.PageSize > maxPageSize:
+		page.Limit = maxPageSize
+	}
+	for _, name := range input.Statuses {
+		status, err := domain.ParseBookingStatus(name)
+		if err != nil {
+			return nil, "", err
+		}
+		page.Statuses = append(page.Statuses, status)
+	}
+	if input.PageToken != "" {
+		id, err := decodePageToken(input.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		page.BeforeID = id
+	}
+
+	// One more than asked tells whether there is a next page.
+	page.Limit++
+	bookings, err := s.bookings.ListByCustomer(ctx, customerID, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(bookings) < page.Limit {
+		return bookings, "", nil
+	}
+	bookings = bookings[:page.Limit-1]
+	return bookings, encodePageToken(bookings[len(bookings)-1].ID), nil
+}
+
+// Page tokens carry the id of the last booking of the previous page.
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		if id, err := strconv.ParseInt(string(raw), 10, 64); err == nil && id > 0 {
+			return id, nil
+		}
+	}
+	return 0, domain.NewValidationError("page_token", "invalid page token")
+}
+
+// holdPolicy returns the hold policy for a booking made through channel in
+// fare class class.
+func (s *BookingService) holdPolicy(channel domain.Channel, class domain.FareClass) domain.HoldPolicy {
+	if s.holdPolicies != nil {
+		return s.holdPolicies.For(channel, class)
+	}
+	return s.defaultHoldPolicy()
+}
+
+// defaultHoldPolicy holds seats for confirmationTTL, or holdTTL when it is
+// not set, without extensions.
+func (s *BookingService) defaultHoldPolicy() domain.HoldPolicy {
+	ttl := s.confirmationTTL
+	if ttl == 0 {
+		ttl = s.holdTTL
+	}
+	return domain.HoldPolicy{TTL: ttl}
+}
+
+// JoinWaitlist queues the customer for a sold-out flight. Entries of loyalty
+// members are ranked by the current tier of their account.
+func (s *BookingService) JoinWaitlist(ctx context.Context, input JoinWaitlistInput) (*domain.WaitlistEntry, error) {
+	if s.waitlist == nil {
+		return nil, ErrWaitlistDisabled
+	}
+	if input.Email == "" {
+		return nil, domain.NewValidationError("email", "email is required")
+	}
+	class, err := domain.ParseFareClass(input.FareClass)
+	if err != nil {
+		return nil, err
+	}
+	tier, err := s.loyaltyTier(ctx, input.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &domain.WaitlistEntry{
+		FlightID:    input.FlightID,
+		Email:       input.Email,
+		FareClass:   class,
+		LoyaltyTier: tier,
+		Priority:    domain.WaitlistPriority(class, tier),
+	}
+	if err := s.waitlist.Join(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// loyaltyTier returns the current tier of the loyalty account of the
+// customer; guests and customers outside the program have none.
+func (s *BookingService) loyaltyTier(ctx context.Context, customerID int64) (domain.LoyaltyTier, error) {
+	if s.loyalty == nil || customerID == 0 {
+		return domain.LoyaltyTierNone, nil
+	}
+	member, err := s.loyalty.GetMemberByCustomer(ctx, customerID)
+	if errors.Is(err, domain.ErrLoyaltyMemberNotFound) {
+		return domain.LoyaltyTierNone, nil
+	}
+	if err != nil {
+		return domain.LoyaltyTierNone, err
+	}
+	return member.TierAt(time.Now()), nil
+}
+
+// offerReleasedSeat hands a seat that was just released on the flight to the
+// next waitlisted customer. Failures are logged: the release itself already
+// succeeded and the seat stays available for regular bookings.
+func (s *BookingService) offerReleasedSeat(ctx context.Context, flightID int64) {
+	if s.waitlist == nil {
+		return
+	}
+	rates, err := s.rates(ctx)
+	if err != nil {
+		fmt.Printf("WARNING: Failed to load exchange rates to offer released seat on flight %d: %v\n", flightID, err)
+		return
+	}
+	entry, offered, err := s.waitlist.OfferNext(audit.WithReason(ctx, "waitlist offer"), flightID, uuid.NewString(), time.Now().Add(s.waitlistHoldTTL), s.pricing, rates)
+	if err != nil {
+		fmt.Printf("WARNING: Failed to offer released seat on flight %d to the waitlist: %v\n", flightID, err)
+		return
+	}
+	if offered == nil {
+		return
+	}
+	if s.cache != nil {
+		_, _ = s.cache.AcquireSeatLock(ctx, offered.FlightID, offered.SeatNumber, s.waitlistHoldTTL)
+	}
+	log.Printf("waitlist entry %d on flight %d offered seat %d until %s", entry.ID, flightID, offered.SeatNumber, offered.ExpiresAt.Format(time.RFC3339))
+	if err := s.publish(ctx, "waitlist_offered", offered); err != nil {
+		fmt.Printf("WARNING: Failed to publish waitlist_offered event for booking %s: %v\n", offered.Token, err)
+	}
+}
+
 func (s *BookingService) publish(ctx context.Context, eventType string, booking *domain.Booking) error {
+	return s.publishEvent(ctx, eventType, booking, nil)
+}
+
+// publishEvent is publish with a hook to fill the event-specific fields.
+func (s *BookingService) publishEvent(ctx context.Context, eventType string, booking *domain.Booking, decorate func(*kafka.BookingEvent)) error {
 	if s.producer == nil || s.bookingTopic == "" {
 		return nil
 	}
 	event := kafka.BookingEvent{
 		Type:       eventType,
 		Token:      booking.Token,
+		BookingID:  booking.ID,
 		FlightID:   booking.FlightID,
 		SeatNumber: booking.SeatNumber,
 		Email:      booking.Email,
 		Status:     string(booking.Status),
 		ExpiresAt:  booking.ExpiresAt,
 	}
+	if decorate != nil {
+		decorate(&event)
+	}
 	if err := s.producer.Publish(ctx, s.bookingTopic, booking.Token, event); err != nil {
 		return err
 	}
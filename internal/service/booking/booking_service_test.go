@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Domenick1991/airbooking/internal/audit"
 	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -30,8 +31,8 @@ func (m *MockBookingRepository) GetByToken(ctx context.Context, token string) (*
 	return args.Get(0).(*domain.Booking), args.Error(1)
 }
 
-func (m *MockBookingRepository) UpdateStatus(ctx context.Context, token string, status domain.BookingStatus) (*domain.Booking, error) {
-	args := m.Called(ctx, token, status)
+func (m *MockBookingRepository) UpdateStatus(ctx context.Context, token string, from, to domain.BookingStatus) (*domain.Booking, error) {
+	args := m.Called(ctx, token, from, to)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -48,6 +49,67 @@ func (m *MockBookingRepository) ReleaseSeat(ctx context.Context, flightID int64)
 	return args.Error(0)
 }
 
+func (m *MockBookingRepository) ListByFlight(ctx context.Context, flightID int64, statuses ...domain.BookingStatus) ([]domain.Booking, error) {
+	args := m.Called(ctx, flightID, statuses)
+	bookings, _ := args.Get(0).([]domain.Booking)
+	return bookings, args.Error(1)
+}
+
+func (m *MockBookingRepository) Rebook(ctx context.Context, token string, fromFlightID, toFlightID int64, charges domain.FareCharges) (*domain.Booking, error) {
+	args := m.Called(ctx, token, fromFlightID, toFlightID, charges)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) ExtendHold(ctx context.Context, token string, expiresAt time.Time, maxExtensions int) (*domain.Booking, error) {
+	args := m.Called(ctx, token, expiresAt, maxExtensions)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) ChangeSeat(ctx context.Context, token string, seatNumber int) (*domain.Booking, error) {
+	args := m.Called(ctx, token, seatNumber)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) ChangeFlight(ctx context.Context, change *domain.FlightChange, seatNumber int) (*domain.Booking, error) {
+	args := m.Called(ctx, change, seatNumber)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) History(ctx context.Context, token string) ([]domain.BookingTransition, error) {
+	args := m.Called(ctx, token)
+	history, _ := args.Get(0).([]domain.BookingTransition)
+	return history, args.Error(1)
+}
+
+func (m *MockBookingRepository) ListByCustomer(ctx context.Context, customerID int64, page domain.BookingPage) ([]domain.Booking, error) {
+	args := m.Called(ctx, customerID, page)
+	bookings, _ := args.Get(0).([]domain.Booking)
+	return bookings, args.Error(1)
+}
+
+func (m *MockBookingRepository) GetByID(ctx context.Context, id int64) (*domain.Booking, error) {
+	args := m.Called(ctx, id)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) Cancel(ctx context.Context, token string, from domain.BookingStatus) (*domain.Booking, error) {
+	args := m.Called(ctx, token, from)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+// withReason matches a context carrying the audit reason.
+func withReason(reason string) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return audit.FromContext(ctx).Reason == reason
+	})
+}
+
 type MockFlightRepository struct {
 	mock.Mock
 }
@@ -75,6 +137,57 @@ func (m *MockFlightRepository) ReleaseSeat(ctx context.Context, flightID int64)
 	return args.Error(0)
 }
 
+func (m *MockFlightRepository) Create(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	args := m.Called(ctx, flight)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Flight), args.Error(1)
+}
+
+func (m *MockFlightRepository) Update(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	args := m.Called(ctx, flight)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Flight), args.Error(1)
+}
+
+func (m *MockFlightRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockFlightRepository) AirportExists(ctx context.Context, code string) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFlightRepository) Cancel(ctx context.Context, id int64) (*domain.Flight, []domain.Booking, error) {
+	args := m.Called(ctx, id)
+	flight, _ := args.Get(0).(*domain.Flight)
+	bookings, _ := args.Get(1).([]domain.Booking)
+	return flight, bookings, args.Error(2)
+}
+
+func (m *MockFlightRepository) FindAlternatives(ctx context.Context, flight *domain.Flight, window time.Duration, limit int) ([]domain.Flight, error) {
+	args := m.Called(ctx, flight, window, limit)
+	flights, _ := args.Get(0).([]domain.Flight)
+	return flights, args.Error(1)
+}
+
+func (m *MockFlightRepository) UpdateStatus(ctx context.Context, flight *domain.Flight, previousStatus domain.FlightStatus, previousUpdate time.Time) (*domain.Flight, error) {
+	args := m.Called(ctx, flight, previousStatus, previousUpdate)
+	updated, _ := args.Get(0).(*domain.Flight)
+	return updated, args.Error(1)
+}
+
+func (m *MockFlightRepository) ListOversold(ctx context.Context, departingBefore time.Time) ([]domain.Flight, error) {
+	args := m.Called(ctx, departingBefore)
+	flights, _ := args.Get(0).([]domain.Flight)
+	return flights, args.Error(1)
+}
+
 // MockCache - реализует интерфейс Cache напрямую
 type MockCache struct {
 	mock.Mock
@@ -85,6 +198,10 @@ func (m *MockCache) AcquireSeatLock(ctx context.Context, flightID int64, seatNum
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockCache) ExtendSeatLock(ctx context.Context, flightID int64, seatNumber int, ttl time.Duration) error {
+	return m.Called(ctx, flightID, seatNumber, ttl).Error(0)
+}
+
 func (m *MockCache) ReleaseSeatLock(ctx context.Context, flightID int64, seatNumber int) error {
 	args := m.Called(ctx, flightID, seatNumber)
 	return args.Error(0)
@@ -136,8 +253,9 @@ func TestBookingService_CreateBooking_Success(t *testing.T) {
 		Email:      "test@example.com",
 	}
 
+	mockFlightRepo.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, PriceCents: 10000}, nil)
 	// Настройка моков
-	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Minute).Return(true, nil).Once()
+	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Hour).Return(true, nil).Once()
 	mockBookingRepo.On("CreatePending", ctx, mock.AnythingOfType("*domain.Booking")).Return(nil).Once()
 	mockProducer.On("Publish", ctx, "booking_topic", mock.Anything, mock.Anything).Return(nil).Once()
 
@@ -234,9 +352,10 @@ func TestBookingService_CreateBooking_SeatAlreadyLocked(t *testing.T) {
 		Email:      "test@example.com",
 	}
 
+	mockFlightRepo.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, PriceCents: 10000}, nil)
 	// Место уже заблокировано
-	// Используем service.holdTTL вместо time.Hour
-	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Minute).Return(false, nil).Once()
+	// Блокировка живёт столько же, сколько бронь (confirmationTTL)
+	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Hour).Return(false, nil).Once()
 
 	booking, err := service.CreateBooking(ctx, input)
 
@@ -272,9 +391,10 @@ func TestBookingService_CreateBooking_LockError(t *testing.T) {
 		Email:      "test@example.com",
 	}
 
+	mockFlightRepo.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, PriceCents: 10000}, nil)
 	// Ошибка при блокировке места
 	expectedErr := errors.New("redis error")
-	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Minute).Return(false, expectedErr).Once()
+	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Hour).Return(false, expectedErr).Once()
 	booking, err := service.CreateBooking(ctx, input)
 
 	assert.Error(t, err)
@@ -309,8 +429,9 @@ func TestBookingService_CreateBooking_RepositoryError(t *testing.T) {
 		Email:      "test@example.com",
 	}
 
+	mockFlightRepo.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, PriceCents: 10000}, nil)
 	// Успешная блокировка, но ошибка в репозитории
-	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Minute).Return(true, nil).Once()
+	mockCache.On("AcquireSeatLock", ctx, int64(4), 10, time.Hour).Return(true, nil).Once()
 	// Используем Times(2) для учета вызова через defer
 	mockCache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
 
@@ -369,7 +490,7 @@ func TestBookingService_ConfirmBooking_Success(t *testing.T) {
 
 	// Настройка моков
 	mockBookingRepo.On("GetByToken", ctx, token).Return(existingBooking, nil).Once()
-	mockBookingRepo.On("UpdateStatus", ctx, token, domain.BookingStatusConfirmed).Return(updatedBooking, nil).Once()
+	mockBookingRepo.On("UpdateStatus", ctx, token, domain.BookingStatusPending, domain.BookingStatusConfirmed).Return(updatedBooking, nil).Once()
 	mockCache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
 	mockProducer.On("Publish", ctx, "booking_topic", token, mock.Anything).Return(nil).Once()
 
@@ -455,14 +576,31 @@ func TestBookingService_ConfirmBooking_AlreadyConfirmed(t *testing.T) {
 
 	booking, err := service.ConfirmBooking(ctx, token)
 
-	assert.Error(t, err)
 	assert.Nil(t, booking)
-	assert.Contains(t, err.Error(), "booking is not pending")
+	assert.ErrorIs(t, err, domain.ErrInvalidBookingTransition)
+	var transitionErr *domain.BookingTransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+	assert.Equal(t, domain.BookingStatusConfirmed, transitionErr.From)
 
 	mockBookingRepo.AssertExpectations(t)
 	mockBookingRepo.AssertNotCalled(t, "UpdateStatus")
 }
 
+// Подтверждение бронирования - пассажир уже прошёл регистрацию
+func TestBookingService_ConfirmBooking_CheckedIn(t *testing.T) {
+	mockBookingRepo := &MockBookingRepository{}
+	service := &BookingService{bookings: mockBookingRepo}
+	ctx := context.Background()
+
+	mockBookingRepo.On("GetByToken", ctx, "checked-in").Return(&domain.Booking{ID: 1, Token: "checked-in", Status: domain.BookingStatusCheckedIn}, nil).Once()
+
+	booking, err := service.ConfirmBooking(ctx, "checked-in")
+
+	assert.Nil(t, booking)
+	assert.Equal(t, &domain.BookingTransitionError{From: domain.BookingStatusCheckedIn, To: domain.BookingStatusConfirmed}, err)
+	mockBookingRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 // Тест 9: Подтверждение бронирования - ошибка при обновлении статуса
 func TestBookingService_ConfirmBooking_UpdateError(t *testing.T) {
 	mockBookingRepo := &MockBookingRepository{}
@@ -496,7 +634,7 @@ func TestBookingService_ConfirmBooking_UpdateError(t *testing.T) {
 	// Ошибка при обновлении статуса
 	expectedErr := errors.New("update error")
 	mockBookingRepo.On("GetByToken", ctx, token).Return(existingBooking, nil).Once()
-	mockBookingRepo.On("UpdateStatus", ctx, token, domain.BookingStatusConfirmed).Return(nil, expectedErr).Once()
+	mockBookingRepo.On("UpdateStatus", ctx, token, domain.BookingStatusPending, domain.BookingStatusConfirmed).Return(nil, expectedErr).Once()
 
 	booking, err := service.ConfirmBooking(ctx, token)
 
@@ -550,7 +688,7 @@ func TestBookingService_CancelBooking_Success(t *testing.T) {
 
 	// Настройка моков
 	mockBookingRepo.On("GetByToken", ctx, token).Return(existingBooking, nil).Once()
-	mockBookingRepo.On("UpdateStatus", ctx, token, domain.BookingStatusCancelled).Return(updatedBooking, nil).Once()
+	mockBookingRepo.On("Cancel", ctx, token, domain.BookingStatusPending).Return(updatedBooking, nil).Once()
 	mockBookingRepo.On("ReleaseSeat", ctx, int64(4)).Return(nil).Once()
 	mockCache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
 	mockProducer.On("Publish", ctx, "booking_topic", token, mock.Anything).Return(nil).Once()
@@ -562,6 +700,7 @@ func TestBookingService_CancelBooking_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, booking)
 	assert.Equal(t, domain.BookingStatusCancelled, booking.Status)
+	assert.Equal(t, token, booking.Token, "the rotated token is not handed out")
 
 	mockBookingRepo.AssertExpectations(t)
 	mockCache.AssertExpectations(t)
@@ -603,9 +742,8 @@ func TestBookingService_CancelBooking_AlreadyCancelled(t *testing.T) {
 
 	booking, err := service.CancelBooking(ctx, token)
 
-	assert.NoError(t, err)
-	assert.NotNil(t, booking)
-	assert.Equal(t, existingBooking, booking) // Должен вернуть существующее без изменений
+	assert.ErrorIs(t, err, domain.ErrInvalidBookingTransition)
+	assert.Nil(t, booking)
 
 	mockBookingRepo.AssertExpectations(t)
 	mockBookingRepo.AssertNotCalled(t, "UpdateStatus")
@@ -647,9 +785,8 @@ func TestBookingService_CancelBooking_AlreadyExpired(t *testing.T) {
 
 	booking, err := service.CancelBooking(ctx, token)
 
-	assert.NoError(t, err)
-	assert.NotNil(t, booking)
-	assert.Equal(t, existingBooking, booking)
+	assert.ErrorIs(t, err, domain.ErrInvalidBookingTransition)
+	assert.Nil(t, booking)
 
 	mockBookingRepo.AssertExpectations(t)
 	mockBookingRepo.AssertNotCalled(t, "UpdateStatus")
@@ -732,7 +869,7 @@ func TestBookingService_ExpirePendingBookings_Success(t *testing.T) {
 	}
 
 	// Настройка моков
-	mockBookingRepo.On("ExpirePendingBefore", ctx, mock.AnythingOfType("time.Time")).Return(expiredBookings, nil).Once()
+	mockBookingRepo.On("ExpirePendingBefore", withReason("hold expired"), mock.AnythingOfType("time.Time")).Return(expiredBookings, nil).Once()
 	mockBookingRepo.On("ReleaseSeat", ctx, int64(4)).Return(nil).Once()
 	mockBookingRepo.On("ReleaseSeat", ctx, int64(5)).Return(nil).Once()
 	mockCache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
@@ -775,7 +912,7 @@ func TestBookingService_ExpirePendingBookings_Empty(t *testing.T) {
 	emptyBookings := []domain.Booking{}
 
 	// Настройка моков
-	mockBookingRepo.On("ExpirePendingBefore", ctx, mock.AnythingOfType("time.Time")).Return(emptyBookings, nil).Once()
+	mockBookingRepo.On("ExpirePendingBefore", withReason("hold expired"), mock.AnythingOfType("time.Time")).Return(emptyBookings, nil).Once()
 
 	// Выполнение
 	result, err := service.ExpirePendingBookings(ctx)
@@ -811,7 +948,7 @@ func TestBookingService_ExpirePendingBookings_Error(t *testing.T) {
 
 	// Ошибка при получении просроченных бронирований
 	expectedErr := errors.New("database error")
-	mockBookingRepo.On("ExpirePendingBefore", ctx, mock.AnythingOfType("time.Time")).Return([]domain.Booking{}, expectedErr).Once()
+	mockBookingRepo.On("ExpirePendingBefore", withReason("hold expired"), mock.AnythingOfType("time.Time")).Return([]domain.Booking{}, expectedErr).Once()
 
 	// Выполнение
 	result, err := service.ExpirePendingBookings(ctx)
@@ -1020,6 +1157,7 @@ func TestBookingService_NoCache(t *testing.T) {
 		Email:      "test@example.com",
 	}
 
+	mockFlightRepo.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, PriceCents: 10000}, nil)
 	mockBookingRepo.On("CreatePending", ctx, mock.Anything).Return(nil).Once()
 	mockProducer.On("Publish", ctx, "booking_topic", mock.Anything, mock.Anything).Return(nil).Once()
 
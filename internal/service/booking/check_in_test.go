@@ -0,0 +1,80 @@
+package booking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBookingService_CheckIn(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	producer := &MockProducer{}
+	service := &BookingService{bookings: bookings, flights: flights, producer: producer, bookingTopic: "booking_topic"}
+
+	current := &domain.Booking{Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusConfirmed}
+	checkedIn := *current
+	checkedIn.Status = domain.BookingStatusCheckedIn
+
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, Status: domain.FlightStatusBoarding}, nil).Once()
+	bookings.On("UpdateStatus", ctx, "t1", domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn).Return(&checkedIn, nil).Once()
+	producer.On("Publish", ctx, "booking_topic", "t1", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == "booking_checked_in" && e.Status == "CHECKED_IN"
+	})).Return(nil).Once()
+
+	result, err := service.CheckIn(ctx, "t1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BookingStatusCheckedIn, result.Status)
+	bookings.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestBookingService_CheckIn_Rejected(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pending booking", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		bookings.On("GetByToken", ctx, "t1").Return(&domain.Booking{Token: "t1", Status: domain.BookingStatusPending}, nil).Once()
+
+		_, err := service.CheckIn(ctx, "t1")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidBookingTransition)
+	})
+
+	t.Run("flight departed", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		flights := &MockFlightRepository{}
+		service := &BookingService{bookings: bookings, flights: flights}
+		bookings.On("GetByToken", ctx, "t1").Return(&domain.Booking{Token: "t1", FlightID: 4, Status: domain.BookingStatusConfirmed}, nil).Once()
+		flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, Status: domain.FlightStatusDeparted}, nil).Once()
+
+		_, err := service.CheckIn(ctx, "t1")
+
+		assert.ErrorIs(t, err, domain.ErrCheckInClosed)
+		bookings.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("lost race", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		flights := &MockFlightRepository{}
+		service := &BookingService{bookings: bookings, flights: flights}
+		bookings.On("GetByToken", ctx, "t1").Return(&domain.Booking{Token: "t1", FlightID: 4, Status: domain.BookingStatusConfirmed}, nil).Once()
+		flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, Status: domain.FlightStatusScheduled}, nil).Once()
+		bookings.On("UpdateStatus", ctx, "t1", domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn).
+			Return(nil, &domain.BookingTransitionError{From: domain.BookingStatusCancelled, To: domain.BookingStatusCheckedIn}).Once()
+
+		_, err := service.CheckIn(ctx, "t1")
+
+		var transitionErr *domain.BookingTransitionError
+		assert.ErrorAs(t, err, &transitionErr)
+		assert.Equal(t, domain.BookingStatusCancelled, transitionErr.From)
+	})
+}
@@ -0,0 +1,84 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingService_ListCustomerBookings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pages through the bookings", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		bookings.On("ListByCustomer", ctx, int64(7), domain.BookingPage{
+			Statuses: []domain.BookingStatus{domain.BookingStatusConfirmed},
+			Limit:    3,
+		}).Return([]domain.Booking{{ID: 30}, {ID: 20}, {ID: 10}}, nil).Once()
+
+		page, next, err := service.ListCustomerBookings(ctx, 7, ListBookingsInput{Statuses: []string{"confirmed"}, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Booking{{ID: 30}, {ID: 20}}, page)
+		require.NotEmpty(t, next)
+
+		bookings.On("ListByCustomer", ctx, int64(7), domain.BookingPage{
+			Statuses: []domain.BookingStatus{domain.BookingStatusConfirmed},
+			BeforeID: 20,
+			Limit:    3,
+		}).Return([]domain.Booking{{ID: 10}}, nil).Once()
+
+		page, next, err = service.ListCustomerBookings(ctx, 7, ListBookingsInput{Statuses: []string{"confirmed"}, PageSize: 2, PageToken: next})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Booking{{ID: 10}}, page)
+		assert.Empty(t, next, "last page")
+		bookings.AssertExpectations(t)
+	})
+
+	t.Run("page size defaults and limits", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		bookings.On("ListByCustomer", ctx, int64(7), domain.BookingPage{Limit: defaultPageSize + 1}).Return(nil, nil).Once()
+		bookings.On("ListByCustomer", ctx, int64(7), domain.BookingPage{Limit: maxPageSize + 1}).Return(nil, nil).Once()
+
+		_, _, err := service.ListCustomerBookings(ctx, 7, ListBookingsInput{})
+		require.NoError(t, err)
+		_, _, err = service.ListCustomerBookings(ctx, 7, ListBookingsInput{PageSize: 1000})
+		require.NoError(t, err)
+		bookings.AssertExpectations(t)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		service := &BookingService{bookings: &MockBookingRepository{}}
+
+		_, _, err := service.ListCustomerBookings(ctx, 7, ListBookingsInput{Statuses: []string{"BOARDED"}})
+		assert.ErrorIs(t, err, domain.ErrInvalidBookingStatus)
+		_, _, err = service.ListCustomerBookings(ctx, 7, ListBookingsInput{PageToken: "not-a-token"})
+		assert.Equal(t, []domain.FieldViolation{{Field: "page_token", Description: "invalid page token"}}, domain.Violations(err))
+		_, _, err = service.ListCustomerBookings(ctx, 7, ListBookingsInput{PageSize: -1})
+		assert.Equal(t, "page_size", domain.Violations(err)[0].Field)
+	})
+}
+
+func TestBookingService_CreateBooking_LinksCustomer(t *testing.T) {
+	bookings, flights := &MockBookingRepository{}, &MockFlightRepository{}
+	producer := &MockProducer{}
+	service := &BookingService{bookings: bookings, flights: flights, producer: producer, bookingTopic: "booking_topic", holdTTL: time.Minute}
+	ctx := context.Background()
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4}, nil)
+
+	bookings.On("CreatePending", ctx, mock.MatchedBy(func(b *domain.Booking) bool {
+		return b.CustomerID == 7
+	})).Return(nil).Once()
+	producer.On("Publish", ctx, "booking_topic", mock.Anything, mock.Anything).Return(nil).Once()
+
+	booking, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 10, Email: "me@example.com", CustomerID: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), booking.CustomerID)
+	bookings.AssertExpectations(t)
+}
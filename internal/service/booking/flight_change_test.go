@@ -0,0 +1,149 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/audit"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func changeFlightFixture() (*domain.Booking, *domain.Flight, *domain.Flight) {
+	departure := time.Now().Add(72 * time.Hour)
+	from := &domain.Flight{ID: 4, FromAirport: "SVO", ToAirport: "LED", DepartureTime: departure, PriceCents: 10000, Status: domain.FlightStatusScheduled}
+	to := &domain.Flight{ID: 5, FromAirport: "SVO", ToAirport: "LED", DepartureTime: departure.Add(24 * time.Hour), PriceCents: 14000, Status: domain.FlightStatusScheduled}
+	current := &domain.Booking{ID: 1, Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusConfirmed, FareClass: domain.FareClassEconomy, PriceCents: 10000}
+	return current, from, to
+}
+
+func TestBookingService_ChangeFlight(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	cache := &MockCache{}
+	producer := &MockProducer{}
+	waitlist := &MockWaitlistRepository{}
+	service := &BookingService{bookings: bookings, flights: flights, cache: cache, producer: producer, bookingTopic: "booking_topic", holdTTL: 15 * time.Minute, waitlist: waitlist}
+
+	current, from, to := changeFlightFixture()
+	moved := *current
+	moved.FlightID, moved.SeatNumber, moved.PriceCents = 5, 3, 14000
+
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	flights.On("GetByID", ctx, int64(4)).Return(from, nil).Once()
+	flights.On("GetByID", ctx, int64(5)).Return(to, nil).Once()
+	bookings.On("ChangeFlight", mock.MatchedBy(func(ctx context.Context) bool {
+		return audit.FromContext(ctx).Reason == "flight changed from 4 to 5"
+	}), mock.MatchedBy(func(c *domain.FlightChange) bool {
+		return c.Token == "t1" && c.FromFlightID == 4 && c.ToFlightID == 5 &&
+			c.FareDifferenceCents == 4000 && c.ChangeFeeCents == 5000
+	}), 0).Return(&moved, nil).Once()
+	cache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
+	producer.On("Publish", ctx, "booking_topic", "t1", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == "booking_flight_changed" && e.FlightID == 5 && e.PreviousFlightID == 4 && e.PreviousSeatNumber == 10 && e.AmountDueCents == 9000
+	})).Return(nil).Once()
+	waitlist.On("OfferNext", withReason("waitlist offer"), int64(4), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, nil).Once()
+
+	updated, change, err := service.ChangeFlight(ctx, ChangeFlightInput{Token: "t1", FlightID: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), updated.FlightID)
+	assert.Equal(t, int64(9000), change.AmountDueCents())
+	bookings.AssertExpectations(t)
+	flights.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+	waitlist.AssertExpectations(t)
+}
+
+func TestBookingService_ChangeFlight_RepricesCharges(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	cache := &MockCache{}
+	service := &BookingService{bookings: bookings, flights: flights, cache: cache, holdTTL: 15 * time.Minute}
+	WithPricing(domain.PricingRules{
+		FuelSurchargeCents: 1500,
+		Taxes:              []domain.TaxRule{{Code: "RU", LevyOn: domain.TaxOnDeparture, Country: "RU", Percent: 20}},
+	})(service)
+
+	current, from, to := changeFlightFixture()
+	current.Charges = domain.FareCharges{Taxes: []domain.TaxCharge{{Code: "RU", Airport: "SVO", AmountCents: 2000}}, FuelSurchargeCents: 1500}
+	to.DepartureCountry = "RU"
+	charges := domain.FareCharges{Taxes: []domain.TaxCharge{{Code: "RU", Airport: "SVO", AmountCents: 2800}}, FuelSurchargeCents: 1500}
+	moved := *current
+	moved.FlightID, moved.SeatNumber, moved.PriceCents, moved.Charges = 5, 3, 14000, charges
+
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	flights.On("GetByID", ctx, int64(4)).Return(from, nil).Once()
+	flights.On("GetByID", ctx, int64(5)).Return(to, nil).Once()
+	bookings.On("ChangeFlight", mock.Anything, mock.MatchedBy(func(c *domain.FlightChange) bool {
+		return assert.ObjectsAreEqual(charges, c.Charges)
+	}), 0).Return(&moved, nil).Once()
+	cache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
+
+	updated, _, err := service.ChangeFlight(ctx, ChangeFlightInput{Token: "t1", FlightID: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.FareBreakdown{
+		BaseFareCents: 14000,
+		FareCharges:   charges,
+		TotalCents:    14000 + 2800 + 1500,
+	}, updated.Fare())
+	bookings.AssertExpectations(t)
+}
+
+func TestBookingService_ChangeFlight_RequestedSeat(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	cache := &MockCache{}
+	service := &BookingService{bookings: bookings, flights: flights, cache: cache, holdTTL: 15 * time.Minute}
+
+	current, from, to := changeFlightFixture()
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil)
+	flights.On("GetByID", ctx, int64(4)).Return(from, nil)
+	flights.On("GetByID", ctx, int64(5)).Return(to, nil)
+
+	t.Run("seat is locked", func(t *testing.T) {
+		cache.On("AcquireSeatLock", ctx, int64(5), 7, 15*time.Minute).Return(false, nil).Once()
+
+		_, _, err := service.ChangeFlight(ctx, ChangeFlightInput{Token: "t1", FlightID: 5, SeatNumber: 7})
+
+		assert.ErrorIs(t, err, domain.ErrSeatTaken)
+		bookings.AssertNotCalled(t, "ChangeFlight", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("fare changed releases the lock", func(t *testing.T) {
+		cache.On("AcquireSeatLock", ctx, int64(5), 7, 15*time.Minute).Return(true, nil).Once()
+		bookings.On("ChangeFlight", mock.Anything, mock.Anything, 7).Return(nil, domain.ErrFareChanged).Once()
+		cache.On("ReleaseSeatLock", ctx, int64(5), 7).Return(nil).Once()
+
+		_, _, err := service.ChangeFlight(ctx, ChangeFlightInput{Token: "t1", FlightID: 5, SeatNumber: 7})
+
+		assert.ErrorIs(t, err, domain.ErrFareChanged)
+		cache.AssertExpectations(t)
+	})
+}
+
+func TestBookingService_ChangeFlight_FareRules(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	service := &BookingService{bookings: bookings, flights: flights}
+	WithFareRules(domain.FareRules{domain.FareClassEconomy: {Changeable: false}})(service)
+
+	current, from, to := changeFlightFixture()
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil)
+	flights.On("GetByID", ctx, int64(4)).Return(from, nil)
+	flights.On("GetByID", ctx, int64(5)).Return(to, nil)
+
+	_, _, err := service.ChangeFlight(ctx, ChangeFlightInput{Token: "t1", FlightID: 5})
+
+	assert.ErrorIs(t, err, domain.ErrFareNotChangeable)
+	bookings.AssertNotCalled(t, "ChangeFlight", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,42 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingService_GetBookingHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the transitions", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		history := []domain.BookingTransition{
+			{Token: "t1", To: domain.BookingStatusPending, Actor: "customer"},
+			{Token: "t1", From: domain.BookingStatusPending, To: domain.BookingStatusConfirmed, Actor: "customer", RequestID: "req-1"},
+		}
+		bookings.On("History", ctx, "t1").Return(history, nil).Once()
+
+		result, err := service.GetBookingHistory(ctx, "t1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, history, result)
+		bookings.AssertNotCalled(t, "GetByToken", ctx, "t1")
+	})
+
+	t.Run("unknown booking", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		notFound := errors.New("no rows in result set")
+		bookings.On("History", ctx, "nope").Return([]domain.BookingTransition{}, nil).Once()
+		bookings.On("GetByToken", ctx, "nope").Return(nil, notFound).Once()
+
+		_, err := service.GetBookingHistory(ctx, "nope")
+
+		assert.ErrorIs(t, err, notFound)
+	})
+}
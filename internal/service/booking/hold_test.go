@@ -0,0 +1,90 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBookingService_CreateBooking_UsesHoldPolicy(t *testing.T) {
+	ctx := context.Background()
+	bookings, flights := &MockBookingRepository{}, &MockFlightRepository{}
+	cache := &MockCache{}
+	service := &BookingService{bookings: bookings, flights: flights, cache: cache, holdTTL: time.Minute}
+	WithHoldPolicies(domain.HoldPolicies{
+		Rules: []domain.HoldPolicy{{Channel: domain.ChannelAgent, TTL: 2 * time.Hour, MaxExtensions: 1}},
+	})(service)
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4}, nil)
+
+	cache.On("AcquireSeatLock", ctx, int64(4), 10, 2*time.Hour).Return(true, nil).Once()
+	bookings.On("CreatePending", ctx, mock.MatchedBy(func(b *domain.Booking) bool {
+		return b.Channel == domain.ChannelAgent && b.FareClass == domain.FareClassBusiness && time.Until(b.ExpiresAt) > 119*time.Minute
+	})).Return(nil).Once()
+
+	_, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 10, Email: "a@example.com", Channel: "agent", FareClass: "business"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, service.holdPolicies.Default.TTL, "default TTL falls back to the service TTL")
+	cache.AssertExpectations(t)
+	bookings.AssertExpectations(t)
+}
+
+func TestBookingService_CreateBooking_InvalidChannel(t *testing.T) {
+	service := &BookingService{holdTTL: time.Minute}
+
+	_, err := service.CreateBooking(context.Background(), CreateBookingInput{FlightID: 4, SeatNumber: 10, Email: "a@example.com", Channel: "fax"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidChannel)
+}
+
+func TestBookingService_ExtendHold(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	cache := &MockCache{}
+	producer := &MockProducer{}
+	service := &BookingService{bookings: bookings, cache: cache, producer: producer, bookingTopic: "booking_topic"}
+	WithHoldPolicies(domain.HoldPolicies{Default: domain.HoldPolicy{TTL: 15 * time.Minute, ExtendBy: 10 * time.Minute, MaxExtensions: 2}})(service)
+
+	expiresAt := time.Now().Add(5 * time.Minute)
+	current := &domain.Booking{Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusPending, ExpiresAt: expiresAt, Channel: domain.ChannelWeb, FareClass: domain.FareClassEconomy}
+	extended := *current
+	extended.ExpiresAt = expiresAt.Add(10 * time.Minute)
+	extended.HoldExtensions = 1
+
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	cache.On("ExtendSeatLock", ctx, int64(4), 10, mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 14*time.Minute && ttl <= 15*time.Minute
+	})).Return(nil).Once()
+	bookings.On("ExtendHold", ctx, "t1", expiresAt.Add(10*time.Minute), 2).Return(&extended, nil).Once()
+	producer.On("Publish", ctx, "booking_topic", "t1", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == "booking_hold_extended" && e.ExpiresAt.Equal(extended.ExpiresAt)
+	})).Return(nil).Once()
+
+	result, err := service.ExtendHold(ctx, "t1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.HoldExtensions)
+	bookings.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestBookingService_ExtendHold_LimitReached(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	cache := &MockCache{}
+	service := &BookingService{bookings: bookings, cache: cache, holdTTL: 15 * time.Minute}
+
+	bookings.On("GetByToken", ctx, "t1").Return(&domain.Booking{Token: "t1", Status: domain.BookingStatusPending, ExpiresAt: time.Now().Add(time.Minute)}, nil).Once()
+
+	_, err := service.ExtendHold(ctx, "t1")
+
+	assert.ErrorIs(t, err, domain.ErrHoldExtensionLimit, "the default policy allows no extensions")
+	cache.AssertNotCalled(t, "ExtendSeatLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	bookings.AssertNotCalled(t, "ExtendHold", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,71 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingService_OpenManageLink(t *testing.T) {
+	ctx := context.Background()
+	secret := []byte("s3cret")
+	links := auth.NewLinkSigner([]byte("link-secret"), "https://example.com/manage", time.Hour)
+	issuer := auth.NewIssuer("airbooking", "", time.Hour, auth.SignWithHS256(secret))
+	current := &domain.Booking{ID: 5, Token: "token-5", Status: domain.BookingStatusPending}
+
+	_, link, err := links.Sign(5, "token-5")
+	require.NoError(t, err)
+
+	t.Run("issues a session for the booking", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		WithManageLinks(links, issuer, 15*time.Minute)(service)
+		bookings.On("GetByID", ctx, int64(5)).Return(current, nil).Once()
+
+		session, err := service.OpenManageLink(ctx, link)
+		require.NoError(t, err)
+		assert.Equal(t, current, session.Booking)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), session.ExpiresAt, time.Minute)
+
+		claims, err := auth.NewVerifier(auth.WithHS256Secret(secret)).Verify(session.AccessToken)
+		require.NoError(t, err)
+		id, ok := claims.BookingID()
+		assert.True(t, ok)
+		assert.Equal(t, int64(5), id)
+	})
+
+	t.Run("rejects the links of rotated tokens", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		WithManageLinks(links, issuer, 15*time.Minute)(service)
+		bookings.On("GetByID", ctx, int64(5)).Return(&domain.Booking{ID: 5, Token: "rotated", Status: domain.BookingStatusCancelled}, nil).Once()
+
+		_, err := service.OpenManageLink(ctx, link)
+		assert.ErrorIs(t, err, domain.ErrManageLinkInvalid)
+	})
+
+	t.Run("does not tell deleted bookings apart", func(t *testing.T) {
+		bookings := &MockBookingRepository{}
+		service := &BookingService{bookings: bookings}
+		WithManageLinks(links, issuer, 15*time.Minute)(service)
+		bookings.On("GetByID", ctx, int64(5)).Return(nil, domain.ErrBookingNotFound).Once()
+
+		_, err := service.OpenManageLink(ctx, link)
+		assert.ErrorIs(t, err, domain.ErrManageLinkInvalid)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		service := &BookingService{bookings: &MockBookingRepository{}}
+		_, err := service.OpenManageLink(ctx, link)
+		assert.ErrorIs(t, err, ErrManageLinksDisabled)
+
+		WithManageLinks(links, auth.NewIssuer("airbooking", "", time.Hour), time.Minute)(service)
+		_, err = service.OpenManageLink(ctx, link)
+		assert.ErrorIs(t, err, ErrManageLinksDisabled, "sessions cannot be signed")
+	})
+}
@@ -0,0 +1,62 @@
+package booking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingService_CreateBooking_PricesTheBooking(t *testing.T) {
+	ctx := context.Background()
+	bookings, flights := &MockBookingRepository{}, &MockFlightRepository{}
+	service := &BookingService{bookings: bookings, flights: flights, holdTTL: time.Minute}
+	WithPricing(domain.PricingRules{
+		FuelSurchargeCents: 1500,
+		SeatFees:           map[domain.FareClass]int64{domain.FareClassBusiness: 3000},
+		Taxes:              []domain.TaxRule{{Code: "RU", LevyOn: domain.TaxOnDeparture, Country: "RU", Percent: 20}},
+	})(service)
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, FromAirport: "SVO", DepartureCountry: "RU", PriceCents: 10000}, nil)
+	bookings.On("CreatePending", ctx, mock.Anything).Return(nil).Once()
+
+	booking, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 3, Email: "a@example.com", FareClass: "BUSINESS"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), booking.PriceCents)
+	assert.Equal(t, []domain.TaxCharge{{Code: "RU", Airport: "SVO", AmountCents: 2000}}, booking.Charges.Taxes)
+	assert.Equal(t, int64(10000+2000+1500+3000), booking.TotalCents())
+}
+
+func TestBookingService_CreateBooking_PricesInTheCurrencyOfTheFlight(t *testing.T) {
+	ctx := context.Background()
+	bookings, flights := &MockBookingRepository{}, &MockFlightRepository{}
+	service := &BookingService{bookings: bookings, flights: flights, holdTTL: time.Minute}
+	WithPricing(domain.PricingRules{Currency: "RUB", FuelSurchargeCents: 190000})(service)
+	WithExchangeRates(staticRates{{Base: "EUR", Quote: "RUB", Rate: big.NewRat(95, 1)}})(service)
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, PriceCents: 10000, Currency: "EUR"}, nil)
+	bookings.On("CreatePending", ctx, mock.Anything).Return(nil).Once()
+
+	booking, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 3, Email: "a@example.com"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", booking.Currency)
+	assert.Equal(t, int64(2000), booking.Charges.FuelSurchargeCents)
+}
+
+func TestBookingService_CreateBooking_MissingExchangeRate(t *testing.T) {
+	ctx := context.Background()
+	bookings, flights := &MockBookingRepository{}, &MockFlightRepository{}
+	service := &BookingService{bookings: bookings, flights: flights, holdTTL: time.Minute}
+	WithPricing(domain.PricingRules{Currency: "RUB", FuelSurchargeCents: 190000})(service)
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, PriceCents: 10000, Currency: "EUR"}, nil)
+
+	_, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 3, Email: "a@example.com"})
+
+	assert.ErrorIs(t, err, domain.ErrExchangeRateNotFound)
+	bookings.AssertNotCalled(t, "CreatePending", mock.Anything, mock.Anything)
+}
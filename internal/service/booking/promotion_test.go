@@ -0,0 +1,87 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPromotionRepository implements only GetByCode; the embedded interface
+// makes any other call panic.
+type MockPromotionRepository struct {
+	mock.Mock
+	repository.PromotionRepository
+}
+
+func (m *MockPromotionRepository) GetByCode(ctx context.Context, code string) (*domain.Promotion, error) {
+	args := m.Called(ctx, code)
+	p, _ := args.Get(0).(*domain.Promotion)
+	return p, args.Error(1)
+}
+
+func newPromotionTestService() (*BookingService, *MockBookingRepository, *MockFlightRepository, *MockPromotionRepository) {
+	bookings, flights, promotions := &MockBookingRepository{}, &MockFlightRepository{}, &MockPromotionRepository{}
+	service := &BookingService{bookings: bookings, flights: flights, producer: &MockProducer{}, holdTTL: time.Minute}
+	WithPromotions(promotions)(service)
+	return service, bookings, flights, promotions
+}
+
+func TestBookingService_CreateBooking_PromoCode(t *testing.T) {
+	ctx := context.Background()
+	flight := &domain.Flight{ID: 4, FromAirport: "SVO", ToAirport: "LED", DepartureTime: time.Now().Add(72 * time.Hour), PriceCents: 10000}
+	spring := &domain.Promotion{ID: 1, Code: "SPRING", Type: domain.DiscountPercent, PercentOff: 10, FromAirport: "SVO", Active: true}
+
+	t.Run("passes the checked code to the booking", func(t *testing.T) {
+		service, bookings, flights, promotions := newPromotionTestService()
+		service.producer.(*MockProducer).On("Publish", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		promotions.On("GetByCode", ctx, "SPRING").Return(spring, nil)
+		flights.On("GetByID", ctx, int64(4)).Return(flight, nil)
+		bookings.On("CreatePending", ctx, mock.MatchedBy(func(b *domain.Booking) bool {
+			return b.PromoCode == "SPRING"
+		})).Return(nil).Once()
+
+		_, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 3, Email: "a@example.com", PromoCode: " spring "})
+
+		require.NoError(t, err)
+		bookings.AssertExpectations(t)
+	})
+
+	t.Run("rejects codes for other routes", func(t *testing.T) {
+		service, bookings, flights, promotions := newPromotionTestService()
+		other := *spring
+		other.FromAirport = "VKO"
+		promotions.On("GetByCode", ctx, "SPRING").Return(&other, nil)
+		flights.On("GetByID", ctx, int64(4)).Return(flight, nil)
+
+		_, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 3, Email: "a@example.com", PromoCode: "SPRING"})
+
+		assert.ErrorIs(t, err, domain.ErrPromoCodeNotApplicable)
+		bookings.AssertNotCalled(t, "CreatePending", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown codes are not valid", func(t *testing.T) {
+		service, _, flights, promotions := newPromotionTestService()
+		promotions.On("GetByCode", ctx, "NOPE").Return(nil, domain.ErrPromotionNotFound)
+		flights.On("GetByID", ctx, int64(4)).Return(flight, nil)
+
+		_, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 3, Email: "a@example.com", PromoCode: "nope"})
+
+		assert.ErrorIs(t, err, domain.ErrPromoCodeNotValid)
+	})
+
+	t.Run("disabled without promotions", func(t *testing.T) {
+		flights := &MockFlightRepository{}
+		flights.On("GetByID", ctx, int64(4)).Return(flight, nil)
+		service := &BookingService{bookings: &MockBookingRepository{}, flights: flights, holdTTL: time.Minute}
+
+		_, err := service.CreateBooking(ctx, CreateBookingInput{FlightID: 4, SeatNumber: 3, Email: "a@example.com", PromoCode: "SPRING"})
+
+		assert.ErrorIs(t, err, ErrPromotionsDisabled)
+	})
+}
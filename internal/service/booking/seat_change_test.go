@@ -0,0 +1,128 @@
+package booking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBookingService_ChangeSeat_Pending(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	cache := &MockCache{}
+	producer := &MockProducer{}
+	service := &BookingService{bookings: bookings, flights: flights, cache: cache, producer: producer, bookingTopic: "booking_topic", holdTTL: time.Hour}
+
+	current := &domain.Booking{Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusPending, ExpiresAt: time.Now().Add(10 * time.Minute)}
+	moved := *current
+	moved.SeatNumber = 12
+
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, TotalSeats: 100}, nil).Once()
+	cache.On("AcquireSeatLock", ctx, int64(4), 12, mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 9*time.Minute && ttl <= 10*time.Minute
+	})).Return(true, nil).Once()
+	bookings.On("ChangeSeat", ctx, "t1", 12).Return(&moved, nil).Once()
+	cache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
+	producer.On("Publish", ctx, "booking_topic", "t1", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == "booking_seat_changed" && e.SeatNumber == 12 && e.PreviousSeatNumber == 10 && e.PreviousFlightID == 4
+	})).Return(nil).Once()
+
+	result, err := service.ChangeSeat(ctx, "t1", 12)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12, result.SeatNumber)
+	bookings.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestBookingService_ChangeSeat_ConfirmedReleasesBothLocks(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	cache := &MockCache{}
+	service := &BookingService{bookings: bookings, flights: flights, cache: cache, holdTTL: 15 * time.Minute}
+
+	current := &domain.Booking{Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusConfirmed}
+	moved := *current
+	moved.SeatNumber = 12
+
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, TotalSeats: 100}, nil).Once()
+	cache.On("AcquireSeatLock", ctx, int64(4), 12, 15*time.Minute).Return(true, nil).Once()
+	bookings.On("ChangeSeat", ctx, "t1", 12).Return(&moved, nil).Once()
+	cache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
+	cache.On("ReleaseSeatLock", ctx, int64(4), 12).Return(nil).Once()
+
+	_, err := service.ChangeSeat(ctx, "t1", 12)
+
+	assert.NoError(t, err)
+	cache.AssertExpectations(t)
+}
+
+func TestBookingService_ChangeSeat_SeatTaken(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	cache := &MockCache{}
+	service := &BookingService{bookings: bookings, flights: flights, cache: cache, holdTTL: 15 * time.Minute}
+	current := &domain.Booking{Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusConfirmed}
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, TotalSeats: 100}, nil)
+
+	t.Run("locked by another hold", func(t *testing.T) {
+		bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+		cache.On("AcquireSeatLock", ctx, int64(4), 12, 15*time.Minute).Return(false, nil).Once()
+
+		_, err := service.ChangeSeat(ctx, "t1", 12)
+
+		assert.ErrorIs(t, err, domain.ErrSeatTaken)
+	})
+
+	t.Run("booked by a confirmed booking", func(t *testing.T) {
+		bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+		cache.On("AcquireSeatLock", ctx, int64(4), 13, 15*time.Minute).Return(true, nil).Once()
+		bookings.On("ChangeSeat", ctx, "t1", 13).Return(nil, domain.ErrSeatTaken).Once()
+		cache.On("ReleaseSeatLock", ctx, int64(4), 13).Return(nil).Once()
+
+		_, err := service.ChangeSeat(ctx, "t1", 13)
+
+		assert.ErrorIs(t, err, domain.ErrSeatTaken)
+		cache.AssertNotCalled(t, "ReleaseSeatLock", ctx, int64(4), 10)
+	})
+	cache.AssertExpectations(t)
+}
+
+func TestBookingService_ChangeSeat_Rejected(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	flights := &MockFlightRepository{}
+	service := &BookingService{bookings: bookings, flights: flights}
+
+	_, err := service.ChangeSeat(ctx, "t1", 0)
+	assert.EqualError(t, err, "seat number must be positive")
+
+	bookings.On("GetByToken", ctx, "t1").Return(&domain.Booking{Token: "t1", Status: domain.BookingStatusCancelled}, nil).Once()
+	_, err = service.ChangeSeat(ctx, "t1", 5)
+	assert.ErrorIs(t, err, domain.ErrBookingNotActive)
+
+	same := &domain.Booking{Token: "t2", SeatNumber: 5, Status: domain.BookingStatusConfirmed}
+	bookings.On("GetByToken", ctx, "t2").Return(same, nil).Once()
+	result, err := service.ChangeSeat(ctx, "t2", 5)
+	assert.NoError(t, err)
+	assert.Equal(t, same, result)
+	bookings.AssertNotCalled(t, "ChangeSeat", mock.Anything, mock.Anything, mock.Anything)
+
+	bookings.On("GetByToken", ctx, "t3").Return(&domain.Booking{Token: "t3", FlightID: 4, SeatNumber: 5, Status: domain.BookingStatusConfirmed}, nil).Once()
+	flights.On("GetByID", ctx, int64(4)).Return(&domain.Flight{ID: 4, TotalSeats: 100}, nil).Once()
+	_, err = service.ChangeSeat(ctx, "t3", 101)
+	var validation *domain.ValidationError
+	assert.ErrorAs(t, err, &validation, "seats beyond the cabin are rejected")
+	bookings.AssertNotCalled(t, "ChangeSeat", mock.Anything, mock.Anything, mock.Anything)
+}
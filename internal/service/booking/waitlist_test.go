@@ -0,0 +1,173 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWaitlistRepository struct {
+	mock.Mock
+}
+
+func (m *MockWaitlistRepository) Join(ctx context.Context, entry *domain.WaitlistEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockWaitlistRepository) OfferNext(ctx context.Context, flightID int64, token string, expiresAt time.Time, pricing domain.PricingRules, rates domain.ExchangeRates) (*domain.WaitlistEntry, *domain.Booking, error) {
+	args := m.Called(ctx, flightID, token, expiresAt, pricing, rates)
+	entry, _ := args.Get(0).(*domain.WaitlistEntry)
+	booking, _ := args.Get(1).(*domain.Booking)
+	return entry, booking, args.Error(2)
+}
+
+// MockLoyaltyRepository implements only GetMemberByCustomer; the embedded
+// interface makes any other call panic.
+type MockLoyaltyRepository struct {
+	mock.Mock
+	repository.LoyaltyRepository
+}
+
+func (m *MockLoyaltyRepository) GetMemberByCustomer(ctx context.Context, customerID int64) (*domain.LoyaltyMember, error) {
+	args := m.Called(ctx, customerID)
+	member, _ := args.Get(0).(*domain.LoyaltyMember)
+	return member, args.Error(1)
+}
+
+func TestBookingService_JoinWaitlist(t *testing.T) {
+	ctx := context.Background()
+	waitlist, loyalty := &MockWaitlistRepository{}, &MockLoyaltyRepository{}
+	service := &BookingService{waitlist: waitlist}
+	WithLoyalty(loyalty)(service)
+	loyalty.On("GetMemberByCustomer", ctx, int64(7)).Return(&domain.LoyaltyMember{ID: 3, CustomerID: 7, Tier: domain.LoyaltyTierGold, TierExpiresAt: time.Now().Add(time.Hour)}, nil)
+
+	waitlist.On("Join", ctx, mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+		return e.FlightID == 4 && e.FareClass == domain.FareClassBusiness && e.LoyaltyTier == domain.LoyaltyTierGold && e.Priority == 22
+	})).Run(func(args mock.Arguments) {
+		entry := args.Get(1).(*domain.WaitlistEntry)
+		entry.ID = 9
+		entry.Status = domain.WaitlistStatusWaiting
+		entry.Position = 1
+	}).Return(nil).Once()
+
+	entry, err := service.JoinWaitlist(ctx, JoinWaitlistInput{FlightID: 4, Email: "a@example.com", FareClass: "business", CustomerID: 7})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), entry.ID)
+	assert.Equal(t, 1, entry.Position)
+	waitlist.AssertExpectations(t)
+}
+
+func TestBookingService_JoinWaitlist_TierComesFromTheLoyaltyAccount(t *testing.T) {
+	ctx := context.Background()
+	waitlist, loyalty := &MockWaitlistRepository{}, &MockLoyaltyRepository{}
+	service := &BookingService{waitlist: waitlist}
+	WithLoyalty(loyalty)(service)
+	loyalty.On("GetMemberByCustomer", ctx, int64(7)).Return(&domain.LoyaltyMember{Tier: domain.LoyaltyTierPlatinum, TierExpiresAt: time.Now().Add(-time.Hour)}, nil)
+	loyalty.On("GetMemberByCustomer", ctx, int64(8)).Return(nil, domain.ErrLoyaltyMemberNotFound)
+	waitlist.On("Join", ctx, mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+		return e.LoyaltyTier == domain.LoyaltyTierNone
+	})).Return(nil).Times(3)
+
+	for _, customerID := range []int64{0, 7, 8} {
+		_, err := service.JoinWaitlist(ctx, JoinWaitlistInput{FlightID: 4, Email: "a@example.com", CustomerID: customerID})
+		assert.NoError(t, err, "guests, expired tiers and non-members have no tier")
+	}
+	waitlist.AssertExpectations(t)
+	loyalty.AssertNotCalled(t, "GetMemberByCustomer", ctx, int64(0))
+}
+
+func TestBookingService_JoinWaitlist_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := (&BookingService{}).JoinWaitlist(ctx, JoinWaitlistInput{FlightID: 4, Email: "a@example.com"})
+	assert.ErrorIs(t, err, ErrWaitlistDisabled)
+
+	service := &BookingService{waitlist: &MockWaitlistRepository{}}
+	_, err = service.JoinWaitlist(ctx, JoinWaitlistInput{FlightID: 4})
+	assert.EqualError(t, err, "email is required")
+	_, err = service.JoinWaitlist(ctx, JoinWaitlistInput{FlightID: 4, Email: "a@example.com", FareClass: "cargo"})
+	assert.ErrorIs(t, err, domain.ErrInvalidFareClass)
+
+	waitlist := &MockWaitlistRepository{}
+	waitlist.On("Join", ctx, mock.Anything).Return(domain.ErrFlightNotSoldOut)
+	_, err = (&BookingService{waitlist: waitlist}).JoinWaitlist(ctx, JoinWaitlistInput{FlightID: 4, Email: "a@example.com"})
+	assert.ErrorIs(t, err, domain.ErrFlightNotSoldOut)
+}
+
+func TestBookingService_CancelBooking_OffersSeatToWaitlist(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	cache := &MockCache{}
+	producer := &MockProducer{}
+	waitlist := &MockWaitlistRepository{}
+	service := &BookingService{
+		bookings:        bookings,
+		cache:           cache,
+		producer:        producer,
+		bookingTopic:    "booking_topic",
+		waitlist:        waitlist,
+		waitlistHoldTTL: 30 * time.Minute,
+	}
+
+	current := &domain.Booking{Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusConfirmed}
+	cancelled := &domain.Booking{Token: "t1", FlightID: 4, SeatNumber: 10, Status: domain.BookingStatusCancelled}
+	offered := &domain.Booking{Token: "t2", FlightID: 4, SeatNumber: 12, Status: domain.BookingStatusPending, Email: "w@example.com", ExpiresAt: time.Now().Add(30 * time.Minute)}
+
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	bookings.On("Cancel", ctx, "t1", domain.BookingStatusConfirmed).Return(cancelled, nil).Once()
+	bookings.On("ReleaseSeat", ctx, int64(4)).Return(nil).Once()
+	cache.On("ReleaseSeatLock", ctx, int64(4), 10).Return(nil).Once()
+	producer.On("Publish", ctx, "booking_topic", "t1", mock.Anything).Return(nil).Once()
+	waitlist.On("OfferNext", withReason("waitlist offer"), int64(4), mock.AnythingOfType("string"), mock.MatchedBy(func(expires time.Time) bool {
+		return time.Until(expires) > 29*time.Minute
+	}), mock.Anything, mock.Anything).Return(&domain.WaitlistEntry{ID: 9, FlightID: 4}, offered, nil).Once()
+	cache.On("AcquireSeatLock", ctx, int64(4), 12, 30*time.Minute).Return(true, nil).Once()
+	producer.On("Publish", ctx, "booking_topic", "t2", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == "waitlist_offered" && e.Email == "w@example.com" && e.SeatNumber == 12
+	})).Return(nil).Once()
+
+	result, err := service.CancelBooking(ctx, "t1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, cancelled, result)
+	bookings.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+	waitlist.AssertExpectations(t)
+}
+
+func TestBookingService_ExpirePendingBookings_EmptyWaitlist(t *testing.T) {
+	ctx := context.Background()
+	bookings := &MockBookingRepository{}
+	waitlist := &MockWaitlistRepository{}
+	service := &BookingService{bookings: bookings, waitlist: waitlist, waitlistHoldTTL: time.Minute}
+
+	bookings.On("ExpirePendingBefore", withReason("hold expired"), mock.Anything).Return([]domain.Booking{{Token: "t1", FlightID: 4, SeatNumber: 10}}, nil).Once()
+	bookings.On("ReleaseSeat", ctx, int64(4)).Return(nil).Once()
+	waitlist.On("OfferNext", withReason("waitlist offer"), int64(4), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, nil).Once()
+
+	expired, err := service.ExpirePendingBookings(ctx)
+
+	assert.NoError(t, err)
+	assert.Len(t, expired, 1)
+	waitlist.AssertExpectations(t)
+}
+
+func TestBookingService_OfferReleasedSeat_ErrorIsNotFatal(t *testing.T) {
+	ctx := context.Background()
+	waitlist := &MockWaitlistRepository{}
+	service := &BookingService{waitlist: waitlist, waitlistHoldTTL: time.Minute}
+	waitlist.On("OfferNext", withReason("waitlist offer"), int64(4), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, errors.New("db down")).Once()
+
+	assert.NotPanics(t, func() { service.offerReleasedSeat(ctx, 4) })
+	waitlist.AssertExpectations(t)
+}
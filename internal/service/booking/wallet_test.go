@@ -0,0 +1,267 @@
+package booking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockWalletRepository struct {
+	mock.Mock
+}
+
+func (m *MockWalletRepository) Balance(ctx context.Context, customerID int64) (domain.WalletBalance, error) {
+	args := m.Called(ctx, customerID)
+	return args.Get(0).(domain.WalletBalance), args.Error(1)
+}
+
+func (m *MockWalletRepository) ListEntries(ctx context.Context, customerID int64, page domain.LedgerPage) ([]domain.WalletEntry, error) {
+	args := m.Called(ctx, customerID, page)
+	entries, _ := args.Get(0).([]domain.WalletEntry)
+	return entries, args.Error(1)
+}
+
+func (m *MockWalletRepository) Reserve(ctx context.Context, res *domain.PaymentReservation) error {
+	args := m.Called(ctx, res)
+	return args.Error(0)
+}
+
+func (m *MockWalletRepository) GetReservation(ctx context.Context, bookingID int64) (*domain.PaymentReservation, error) {
+	args := m.Called(ctx, bookingID)
+	res, _ := args.Get(0).(*domain.PaymentReservation)
+	return res, args.Error(1)
+}
+
+func (m *MockWalletRepository) Capture(ctx context.Context, bookingID int64) (*domain.PaymentReservation, error) {
+	args := m.Called(ctx, bookingID)
+	res, _ := args.Get(0).(*domain.PaymentReservation)
+	return res, args.Error(1)
+}
+
+func (m *MockWalletRepository) Release(ctx context.Context, bookingID int64) error {
+	args := m.Called(ctx, bookingID)
+	return args.Error(0)
+}
+
+func (m *MockWalletRepository) Refund(ctx context.Context, bookingID int64, description string) (*domain.PaymentReservation, error) {
+	args := m.Called(ctx, bookingID, description)
+	res, _ := args.Get(0).(*domain.PaymentReservation)
+	return res, args.Error(1)
+}
+
+func (m *MockWalletRepository) IssueCredit(ctx context.Context, entry *domain.WalletEntry) (bool, error) {
+	args := m.Called(ctx, entry)
+	return args.Bool(0), args.Error(1)
+}
+
+// staticRates is an ExchangeRateSource with fixed rates.
+type staticRates []domain.ExchangeRate
+
+func (r staticRates) Rates(context.Context) (domain.ExchangeRates, error) {
+	return domain.NewExchangeRates(r), nil
+}
+
+func newWalletTestService() (*BookingService, *MockBookingRepository, *MockWalletRepository) {
+	bookings, wallet := &MockBookingRepository{}, &MockWalletRepository{}
+	service := &BookingService{bookings: bookings}
+	WithWallet(wallet, domain.DefaultRedemptionRules())(service)
+	return service, bookings, wallet
+}
+
+func TestBookingService_ReservePayment(t *testing.T) {
+	ctx := context.Background()
+	pending := &domain.Booking{ID: 4, Token: "tok", CustomerID: 9, Status: domain.BookingStatusPending, PriceCents: 10000}
+
+	t.Run("holds miles and credit", func(t *testing.T) {
+		service, bookings, wallet := newWalletTestService()
+		bookings.On("GetByToken", ctx, "tok").Return(pending, nil)
+		wallet.On("Reserve", ctx, mock.MatchedBy(func(r *domain.PaymentReservation) bool {
+			return r.BookingID == 4 && r.CustomerID == 9 && r.Miles == 5000 && r.CreditCents == 2000
+		})).Return(nil).Once()
+
+		res, err := service.ReservePayment(ctx, ReservePaymentInput{Token: "tok", Miles: 5000, CreditCents: 2000})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5000), res.MilesValueCents)
+		assert.Equal(t, int64(3000), res.AmountDueCents())
+		wallet.AssertExpectations(t)
+	})
+
+	t.Run("zero releases the reservation", func(t *testing.T) {
+		service, bookings, wallet := newWalletTestService()
+		bookings.On("GetByToken", ctx, "tok").Return(pending, nil)
+		wallet.On("Release", ctx, int64(4)).Return(nil).Once()
+
+		res, err := service.ReservePayment(ctx, ReservePaymentInput{Token: "tok"})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000), res.AmountDueCents())
+		wallet.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects more than the price", func(t *testing.T) {
+		service, bookings, _ := newWalletTestService()
+		bookings.On("GetByToken", ctx, "tok").Return(pending, nil)
+
+		_, err := service.ReservePayment(ctx, ReservePaymentInput{Token: "tok", Miles: 8000, CreditCents: 3000})
+
+		var validation *domain.ValidationError
+		assert.ErrorAs(t, err, &validation)
+	})
+
+	t.Run("settles bookings priced in another currency", func(t *testing.T) {
+		service, bookings, wallet := newWalletTestService()
+		WithExchangeRates(staticRates{{Base: "EUR", Quote: "RUB", Rate: big.NewRat(95, 1)}})(service)
+		euros := *pending
+		euros.Currency = "EUR"
+		bookings.On("GetByToken", ctx, "tok").Return(&euros, nil)
+		wallet.On("Reserve", ctx, mock.MatchedBy(func(r *domain.PaymentReservation) bool {
+			return r.Currency == "RUB" && r.PriceCents == 950000
+		})).Return(nil).Once()
+
+		res, err := service.ReservePayment(ctx, ReservePaymentInput{Token: "tok", CreditCents: 2000})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(948000), res.AmountDueCents())
+		wallet.AssertExpectations(t)
+	})
+
+	t.Run("rejects guest bookings", func(t *testing.T) {
+		service, bookings, _ := newWalletTestService()
+		bookings.On("GetByToken", ctx, "guest").Return(&domain.Booking{ID: 5, Status: domain.BookingStatusPending, PriceCents: 10000}, nil)
+
+		_, err := service.ReservePayment(ctx, ReservePaymentInput{Token: "guest", CreditCents: 100})
+
+		assert.ErrorIs(t, err, domain.ErrWalletCustomerRequired)
+	})
+
+	t.Run("disabled without a wallet", func(t *testing.T) {
+		service := &BookingService{bookings: &MockBookingRepository{}}
+
+		_, err := service.ReservePayment(ctx, ReservePaymentInput{Token: "tok"})
+
+		assert.ErrorIs(t, err, ErrWalletDisabled)
+	})
+}
+
+func TestBookingService_ConfirmBooking_CapturesReservation(t *testing.T) {
+	ctx := context.Background()
+	pending := &domain.Booking{ID: 4, Token: "tok", CustomerID: 9, Status: domain.BookingStatusPending, PriceCents: 10000}
+	confirmed := *pending
+	confirmed.Status = domain.BookingStatusConfirmed
+
+	t.Run("captures before confirming", func(t *testing.T) {
+		service, bookings, wallet := newWalletTestService()
+		bookings.On("GetByToken", ctx, "tok").Return(pending, nil)
+		wallet.On("Capture", ctx, int64(4)).Return(&domain.PaymentReservation{BookingID: 4, Miles: 1000}, nil).Once()
+		bookings.On("UpdateStatus", ctx, "tok", domain.BookingStatusPending, domain.BookingStatusConfirmed).Return(&confirmed, nil).Once()
+
+		updated, err := service.ConfirmBooking(ctx, "tok")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.BookingStatusConfirmed, updated.Status)
+		wallet.AssertExpectations(t)
+	})
+
+	t.Run("confirms bookings without a reservation", func(t *testing.T) {
+		service, bookings, wallet := newWalletTestService()
+		bookings.On("GetByToken", ctx, "tok").Return(pending, nil)
+		wallet.On("Capture", ctx, int64(4)).Return(nil, domain.ErrPaymentReservationNotFound).Once()
+		bookings.On("UpdateStatus", ctx, "tok", domain.BookingStatusPending, domain.BookingStatusConfirmed).Return(&confirmed, nil).Once()
+
+		_, err := service.ConfirmBooking(ctx, "tok")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("refunds when the booking could not be confirmed", func(t *testing.T) {
+		service, bookings, wallet := newWalletTestService()
+		bookings.On("GetByToken", ctx, "tok").Return(pending, nil)
+		wallet.On("Capture", ctx, int64(4)).Return(&domain.PaymentReservation{BookingID: 4, Miles: 1000}, nil).Once()
+		lost := &domain.BookingTransitionError{From: domain.BookingStatusExpired, To: domain.BookingStatusConfirmed}
+		bookings.On("UpdateStatus", ctx, "tok", domain.BookingStatusPending, domain.BookingStatusConfirmed).Return(nil, lost).Once()
+		wallet.On("Refund", ctx, int64(4), "booking not confirmed").Return(&domain.PaymentReservation{}, nil).Once()
+
+		_, err := service.ConfirmBooking(ctx, "tok")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidBookingTransition)
+		wallet.AssertExpectations(t)
+	})
+}
+
+func TestBookingService_CancelBooking_RefundsCapturedPayment(t *testing.T) {
+	ctx := context.Background()
+	confirmed := &domain.Booking{ID: 4, FlightID: 2, Token: "tok", CustomerID: 9, Status: domain.BookingStatusConfirmed, PriceCents: 10000}
+	cancelled := *confirmed
+	cancelled.Status = domain.BookingStatusCancelled
+	pending := &domain.Booking{ID: 6, Token: "next", CustomerID: 9, Status: domain.BookingStatusPending, PriceCents: 3000}
+
+	service, bookings, wallet := newWalletTestService()
+	rules := domain.DefaultRedemptionRules()
+	rules.CancellationCreditPercent = 50
+	WithWallet(wallet, rules)(service)
+	bookings.On("GetByToken", ctx, "tok").Return(confirmed, nil)
+	bookings.On("Cancel", ctx, "tok", domain.BookingStatusConfirmed).Return(&cancelled, nil)
+	bookings.On("ReleaseSeat", ctx, int64(2)).Return(nil)
+	wallet.On("Refund", ctx, int64(4), "booking cancelled").Return(&domain.PaymentReservation{
+		BookingID: 4, Miles: 3000, MilesValueCents: 3000, CreditCents: 1000, PriceCents: 10000,
+	}, nil).Once()
+	wallet.On("IssueCredit", ctx, mock.MatchedBy(func(e *domain.WalletEntry) bool {
+		return e.CustomerID == 9 && e.BookingID == 4 && e.AmountCents == 3000
+	})).Return(true, nil).Once()
+
+	_, err := service.CancelBooking(ctx, "tok")
+	require.NoError(t, err)
+
+	// The issued credit pays the next booking in full.
+	bookings.On("GetByToken", ctx, "next").Return(pending, nil)
+	wallet.On("Reserve", ctx, mock.MatchedBy(func(r *domain.PaymentReservation) bool {
+		return r.BookingID == 6 && r.CreditCents == 3000
+	})).Return(nil).Once()
+
+	res, err := service.ReservePayment(ctx, ReservePaymentInput{Token: "next", CreditCents: 3000})
+
+	require.NoError(t, err)
+	assert.Zero(t, res.AmountDueCents())
+	wallet.AssertExpectations(t)
+}
+
+func TestBookingService_CancelBooking_CashBookingEarnsNoCredit(t *testing.T) {
+	ctx := context.Background()
+	confirmed := &domain.Booking{ID: 5, FlightID: 2, Token: "cash", CustomerID: 9, Status: domain.BookingStatusConfirmed, PriceCents: 10000}
+	cancelled := *confirmed
+	cancelled.Status = domain.BookingStatusCancelled
+
+	service, bookings, wallet := newWalletTestService()
+	bookings.On("GetByToken", ctx, "cash").Return(confirmed, nil)
+	bookings.On("Cancel", ctx, "cash", domain.BookingStatusConfirmed).Return(&cancelled, nil)
+	bookings.On("ReleaseSeat", ctx, int64(2)).Return(nil)
+	wallet.On("Refund", ctx, int64(5), "booking cancelled").Return(nil, domain.ErrPaymentReservationNotFound).Once()
+
+	updated, err := service.CancelBooking(ctx, "cash")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.BookingStatusCancelled, updated.Status)
+	wallet.AssertExpectations(t)
+	wallet.AssertNotCalled(t, "IssueCredit", mock.Anything, mock.Anything)
+}
+
+func TestBookingService_ExpirePendingBookings_ReleasesReservations(t *testing.T) {
+	ctx := context.Background()
+	service, bookings, wallet := newWalletTestService()
+	expired := []domain.Booking{{ID: 4, FlightID: 2, Status: domain.BookingStatusExpired, ExpiresAt: time.Now()}}
+	bookings.On("ExpirePendingBefore", mock.Anything, mock.Anything).Return(expired, nil)
+	bookings.On("ReleaseSeat", ctx, int64(2)).Return(nil)
+	wallet.On("Release", ctx, int64(4)).Return(nil).Once()
+
+	_, err := service.ExpirePendingBookings(ctx)
+
+	require.NoError(t, err)
+	wallet.AssertExpectations(t)
+}
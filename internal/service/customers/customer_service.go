@@ -0,0 +1,221 @@
+package customers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type CustomerUseCase interface {
+	// Register creates an unverified account and emails a verification
+	// code to it.
+	Register(ctx context.Context, email, password string) (*domain.Customer, error)
+	// ResendVerification emails a new verification code to an unverified
+	// account. Unknown and verified emails are silently ignored.
+	ResendVerification(ctx context.Context, email string) error
+	// VerifyEmail verifies the account the code was sent to and claims the
+	// guest bookings made with its email. It returns the number of bookings
+	// claimed.
+	VerifyEmail(ctx context.Context, code string) (*domain.Customer, int, error)
+	// Login checks the password of a verified account and issues an access
+	// token.
+	Login(ctx context.Context, email, password string) (*Session, error)
+	// RequestClaim emails a code to email that lets the customer claim the
+	// guest bookings made with it.
+	RequestClaim(ctx context.Context, customerID int64, email string) error
+	// ClaimBookings links the bookings of the email a claim code was sent
+	// to, and returns their number.
+	ClaimBookings(ctx context.Context, customerID int64, code string) (int, error)
+}
+
+type Producer interface {
+	Publish(ctx context.Context, topic, key string, value interface{}) error
+}
+
+// Session is an access token issued at login.
+type Session struct {
+	AccessToken string
+	ExpiresAt   time.Time
+	Customer    *domain.Customer
+}
+
+var ErrLoginDisabled = &domain.InvalidStateError{Code: "CUSTOMER_LOGIN_DISABLED", Message: "customer login is not enabled"}
+
+const (
+	emailVerificationEvent = "customer_email_verification"
+	claimVerificationEvent = "booking_claim_verification"
+)
+
+type Service struct {
+	customers          repository.CustomerRepository
+	issuer             *auth.Issuer
+	producer           Producer
+	notificationsTopic string
+	verificationTTL    time.Duration
+}
+
+// NewService returns the customer account service. Verification codes are
+// sent as events to notificationsTopic and expire after verificationTTL.
+func NewService(customers repository.CustomerRepository, issuer *auth.Issuer, producer Producer, notificationsTopic string, verificationTTL time.Duration) *Service {
+	return &Service{
+		customers:          customers,
+		issuer:             issuer,
+		producer:           producer,
+		notificationsTopic: notificationsTopic,
+		verificationTTL:    verificationTTL,
+	}
+}
+
+func (s *Service) Register(ctx context.Context, email, password string) (*domain.Customer, error) {
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidatePassword(password); err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	customer := &domain.Customer{Email: email, PasswordHash: string(hash)}
+	if err := s.customers.Create(ctx, customer); err != nil {
+		return nil, err
+	}
+	// The account exists either way; a lost email is sent again by
+	// ResendVerification.
+	if err := s.sendCode(ctx, customer.ID, email, domain.VerificationPurposeEmail); err != nil {
+		log.Printf("WARNING: failed to send verification email to customer %d: %v", customer.ID, err)
+	}
+	return customer, nil
+}
+
+func (s *Service) ResendVerification(ctx context.Context, email string) error {
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	customer, err := s.customers.GetByEmail(ctx, email)
+	if errors.Is(err, domain.ErrCustomerNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if customer.Verified() {
+		return nil
+	}
+	return s.sendCode(ctx, customer.ID, email, domain.VerificationPurposeEmail)
+}
+
+func (s *Service) VerifyEmail(ctx context.Context, code string) (*domain.Customer, int, error) {
+	if strings.TrimSpace(code) == "" {
+		return nil, 0, domain.NewValidationError("code", "verification code is required")
+	}
+	return s.customers.VerifyEmail(ctx, domain.HashVerificationCode(code))
+}
+
+func (s *Service) Login(ctx context.Context, email, password string) (*Session, error) {
+	if !s.issuer.Enabled() {
+		return nil, ErrLoginDisabled
+	}
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	customer, err := s.customers.GetByEmail(ctx, email)
+	if errors.Is(err, domain.ErrCustomerNotFound) {
+		// Take as long as a wrong password would.
+		_ = bcrypt.CompareHashAndPassword(unknownCustomerHash(), []byte(password))
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(customer.PasswordHash), []byte(password)) != nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+	// Tokens grant access to the bookings made with their email, so only
+	// verified addresses get one.
+	if !customer.Verified() {
+		return nil, domain.ErrEmailNotVerified
+	}
+	token, expiresAt, err := s.issuer.IssueCustomerToken(customer.ID, customer.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{AccessToken: token, ExpiresAt: expiresAt, Customer: customer}, nil
+}
+
+func (s *Service) RequestClaim(ctx context.Context, customerID int64, email string) error {
+	customer, err := s.customers.GetByID(ctx, customerID)
+	if err != nil {
+		return err
+	}
+	if email == "" {
+		email = customer.Email
+	}
+	email, err = domain.NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	return s.sendCode(ctx, customer.ID, email, domain.VerificationPurposeClaim)
+}
+
+func (s *Service) ClaimBookings(ctx context.Context, customerID int64, code string) (int, error) {
+	if strings.TrimSpace(code) == "" {
+		return 0, domain.NewValidationError("code", "verification code is required")
+	}
+	return s.customers.ClaimBookings(ctx, customerID, domain.HashVerificationCode(code))
+}
+
+// sendCode stores a new verification code and has the notifications worker
+// email it.
+func (s *Service) sendCode(ctx context.Context, customerID int64, email string, purpose domain.VerificationPurpose) error {
+	code, verification, err := domain.NewEmailVerification(customerID, email, purpose, s.verificationTTL, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := s.customers.CreateVerification(ctx, &verification); err != nil {
+		return err
+	}
+	eventType := emailVerificationEvent
+	if purpose == domain.VerificationPurposeClaim {
+		eventType = claimVerificationEvent
+	}
+	if s.producer == nil || s.notificationsTopic == "" {
+		return fmt.Errorf("no notifications topic to send %s to", eventType)
+	}
+	return s.producer.Publish(ctx, s.notificationsTopic, email, kafka.BookingEvent{
+		Type:             eventType,
+		Email:            email,
+		ExpiresAt:        verification.ExpiresAt,
+		VerificationCode: code,
+	})
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+)
+
+// unknownCustomerHash is compared with the password of logins to unknown
+// emails, so that they cannot be told from wrong passwords by timing.
+func unknownCustomerHash() []byte {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte("unknown customer"), bcrypt.DefaultCost)
+	})
+	return dummyHash
+}
+
+var _ CustomerUseCase = (*Service)(nil)
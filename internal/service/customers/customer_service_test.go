@@ -0,0 +1,181 @@
+package customers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/auth"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type MockCustomerRepository struct {
+	mock.Mock
+}
+
+func (m *MockCustomerRepository) Create(ctx context.Context, customer *domain.Customer) error {
+	return m.Called(ctx, customer).Error(0)
+}
+
+func (m *MockCustomerRepository) GetByID(ctx context.Context, id int64) (*domain.Customer, error) {
+	args := m.Called(ctx, id)
+	customer, _ := args.Get(0).(*domain.Customer)
+	return customer, args.Error(1)
+}
+
+func (m *MockCustomerRepository) GetByEmail(ctx context.Context, email string) (*domain.Customer, error) {
+	args := m.Called(ctx, email)
+	customer, _ := args.Get(0).(*domain.Customer)
+	return customer, args.Error(1)
+}
+
+func (m *MockCustomerRepository) CreateVerification(ctx context.Context, verification *domain.EmailVerification) error {
+	return m.Called(ctx, verification).Error(0)
+}
+
+func (m *MockCustomerRepository) VerifyEmail(ctx context.Context, tokenHash string) (*domain.Customer, int, error) {
+	args := m.Called(ctx, tokenHash)
+	customer, _ := args.Get(0).(*domain.Customer)
+	return customer, args.Int(1), args.Error(2)
+}
+
+func (m *MockCustomerRepository) ClaimBookings(ctx context.Context, customerID int64, tokenHash string) (int, error) {
+	args := m.Called(ctx, customerID, tokenHash)
+	return args.Int(0), args.Error(1)
+}
+
+type MockProducer struct {
+	mock.Mock
+}
+
+func (m *MockProducer) Publish(ctx context.Context, topic, key string, value interface{}) error {
+	return m.Called(ctx, topic, key, value).Error(0)
+}
+
+var testSecret = []byte("s3cret")
+
+func newTestService(repo *MockCustomerRepository, producer *MockProducer) *Service {
+	issuer := auth.NewIssuer("airbooking", "airbooking-api", time.Hour, auth.SignWithHS256(testSecret))
+	return NewService(repo, issuer, producer, "notifications", 24*time.Hour)
+}
+
+func TestRegisterSendsVerificationCode(t *testing.T) {
+	repo := &MockCustomerRepository{}
+	producer := &MockProducer{}
+	svc := newTestService(repo, producer)
+	ctx := context.Background()
+
+	var stored *domain.EmailVerification
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Customer")).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.Customer).ID = 7
+	}).Return(nil).Once()
+	repo.On("CreateVerification", ctx, mock.AnythingOfType("*domain.EmailVerification")).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*domain.EmailVerification)
+	}).Return(nil).Once()
+	var event kafka.BookingEvent
+	producer.On("Publish", ctx, "notifications", "me@example.com", mock.AnythingOfType("kafka.BookingEvent")).Run(func(args mock.Arguments) {
+		event = args.Get(3).(kafka.BookingEvent)
+	}).Return(nil).Once()
+
+	customer, err := svc.Register(ctx, " Me@Example.com ", "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, "me@example.com", customer.Email)
+	assert.False(t, customer.Verified())
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(customer.PasswordHash), []byte("correct horse")))
+
+	assert.Equal(t, "customer_email_verification", event.Type)
+	require.NotEmpty(t, event.VerificationCode)
+	assert.Equal(t, domain.HashVerificationCode(event.VerificationCode), stored.TokenHash, "only the hash is stored")
+	assert.Equal(t, domain.VerificationPurposeEmail, stored.Purpose)
+	assert.Equal(t, int64(7), stored.CustomerID)
+	repo.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestRegisterValidation(t *testing.T) {
+	svc := newTestService(&MockCustomerRepository{}, &MockProducer{})
+	ctx := context.Background()
+
+	_, err := svc.Register(ctx, "not an email", "correct horse")
+	assert.Equal(t, "email", domain.Violations(err)[0].Field)
+	_, err = svc.Register(ctx, "me@example.com", "short")
+	assert.Equal(t, "password", domain.Violations(err)[0].Field)
+}
+
+func TestLogin(t *testing.T) {
+	repo := &MockCustomerRepository{}
+	svc := newTestService(repo, &MockProducer{})
+	ctx := context.Background()
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	verified := &domain.Customer{ID: 7, Email: "me@example.com", PasswordHash: string(hash), EmailVerifiedAt: time.Now()}
+	unverified := &domain.Customer{ID: 8, Email: "new@example.com", PasswordHash: string(hash)}
+	repo.On("GetByEmail", ctx, "me@example.com").Return(verified, nil)
+	repo.On("GetByEmail", ctx, "new@example.com").Return(unverified, nil)
+	repo.On("GetByEmail", ctx, "nobody@example.com").Return(nil, domain.ErrCustomerNotFound)
+
+	session, err := svc.Login(ctx, "ME@example.com", "correct horse")
+	require.NoError(t, err)
+	claims, err := auth.NewVerifier(auth.WithHS256Secret(testSecret), auth.WithIssuer("airbooking"), auth.WithAudience("airbooking-api")).Verify(session.AccessToken)
+	require.NoError(t, err)
+	id, ok := claims.CustomerID()
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), id)
+	assert.Equal(t, "me@example.com", claims.Email)
+
+	_, err = svc.Login(ctx, "me@example.com", "wrong horse")
+	assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	_, err = svc.Login(ctx, "nobody@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	_, err = svc.Login(ctx, "new@example.com", "correct horse")
+	assert.ErrorIs(t, err, domain.ErrEmailNotVerified)
+
+	disabled := NewService(repo, auth.NewIssuer("airbooking", "", time.Hour), nil, "", time.Hour)
+	_, err = disabled.Login(ctx, "me@example.com", "correct horse")
+	assert.ErrorIs(t, err, ErrLoginDisabled)
+}
+
+func TestClaimBookings(t *testing.T) {
+	repo := &MockCustomerRepository{}
+	producer := &MockProducer{}
+	svc := newTestService(repo, producer)
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, int64(7)).Return(&domain.Customer{ID: 7, Email: "me@example.com"}, nil)
+	repo.On("CreateVerification", ctx, mock.MatchedBy(func(v *domain.EmailVerification) bool {
+		return v.Purpose == domain.VerificationPurposeClaim && v.Email == "old@example.com" && v.CustomerID == 7
+	})).Return(nil).Once()
+	var event kafka.BookingEvent
+	producer.On("Publish", ctx, "notifications", "old@example.com", mock.AnythingOfType("kafka.BookingEvent")).Run(func(args mock.Arguments) {
+		event = args.Get(3).(kafka.BookingEvent)
+	}).Return(nil).Once()
+
+	require.NoError(t, svc.RequestClaim(ctx, 7, "Old@Example.com"))
+	assert.Equal(t, "booking_claim_verification", event.Type)
+
+	repo.On("ClaimBookings", ctx, int64(7), domain.HashVerificationCode(event.VerificationCode)).Return(3, nil).Once()
+	claimed, err := svc.ClaimBookings(ctx, 7, event.VerificationCode)
+	require.NoError(t, err)
+	assert.Equal(t, 3, claimed)
+
+	_, err = svc.ClaimBookings(ctx, 7, "")
+	assert.Equal(t, "code", domain.Violations(err)[0].Field)
+	repo.AssertExpectations(t)
+}
+
+func TestResendVerification(t *testing.T) {
+	repo := &MockCustomerRepository{}
+	svc := newTestService(repo, &MockProducer{})
+	ctx := context.Background()
+	repo.On("GetByEmail", ctx, "nobody@example.com").Return(nil, domain.ErrCustomerNotFound)
+	repo.On("GetByEmail", ctx, "me@example.com").Return(&domain.Customer{ID: 7, Email: "me@example.com", EmailVerifiedAt: time.Now()}, nil)
+
+	assert.NoError(t, svc.ResendVerification(ctx, "nobody@example.com"), "unknown emails are not revealed")
+	assert.NoError(t, svc.ResendVerification(ctx, "me@example.com"))
+	repo.AssertNotCalled(t, "CreateVerification", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,69 @@
+package exchangerates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+type ExchangeRateUseCase interface {
+	// ListRates returns the stored exchange rates ordered by pair.
+	ListRates(ctx context.Context) ([]domain.ExchangeRate, error)
+	// SetRates stores the rates of the given pairs; other pairs keep theirs.
+	// Nothing is stored when any rate is invalid.
+	SetRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error)
+	// Rates returns the stored exchange rates ready to convert prices.
+	Rates(ctx context.Context) (domain.ExchangeRates, error)
+}
+
+type Service struct {
+	rates repository.ExchangeRateRepository
+}
+
+func NewService(rates repository.ExchangeRateRepository) *Service {
+	return &Service{rates: rates}
+}
+
+func (s *Service) ListRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	return s.rates.List(ctx)
+}
+
+func (s *Service) SetRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error) {
+	if len(rates) == 0 {
+		return nil, domain.NewValidationError("rates", "at least one rate is required")
+	}
+	var violations []domain.FieldViolation
+	seen := make(map[[2]string]bool)
+	for i := range rates {
+		if err := rates[i].Validate(); err != nil {
+			for _, v := range domain.Violations(err) {
+				violations = append(violations, domain.FieldViolation{Field: fmt.Sprintf("rates[%d].%s", i, v.Field), Description: v.Description})
+			}
+			continue
+		}
+		pair := [2]string{rates[i].Base, rates[i].Quote}
+		if seen[pair] {
+			violations = append(violations, domain.FieldViolation{Field: fmt.Sprintf("rates[%d]", i), Description: "pair " + pair[0] + "/" + pair[1] + " is given twice"})
+		}
+		seen[pair] = true
+	}
+	if len(violations) > 0 {
+		return nil, &domain.ValidationError{Violations: violations}
+	}
+	if err := s.rates.Upsert(ctx, rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (s *Service) Rates(ctx context.Context) (domain.ExchangeRates, error) {
+	rates, err := s.rates.List(ctx)
+	if err != nil {
+		return domain.ExchangeRates{}, err
+	}
+	return domain.NewExchangeRates(rates), nil
+}
+
+var _ ExchangeRateUseCase = (*Service)(nil)
@@ -0,0 +1,73 @@
+package exchangerates
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) List(ctx context.Context) ([]domain.ExchangeRate, error) {
+	args := m.Called(ctx)
+	rates, _ := args.Get(0).([]domain.ExchangeRate)
+	return rates, args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) Upsert(ctx context.Context, rates []domain.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func TestService_SetRates(t *testing.T) {
+	repo := &MockExchangeRateRepository{}
+	svc := NewService(repo)
+	repo.On("Upsert", mock.Anything, mock.MatchedBy(func(rates []domain.ExchangeRate) bool {
+		return len(rates) == 1 && rates[0].Base == "EUR" && rates[0].Quote == "RUB"
+	})).Return(nil).Once()
+
+	rates, err := svc.SetRates(context.Background(), []domain.ExchangeRate{{Base: "eur", Quote: "rub", Rate: big.NewRat(9525, 100)}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "95.25", domain.FormatRate(rates[0].Rate))
+	repo.AssertExpectations(t)
+}
+
+func TestService_SetRates_Invalid(t *testing.T) {
+	repo := &MockExchangeRateRepository{}
+	svc := NewService(repo)
+
+	_, err := svc.SetRates(context.Background(), []domain.ExchangeRate{
+		{Base: "EUR", Quote: "RUB", Rate: big.NewRat(95, 1)},
+		{Base: "EUR", Quote: "XYZ", Rate: big.NewRat(1, 1)},
+		{Base: "EUR", Quote: "RUB", Rate: big.NewRat(96, 1)},
+	})
+
+	var validation *domain.ValidationError
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, []domain.FieldViolation{
+		{Field: "rates[1].quote", Description: "quote must be an ISO 4217 code"},
+		{Field: "rates[2]", Description: "pair EUR/RUB is given twice"},
+	}, validation.Violations)
+	repo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+}
+
+func TestService_Rates(t *testing.T) {
+	repo := &MockExchangeRateRepository{}
+	svc := NewService(repo)
+	repo.On("List", mock.Anything).Return([]domain.ExchangeRate{{Base: "EUR", Quote: "RUB", Rate: big.NewRat(100, 1)}}, nil)
+
+	rates, err := svc.Rates(context.Background())
+	require.NoError(t, err)
+
+	converted, err := rates.Convert(domain.Money{Cents: 10000, Currency: "RUB"}, "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), converted.Cents)
+}
@@ -0,0 +1,47 @@
+package exchangerates
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+)
+
+// ParseCSV reads exchange rates from CSV records of base currency, quote
+// currency and rate, e.g. "EUR,RUB,95.25". A header row starting with
+// "base", empty lines and lines starting with # are skipped. Malformed input
+// fails with a validation error of the csv field; the rates themselves are
+// validated when they are set.
+func ParseCSV(r io.Reader) ([]domain.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	var rates []domain.ExchangeRate
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, domain.NewValidationError("csv", err.Error())
+		}
+		line, _ := reader.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "base") {
+			continue
+		}
+		rate, err := domain.ParseRate(record[2])
+		if err != nil {
+			return nil, domain.NewValidationError("csv", fmt.Sprintf("line %d: %v", line, err))
+		}
+		rates = append(rates, domain.ExchangeRate{Base: record[0], Quote: record[1], Rate: rate})
+	}
+	if len(rates) == 0 {
+		return nil, domain.NewValidationError("csv", "no exchange rates found")
+	}
+	return rates, nil
+}
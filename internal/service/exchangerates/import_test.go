@@ -0,0 +1,29 @@
+package exchangerates
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV(t *testing.T) {
+	rates, err := ParseCSV(strings.NewReader("base,quote,rate\n# ECB, 2026-10-16\nEUR,RUB,95.25\n\nUSD, JPY, 150.1\n"))
+
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "EUR", rates[0].Base)
+	assert.Equal(t, "RUB", rates[0].Quote)
+	assert.Equal(t, "95.25", domain.FormatRate(rates[0].Rate))
+	assert.Equal(t, "JPY", rates[1].Quote)
+}
+
+func TestParseCSV_Malformed(t *testing.T) {
+	for _, input := range []string{"", "EUR,RUB\n", "EUR,RUB,abc\n"} {
+		_, err := ParseCSV(strings.NewReader(input))
+		var validation *domain.ValidationError
+		assert.ErrorAs(t, err, &validation, "input %q", input)
+	}
+}
@@ -0,0 +1,163 @@
+package flights
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+const flightUpdatedEvent = "flight_updated"
+
+var ErrDepartureInPast = domain.NewValidationError("departure_time", "departure time must be in the future")
+
+type FlightAdminUseCase interface {
+	CreateFlight(ctx context.Context, input FlightInput) (*domain.Flight, error)
+	UpdateFlight(ctx context.Context, id int64, input FlightInput) (*domain.Flight, error)
+	DeleteFlight(ctx context.Context, id int64) error
+}
+
+// FlightsInvalidator drops the cached flights list.
+type FlightsInvalidator interface {
+	InvalidateFlights(ctx context.Context) error
+}
+
+type Producer interface {
+	Publish(ctx context.Context, topic, key string, value interface{}) error
+}
+
+type FlightInput struct {
+	FromAirport   string
+	ToAirport     string
+	DepartureTime time.Time
+	ArrivalTime   time.Time
+	TotalSeats    int
+	PriceCents    int64
+	// Currency is the ISO 4217 code of PriceCents; empty means
+	// domain.DefaultCurrency.
+	Currency string
+	// OverbookingLimit is the number of seats that may be sold above TotalSeats.
+	OverbookingLimit int
+}
+
+type AdminService struct {
+	repo     repository.FlightRepository
+	cache    FlightsInvalidator
+	producer Producer
+	topic    string
+	now      func() time.Time
+}
+
+func NewAdminService(repo repository.FlightRepository, cache FlightsInvalidator, producer Producer, topic string) *AdminService {
+	return &AdminService{repo: repo, cache: cache, producer: producer, topic: topic, now: time.Now}
+}
+
+func (s *AdminService) CreateFlight(ctx context.Context, input FlightInput) (*domain.Flight, error) {
+	flight := input.toDomain()
+	if err := s.validate(ctx, flight); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, flight)
+	if err != nil {
+		return nil, err
+	}
+	s.changed(ctx, "created", created)
+	return created, nil
+}
+
+func (s *AdminService) UpdateFlight(ctx context.Context, id int64, input FlightInput) (*domain.Flight, error) {
+	flight := input.toDomain()
+	flight.ID = id
+	if err := s.validate(ctx, flight); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, flight)
+	if err != nil {
+		return nil, err
+	}
+	s.changed(ctx, "updated", updated)
+	return updated, nil
+}
+
+func (s *AdminService) DeleteFlight(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.changed(ctx, "deleted", &domain.Flight{ID: id})
+	return nil
+}
+
+func (s *AdminService) validate(ctx context.Context, flight *domain.Flight) error {
+	if err := flight.Validate(); err != nil {
+		return err
+	}
+	currency, err := domain.ParseCurrency(flight.Currency)
+	if err != nil {
+		return err
+	}
+	flight.Currency = currency
+	if !flight.DepartureTime.After(s.now()) {
+		return ErrDepartureInPast
+	}
+	for _, code := range []string{flight.FromAirport, flight.ToAirport} {
+		exists, err := s.repo.AirportExists(ctx, code)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("%w: %s", domain.ErrAirportNotFound, code)
+		}
+	}
+	return nil
+}
+
+// changed invalidates the flights cache and publishes a flight_updated event.
+// Both are best effort: the write is already committed.
+func (s *AdminService) changed(ctx context.Context, action string, flight *domain.Flight) {
+	if s.cache != nil {
+		if err := s.cache.InvalidateFlights(ctx); err != nil {
+			log.Printf("WARNING: failed to invalidate flights cache: %v", err)
+		}
+	}
+	if s.producer == nil || s.topic == "" {
+		return
+	}
+	event := kafka.FlightEvent{
+		Type:           flightUpdatedEvent,
+		Action:         action,
+		FlightID:       flight.ID,
+		FromAirport:    flight.FromAirport,
+		ToAirport:      flight.ToAirport,
+		DepartureTime:  flight.DepartureTime,
+		ArrivalTime:    flight.ArrivalTime,
+		TotalSeats:     flight.TotalSeats,
+		AvailableSeats: flight.AvailableSeats,
+		PriceCents:     flight.PriceCents,
+		Currency:       flight.Currency,
+	}
+	if err := s.producer.Publish(ctx, s.topic, strconv.FormatInt(flight.ID, 10), event); err != nil {
+		log.Printf("WARNING: failed to publish %s event for flight %d: %v", flightUpdatedEvent, flight.ID, err)
+	}
+}
+
+func (in FlightInput) toDomain() *domain.Flight {
+	return &domain.Flight{
+		FromAirport:      in.FromAirport,
+		ToAirport:        in.ToAirport,
+		DepartureTime:    in.DepartureTime,
+		ArrivalTime:      in.ArrivalTime,
+		TotalSeats:       in.TotalSeats,
+		PriceCents:       in.PriceCents,
+		Currency:         in.Currency,
+		OverbookingLimit: in.OverbookingLimit,
+	}
+}
+
+var _ FlightAdminUseCase = (*AdminService)(nil)
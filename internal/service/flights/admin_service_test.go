@@ -0,0 +1,177 @@
+package flights
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockInvalidator struct {
+	mock.Mock
+}
+
+func (m *MockInvalidator) InvalidateFlights(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+type MockProducer struct {
+	mock.Mock
+}
+
+func (m *MockProducer) Publish(ctx context.Context, topic, key string, value interface{}) error {
+	args := m.Called(ctx, topic, key, value)
+	return args.Error(0)
+}
+
+var adminNow = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestAdminService() (*AdminService, *MockFlightRepository, *MockInvalidator, *MockProducer) {
+	repo := &MockFlightRepository{}
+	cache := &MockInvalidator{}
+	producer := &MockProducer{}
+	service := NewAdminService(repo, cache, producer, "flight-events")
+	service.now = func() time.Time { return adminNow }
+	return service, repo, cache, producer
+}
+
+func validFlightInput() FlightInput {
+	return FlightInput{
+		FromAirport:   "SVO",
+		ToAirport:     "LED",
+		DepartureTime: adminNow.Add(24 * time.Hour),
+		ArrivalTime:   adminNow.Add(25 * time.Hour),
+		TotalSeats:    150,
+		PriceCents:    500000,
+	}
+}
+
+func TestAdminService_CreateFlight_Success(t *testing.T) {
+	service, repo, cache, producer := newTestAdminService()
+	ctx := context.Background()
+
+	created := &domain.Flight{ID: 7, FromAirport: "SVO", ToAirport: "LED", TotalSeats: 150, AvailableSeats: 150}
+	repo.On("AirportExists", ctx, "SVO").Return(true, nil).Once()
+	repo.On("AirportExists", ctx, "LED").Return(true, nil).Once()
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Flight")).Return(created, nil).Once()
+	cache.On("InvalidateFlights", ctx).Return(nil).Once()
+	producer.On("Publish", ctx, "flight-events", "7", mock.MatchedBy(func(e kafka.FlightEvent) bool {
+		return e.Type == "flight_updated" && e.Action == "created" && e.FlightID == 7
+	})).Return(nil).Once()
+
+	flight, err := service.CreateFlight(ctx, validFlightInput())
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, flight)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestAdminService_CreateFlight_ValidationErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(in *FlightInput)
+		err    error
+	}{
+		{"arrival before departure", func(in *FlightInput) { in.ArrivalTime = in.DepartureTime.Add(-time.Minute) }, domain.ErrArrivalBeforeDeparture},
+		{"departure in past", func(in *FlightInput) {
+			in.DepartureTime = adminNow.Add(-time.Hour)
+			in.ArrivalTime = adminNow.Add(time.Hour)
+		}, ErrDepartureInPast},
+		{"no seats", func(in *FlightInput) { in.TotalSeats = 0 }, domain.ErrInvalidTotalSeats},
+		{"same airports", func(in *FlightInput) { in.ToAirport = in.FromAirport }, domain.ErrSameAirports},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, repo, _, _ := newTestAdminService()
+			input := validFlightInput()
+			tc.modify(&input)
+
+			flight, err := service.CreateFlight(context.Background(), input)
+
+			assert.Nil(t, flight)
+			assert.ErrorIs(t, err, tc.err)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAdminService_CreateFlight_UnknownAirport(t *testing.T) {
+	service, repo, _, _ := newTestAdminService()
+	ctx := context.Background()
+
+	repo.On("AirportExists", ctx, "SVO").Return(false, nil).Once()
+
+	flight, err := service.CreateFlight(ctx, validFlightInput())
+
+	assert.Nil(t, flight)
+	assert.ErrorIs(t, err, domain.ErrAirportNotFound)
+	assert.Contains(t, err.Error(), "SVO")
+	repo.AssertExpectations(t)
+}
+
+func TestAdminService_UpdateFlight_SeatsBelowBooked(t *testing.T) {
+	service, repo, cache, producer := newTestAdminService()
+	ctx := context.Background()
+
+	repo.On("AirportExists", ctx, mock.Anything).Return(true, nil)
+	repo.On("Update", ctx, mock.MatchedBy(func(f *domain.Flight) bool { return f.ID == 3 })).Return(nil, domain.ErrTotalSeatsBelowBooked).Once()
+
+	flight, err := service.UpdateFlight(ctx, 3, validFlightInput())
+
+	assert.Nil(t, flight)
+	assert.ErrorIs(t, err, domain.ErrTotalSeatsBelowBooked)
+	cache.AssertNotCalled(t, "InvalidateFlights", mock.Anything)
+	producer.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdminService_UpdateFlight_PublishFailureIsNotFatal(t *testing.T) {
+	service, repo, cache, producer := newTestAdminService()
+	ctx := context.Background()
+
+	updated := &domain.Flight{ID: 3, TotalSeats: 150, AvailableSeats: 120}
+	repo.On("AirportExists", ctx, mock.Anything).Return(true, nil)
+	repo.On("Update", ctx, mock.Anything).Return(updated, nil).Once()
+	cache.On("InvalidateFlights", ctx).Return(errors.New("redis down")).Once()
+	producer.On("Publish", ctx, "flight-events", "3", mock.Anything).Return(errors.New("kafka down")).Once()
+
+	flight, err := service.UpdateFlight(ctx, 3, validFlightInput())
+
+	assert.NoError(t, err)
+	assert.Equal(t, updated, flight)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestAdminService_DeleteFlight(t *testing.T) {
+	service, repo, cache, producer := newTestAdminService()
+	ctx := context.Background()
+
+	repo.On("Delete", ctx, int64(5)).Return(nil).Once()
+	cache.On("InvalidateFlights", ctx).Return(nil).Once()
+	producer.On("Publish", ctx, "flight-events", "5", mock.MatchedBy(func(e kafka.FlightEvent) bool {
+		return e.Action == "deleted"
+	})).Return(nil).Once()
+
+	assert.NoError(t, service.DeleteFlight(ctx, 5))
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestAdminService_DeleteFlight_HasBookings(t *testing.T) {
+	service, repo, cache, _ := newTestAdminService()
+	ctx := context.Background()
+
+	repo.On("Delete", ctx, int64(5)).Return(domain.ErrFlightHasBookings).Once()
+
+	assert.ErrorIs(t, service.DeleteFlight(ctx, 5), domain.ErrFlightHasBookings)
+	cache.AssertNotCalled(t, "InvalidateFlights", mock.Anything)
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/Domenick1991/airbooking/internal/domain"
 	"github.com/Domenick1991/airbooking/internal/repository"
-	"github.com/Domenick1991/airbooking/internal/service/booking"
 )
 
 type FlightUseCase interface {
@@ -16,7 +15,7 @@ type FlightUseCase interface {
 
 type FlightService struct {
 	repo     repository.FlightRepository
-	cache    booking.Cache // Указатель на структуру
+	cache    FlightCache
 	cacheTTL time.Duration
 }
 
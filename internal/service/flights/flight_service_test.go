@@ -38,6 +38,57 @@ func (m *MockFlightRepository) ReleaseSeat(ctx context.Context, flightID int64)
 	return args.Error(0)
 }
 
+func (m *MockFlightRepository) Create(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	args := m.Called(ctx, flight)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Flight), args.Error(1)
+}
+
+func (m *MockFlightRepository) Update(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	args := m.Called(ctx, flight)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Flight), args.Error(1)
+}
+
+func (m *MockFlightRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockFlightRepository) AirportExists(ctx context.Context, code string) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFlightRepository) Cancel(ctx context.Context, id int64) (*domain.Flight, []domain.Booking, error) {
+	args := m.Called(ctx, id)
+	flight, _ := args.Get(0).(*domain.Flight)
+	bookings, _ := args.Get(1).([]domain.Booking)
+	return flight, bookings, args.Error(2)
+}
+
+func (m *MockFlightRepository) FindAlternatives(ctx context.Context, flight *domain.Flight, window time.Duration, limit int) ([]domain.Flight, error) {
+	args := m.Called(ctx, flight, window, limit)
+	flights, _ := args.Get(0).([]domain.Flight)
+	return flights, args.Error(1)
+}
+
+func (m *MockFlightRepository) UpdateStatus(ctx context.Context, flight *domain.Flight, previousStatus domain.FlightStatus, previousUpdate time.Time) (*domain.Flight, error) {
+	args := m.Called(ctx, flight, previousStatus, previousUpdate)
+	updated, _ := args.Get(0).(*domain.Flight)
+	return updated, args.Error(1)
+}
+
+func (m *MockFlightRepository) ListOversold(ctx context.Context, departingBefore time.Time) ([]domain.Flight, error) {
+	args := m.Called(ctx, departingBefore)
+	flights, _ := args.Get(0).([]domain.Flight)
+	return flights, args.Error(1)
+}
+
 type MockCache struct {
 	mock.Mock
 }
@@ -0,0 +1,225 @@
+package loyalty
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+type LoyaltyUseCase interface {
+	// Enroll opens the loyalty account of a customer.
+	Enroll(ctx context.Context, customerID int64) (*Account, error)
+	// GetAccount returns the balance and tier of the loyalty account of a
+	// customer.
+	GetAccount(ctx context.Context, customerID int64) (*Account, error)
+	// ListHistory returns a page of the miles ledger of a customer, newest
+	// first, and the token of the next page.
+	ListHistory(ctx context.Context, customerID int64, input ListHistoryInput) ([]domain.LoyaltyLedgerEntry, string, error)
+	// AccrueFlownBooking credits the miles of a flown booking to the
+	// loyalty account of its customer and requalifies the tier. Bookings of
+	// non-members and bookings that already accrued return a nil entry.
+	AccrueFlownBooking(ctx context.Context, token string) (*domain.LoyaltyLedgerEntry, error)
+	// HandleBookingEvent accrues the bookings of booking_flown events from
+	// the booking events topic.
+	HandleBookingEvent(ctx context.Context, event kafka.BookingEvent) error
+}
+
+// Account is the state of a loyalty account.
+type Account struct {
+	Member *domain.LoyaltyMember
+	// Tier is the tier held now; an expired tier is none.
+	Tier    domain.LoyaltyTier
+	Balance int64
+	// QualifyingMiles earned within the qualification window.
+	QualifyingMiles int64
+	// NextTier is none at the top tier.
+	NextTier        domain.LoyaltyTier
+	MilesToNextTier int64
+}
+
+// ListHistoryInput selects a page of the ledger.
+type ListHistoryInput struct {
+	// PageSize defaults to 20 and is capped at 100.
+	PageSize int
+	// PageToken is the token returned with the previous page.
+	PageToken string
+}
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+const bookingFlownEvent = "booking_flown"
+
+type Service struct {
+	loyalty  repository.LoyaltyRepository
+	bookings repository.BookingRepository
+	flights  repository.FlightRepository
+	rules    domain.LoyaltyRules
+	now      func() time.Time
+}
+
+// NewService returns the loyalty service accruing miles under rules.
+func NewService(loyalty repository.LoyaltyRepository, bookings repository.BookingRepository, flights repository.FlightRepository, rules domain.LoyaltyRules) *Service {
+	return &Service{loyalty: loyalty, bookings: bookings, flights: flights, rules: rules, now: time.Now}
+}
+
+func (s *Service) Enroll(ctx context.Context, customerID int64) (*Account, error) {
+	member := &domain.LoyaltyMember{CustomerID: customerID}
+	if err := s.loyalty.CreateMember(ctx, member); err != nil {
+		return nil, err
+	}
+	return s.account(ctx, member)
+}
+
+func (s *Service) GetAccount(ctx context.Context, customerID int64) (*Account, error) {
+	member, err := s.loyalty.GetMemberByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.account(ctx, member)
+}
+
+func (s *Service) account(ctx context.Context, member *domain.LoyaltyMember) (*Account, error) {
+	now := s.now()
+	balance, qualifying, err := s.loyalty.Balance(ctx, member.ID, now.Add(-s.rules.QualificationWindow))
+	if err != nil {
+		return nil, err
+	}
+	next, missing := s.rules.NextTier(qualifying)
+	return &Account{
+		Member:          member,
+		Tier:            member.TierAt(now),
+		Balance:         balance,
+		QualifyingMiles: qualifying,
+		NextTier:        next,
+		MilesToNextTier: missing,
+	}, nil
+}
+
+func (s *Service) ListHistory(ctx context.Context, customerID int64, input ListHistoryInput) ([]domain.LoyaltyLedgerEntry, string, error) {
+	page := domain.LedgerPage{Limit: input.PageSize}
+	switch {
+	case input.PageSize < 0:
+		return nil, "", domain.NewValidationError("page_size", "page size must not be negative")
+	case input.PageSize == 0:
+		page.Limit = defaultPageSize
+	case input.PageSize > maxPageSize:
+		page.Limit = maxPageSize
+	}
+	if input.PageToken != "" {
+		id, err := decodePageToken(input.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		page.BeforeID = id
+	}
+	member, err := s.loyalty.GetMemberByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := page.Limit
+	page.Limit++
+	entries, err := s.loyalty.ListEntries(ctx, member.ID, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) <= limit {
+		return entries, "", nil
+	}
+	entries = entries[:limit]
+	return entries, encodePageToken(entries[len(entries)-1].ID), nil
+}
+
+func (s *Service) AccrueFlownBooking(ctx context.Context, token string) (*domain.LoyaltyLedgerEntry, error) {
+	b, err := s.bookings.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if b.Status != domain.BookingStatusFlown {
+		return nil, &domain.BookingTransitionError{From: b.Status, To: domain.BookingStatusFlown}
+	}
+	if b.CustomerID == 0 {
+		return nil, nil
+	}
+	member, err := s.loyalty.GetMemberByCustomer(ctx, b.CustomerID)
+	if errors.Is(err, domain.ErrLoyaltyMemberNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	flight, err := s.flights.GetByID(ctx, b.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	from, err := s.loyalty.AirportLocation(ctx, flight.FromAirport)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", flight.FromAirport, err)
+	}
+	to, err := s.loyalty.AirportLocation(ctx, flight.ToAirport)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", flight.ToAirport, err)
+	}
+
+	now := s.now()
+	distance := domain.GreatCircleMiles(from, to)
+	miles, qualifying := s.rules.Accrual(distance, b.FareClass, member.TierAt(now))
+	entry := &domain.LoyaltyLedgerEntry{
+		MemberID:        member.ID,
+		Miles:           miles,
+		QualifyingMiles: qualifying,
+		BookingID:       b.ID,
+		Description:     fmt.Sprintf("%s-%s %s, %d mi", flight.FromAirport, flight.ToAirport, b.FareClass, distance),
+	}
+	added, err := s.loyalty.Accrue(ctx, entry)
+	if err != nil || !added {
+		return nil, err
+	}
+
+	_, inWindow, err := s.loyalty.Balance(ctx, member.ID, now.Add(-s.rules.QualificationWindow))
+	if err != nil {
+		return entry, err
+	}
+	tier, expiresAt := s.rules.Requalify(*member, inWindow, now)
+	if tier != member.Tier || !expiresAt.Equal(member.TierExpiresAt) {
+		if _, err := s.loyalty.SetTier(ctx, member.ID, tier, expiresAt); err != nil {
+			return entry, err
+		}
+	}
+	return entry, nil
+}
+
+func (s *Service) HandleBookingEvent(ctx context.Context, event kafka.BookingEvent) error {
+	if event.Type != bookingFlownEvent {
+		return nil
+	}
+	_, err := s.AccrueFlownBooking(ctx, event.Token)
+	return err
+}
+
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		if id, err := strconv.ParseInt(string(raw), 10, 64); err == nil && id > 0 {
+			return id, nil
+		}
+	}
+	return 0, domain.NewValidationError("page_token", "invalid page token")
+}
+
+var _ LoyaltyUseCase = (*Service)(nil)
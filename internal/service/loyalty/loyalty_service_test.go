@@ -0,0 +1,228 @@
+package loyalty
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLoyaltyRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoyaltyRepository) CreateMember(ctx context.Context, member *domain.LoyaltyMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockLoyaltyRepository) GetMemberByCustomer(ctx context.Context, customerID int64) (*domain.LoyaltyMember, error) {
+	args := m.Called(ctx, customerID)
+	member, _ := args.Get(0).(*domain.LoyaltyMember)
+	return member, args.Error(1)
+}
+
+func (m *MockLoyaltyRepository) Balance(ctx context.Context, memberID int64, qualifyingSince time.Time) (int64, int64, error) {
+	args := m.Called(ctx, memberID, qualifyingSince)
+	return args.Get(0).(int64), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockLoyaltyRepository) ListEntries(ctx context.Context, memberID int64, page domain.LedgerPage) ([]domain.LoyaltyLedgerEntry, error) {
+	args := m.Called(ctx, memberID, page)
+	entries, _ := args.Get(0).([]domain.LoyaltyLedgerEntry)
+	return entries, args.Error(1)
+}
+
+func (m *MockLoyaltyRepository) Accrue(ctx context.Context, entry *domain.LoyaltyLedgerEntry) (bool, error) {
+	args := m.Called(ctx, entry)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLoyaltyRepository) SetTier(ctx context.Context, memberID int64, tier domain.LoyaltyTier, expiresAt time.Time) (*domain.LoyaltyMember, error) {
+	args := m.Called(ctx, memberID, tier, expiresAt)
+	member, _ := args.Get(0).(*domain.LoyaltyMember)
+	return member, args.Error(1)
+}
+
+func (m *MockLoyaltyRepository) AirportLocation(ctx context.Context, code string) (domain.GeoPoint, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).(domain.GeoPoint), args.Error(1)
+}
+
+// MockBookingRepository implements only GetByToken; the embedded interface
+// makes any other call panic.
+type MockBookingRepository struct {
+	mock.Mock
+	repository.BookingRepository
+}
+
+func (m *MockBookingRepository) GetByToken(ctx context.Context, token string) (*domain.Booking, error) {
+	args := m.Called(ctx, token)
+	b, _ := args.Get(0).(*domain.Booking)
+	return b, args.Error(1)
+}
+
+// MockFlightRepository implements only GetByID.
+type MockFlightRepository struct {
+	mock.Mock
+	repository.FlightRepository
+}
+
+func (m *MockFlightRepository) GetByID(ctx context.Context, id int64) (*domain.Flight, error) {
+	args := m.Called(ctx, id)
+	flight, _ := args.Get(0).(*domain.Flight)
+	return flight, args.Error(1)
+}
+
+var (
+	testNow = time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC)
+	svo     = domain.GeoPoint{Latitude: 55.9726, Longitude: 37.4146}
+	ovb     = domain.GeoPoint{Latitude: 55.0126, Longitude: 82.6507}
+)
+
+func newTestService() (*Service, *MockLoyaltyRepository, *MockBookingRepository, *MockFlightRepository) {
+	loyalty, bookings, flights := &MockLoyaltyRepository{}, &MockBookingRepository{}, &MockFlightRepository{}
+	svc := NewService(loyalty, bookings, flights, domain.DefaultLoyaltyRules())
+	svc.now = func() time.Time { return testNow }
+	return svc, loyalty, bookings, flights
+}
+
+func since() time.Time {
+	return testNow.Add(-365 * 24 * time.Hour)
+}
+
+func expectFlownBooking(bookings *MockBookingRepository, flights *MockFlightRepository, loyalty *MockLoyaltyRepository, class domain.FareClass) {
+	bookings.On("GetByToken", mock.Anything, "tok").Return(&domain.Booking{
+		ID: 9, FlightID: 3, CustomerID: 5, Status: domain.BookingStatusFlown, FareClass: class,
+	}, nil)
+	flights.On("GetByID", mock.Anything, int64(3)).Return(&domain.Flight{ID: 3, FromAirport: "SVO", ToAirport: "OVB"}, nil)
+	loyalty.On("AirportLocation", mock.Anything, "SVO").Return(svo, nil)
+	loyalty.On("AirportLocation", mock.Anything, "OVB").Return(ovb, nil)
+}
+
+func TestService_AccrueFlownBooking_CreditsMilesAndQualifiesTier(t *testing.T) {
+	svc, loyalty, bookings, flights := newTestService()
+	ctx := context.Background()
+	expectFlownBooking(bookings, flights, loyalty, domain.FareClassBusiness)
+	member := &domain.LoyaltyMember{ID: 2, CustomerID: 5}
+	loyalty.On("GetMemberByCustomer", mock.Anything, int64(5)).Return(member, nil)
+	loyalty.On("Accrue", mock.Anything, mock.MatchedBy(func(e *domain.LoyaltyLedgerEntry) bool {
+		return e.MemberID == 2 && e.BookingID == 9 && e.Miles == e.QualifyingMiles
+	})).Return(true, nil)
+	loyalty.On("Balance", mock.Anything, int64(2), since()).Return(int64(26000), int64(26000), nil)
+	loyalty.On("SetTier", mock.Anything, int64(2), domain.LoyaltyTierSilver, testNow.Add(365*24*time.Hour)).Return(member, nil)
+
+	entry, err := svc.AccrueFlownBooking(ctx, "tok")
+
+	assert.NoError(t, err)
+	distance := domain.GreatCircleMiles(svo, ovb)
+	assert.Equal(t, distance*150/100, entry.Miles)
+	assert.Contains(t, entry.Description, "SVO-OVB BUSINESS")
+	loyalty.AssertExpectations(t)
+}
+
+func TestService_AccrueFlownBooking_TierBonus(t *testing.T) {
+	svc, loyalty, bookings, flights := newTestService()
+	expectFlownBooking(bookings, flights, loyalty, domain.FareClassEconomy)
+	expires := testNow.Add(24 * time.Hour)
+	loyalty.On("GetMemberByCustomer", mock.Anything, int64(5)).Return(&domain.LoyaltyMember{ID: 2, Tier: domain.LoyaltyTierGold, TierExpiresAt: expires}, nil)
+	loyalty.On("Accrue", mock.Anything, mock.Anything).Return(true, nil)
+	loyalty.On("Balance", mock.Anything, int64(2), since()).Return(int64(30000), int64(30000), nil)
+
+	entry, err := svc.AccrueFlownBooking(context.Background(), "tok")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entry.QualifyingMiles*150/100, entry.Miles)
+	loyalty.AssertNotCalled(t, "SetTier", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_AccrueFlownBooking_SkipsNonMembersAndRepeats(t *testing.T) {
+	svc, loyalty, bookings, flights := newTestService()
+	expectFlownBooking(bookings, flights, loyalty, domain.FareClassEconomy)
+	loyalty.On("GetMemberByCustomer", mock.Anything, int64(5)).Return(nil, domain.ErrLoyaltyMemberNotFound).Once()
+
+	entry, err := svc.AccrueFlownBooking(context.Background(), "tok")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	loyalty.On("GetMemberByCustomer", mock.Anything, int64(5)).Return(&domain.LoyaltyMember{ID: 2}, nil)
+	loyalty.On("Accrue", mock.Anything, mock.Anything).Return(false, nil)
+
+	entry, err = svc.AccrueFlownBooking(context.Background(), "tok")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+	loyalty.AssertNotCalled(t, "Balance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_AccrueFlownBooking_RequiresFlown(t *testing.T) {
+	svc, _, bookings, _ := newTestService()
+	bookings.On("GetByToken", mock.Anything, "tok").Return(&domain.Booking{Status: domain.BookingStatusConfirmed, CustomerID: 5}, nil)
+
+	_, err := svc.AccrueFlownBooking(context.Background(), "tok")
+
+	var transition *domain.BookingTransitionError
+	assert.ErrorAs(t, err, &transition)
+}
+
+func TestService_HandleBookingEvent_OnlyFlown(t *testing.T) {
+	svc, _, bookings, _ := newTestService()
+
+	assert.NoError(t, svc.HandleBookingEvent(context.Background(), kafka.BookingEvent{Type: "booking_confirmed", Token: "tok"}))
+	bookings.AssertNotCalled(t, "GetByToken", mock.Anything, mock.Anything)
+
+	bookings.On("GetByToken", mock.Anything, "tok").Return(&domain.Booking{Status: domain.BookingStatusFlown}, nil)
+	assert.NoError(t, svc.HandleBookingEvent(context.Background(), kafka.BookingEvent{Type: "booking_flown", Token: "tok"}))
+	bookings.AssertExpectations(t)
+}
+
+func TestService_GetAccount(t *testing.T) {
+	svc, loyalty, _, _ := newTestService()
+	member := &domain.LoyaltyMember{ID: 2, Tier: domain.LoyaltyTierSilver, TierExpiresAt: testNow.Add(-time.Hour)}
+	loyalty.On("GetMemberByCustomer", mock.Anything, int64(5)).Return(member, nil)
+	loyalty.On("Balance", mock.Anything, int64(2), since()).Return(int64(12000), int64(20000), nil)
+
+	account, err := svc.GetAccount(context.Background(), 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.LoyaltyTierNone, account.Tier, "an expired tier is not held")
+	assert.Equal(t, int64(12000), account.Balance)
+	assert.Equal(t, domain.LoyaltyTierSilver, account.NextTier)
+	assert.Equal(t, int64(5000), account.MilesToNextTier)
+}
+
+func TestService_Enroll_AlreadyMember(t *testing.T) {
+	svc, loyalty, _, _ := newTestService()
+	loyalty.On("CreateMember", mock.Anything, mock.Anything).Return(domain.ErrAlreadyLoyaltyMember)
+
+	_, err := svc.Enroll(context.Background(), 5)
+
+	assert.ErrorIs(t, err, domain.ErrAlreadyLoyaltyMember)
+}
+
+func TestService_ListHistory_Pages(t *testing.T) {
+	svc, loyalty, _, _ := newTestService()
+	loyalty.On("GetMemberByCustomer", mock.Anything, int64(5)).Return(&domain.LoyaltyMember{ID: 2}, nil)
+	loyalty.On("ListEntries", mock.Anything, int64(2), domain.LedgerPage{Limit: 3}).
+		Return([]domain.LoyaltyLedgerEntry{{ID: 9}, {ID: 7}, {ID: 4}}, nil)
+
+	entries, next, err := svc.ListHistory(context.Background(), 5, ListHistoryInput{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.NotEmpty(t, next)
+
+	loyalty.On("ListEntries", mock.Anything, int64(2), domain.LedgerPage{BeforeID: 7, Limit: 3}).
+		Return([]domain.LoyaltyLedgerEntry{{ID: 4}}, nil)
+	entries, next, err = svc.ListHistory(context.Background(), 5, ListHistoryInput{PageSize: 2, PageToken: next})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Empty(t, next)
+
+	_, _, err = svc.ListHistory(context.Background(), 5, ListHistoryInput{PageToken: "!"})
+	var validation *domain.ValidationError
+	assert.ErrorAs(t, err, &validation)
+}
@@ -0,0 +1,573 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/audit"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+const (
+	flightCancelledEvent   = "flight_cancelled"
+	bookingRebookedEvent   = "booking_rebooked"
+	rebookingOfferedEvent  = "booking_rebooking_offered"
+	bookingCancelledEvent  = "booking_cancelled"
+	statusChangedEvent     = "flight_status_changed"
+	deniedBoardingEvent    = "booking_denied_boarding"
+	defaultMaxAlternatives = 3
+	defaultOversoldWindow  = 72 * time.Hour
+)
+
+var ErrDeniedBoardingDisabled = &domain.InvalidStateError{Code: "DENIED_BOARDING_DISABLED", Message: "denied boarding is not enabled"}
+
+// OperationsUseCase covers airline operations that affect booked passengers.
+type OperationsUseCase interface {
+	CancelFlight(ctx context.Context, input CancelFlightInput) (*CancellationResult, error)
+	UpdateFlightStatus(ctx context.Context, update domain.FlightStatusUpdate) (*StatusUpdateResult, error)
+	// OversoldFlights lists the oversold flights departing within the given
+	// time, or within the default window when it is zero.
+	OversoldFlights(ctx context.Context, within time.Duration) ([]domain.Flight, error)
+	DenyBoarding(ctx context.Context, input DenyBoardingInput) (*DeniedBoardingResult, error)
+	ListDeniedBoardings(ctx context.Context, flightID int64) ([]domain.DeniedBoarding, error)
+	// SetBookingStatus records what became of a booking at departure (FLOWN,
+	// NO_SHOW) or afterwards (REFUNDED).
+	SetBookingStatus(ctx context.Context, input SetBookingStatusInput) (*domain.Booking, error)
+}
+
+// Cache is the subset of the Redis cache used by operations.
+type Cache interface {
+	InvalidateFlights(ctx context.Context) error
+	ReleaseSeatLock(ctx context.Context, flightID int64, seatNumber int) error
+}
+
+type Producer interface {
+	Publish(ctx context.Context, topic, key string, value interface{}) error
+}
+
+type CancelFlightInput struct {
+	FlightID int64
+	Reason   string
+	// OfferOnly sends the alternatives to passengers instead of moving
+	// their bookings automatically.
+	OfferOnly bool
+}
+
+type SetBookingStatusInput struct {
+	Token  string
+	Status domain.BookingStatus
+	Reason string
+}
+
+type RebookingOutcome string
+
+const (
+	RebookingOutcomeRebooked      RebookingOutcome = "REBOOKED"
+	RebookingOutcomeOffered       RebookingOutcome = "OFFERED"
+	RebookingOutcomeNoAlternative RebookingOutcome = "NO_ALTERNATIVE"
+)
+
+// Rebooking describes what happened to one confirmed or checked-in booking of
+// a cancelled flight. Booking is the state after rebooking.
+type Rebooking struct {
+	Booking            domain.Booking
+	PreviousFlightID   int64
+	PreviousSeatNumber int
+	Outcome            RebookingOutcome
+	Alternatives       []domain.Flight
+}
+
+type CancellationResult struct {
+	Flight *domain.Flight
+	// Rebookings has one entry per confirmed or checked-in booking that was
+	// still on the flight.
+	Rebookings []Rebooking
+	// CancelledPending are the unconfirmed holds that were cancelled with the flight.
+	CancelledPending []domain.Booking
+}
+
+type StatusUpdateResult struct {
+	Flight *domain.Flight
+	Change domain.FlightStatusChange
+	// Notified is the number of booked passengers told about a significant change.
+	Notified int
+}
+
+type DenyBoardingInput struct {
+	FlightID int64
+	// VolunteerTokens are the bookings whose passengers agreed to give up
+	// their seats, in the order they volunteered.
+	VolunteerTokens []string
+	// VoluntaryCompensationCents overrides the configured voluntary
+	// compensation, e.g. when the gate raised the offer. Zero keeps it.
+	VoluntaryCompensationCents int64
+}
+
+type DeniedBoardingResult struct {
+	Flight *domain.Flight
+	Denied []domain.DeniedBoarding
+}
+
+type Topics struct {
+	FlightEvents  string
+	BookingEvents string
+	Notifications string
+}
+
+type Service struct {
+	flights         repository.FlightRepository
+	bookings        repository.BookingRepository
+	cache           Cache
+	producer        Producer
+	topics          Topics
+	window          time.Duration
+	maxAlternatives int
+	deniedBoardings repository.DeniedBoardingRepository
+	compensation    domain.DeniedBoardingCompensation
+	oversoldWindow  time.Duration
+	pricing         *domain.PricingRules
+	exchangeRates   ExchangeRateSource
+	now             func() time.Time
+}
+
+// ExchangeRateSource provides the current exchange rates.
+type ExchangeRateSource interface {
+	Rates(ctx context.Context) (domain.ExchangeRates, error)
+}
+
+type Option func(*Service)
+
+// WithDeniedBoarding enables the denied-boarding workflow for oversold
+// flights with the default compensation per kind.
+func WithDeniedBoarding(repo repository.DeniedBoardingRepository, compensation domain.DeniedBoardingCompensation) Option {
+	return func(s *Service) {
+		s.deniedBoardings = repo
+		s.compensation = compensation
+	}
+}
+
+// WithPricing prices the taxes and fees of rebooked bookings on their new
+// flight like the booking service prices new bookings. rates may be nil
+// when every fixed amount is in the currency of the flights. Without it
+// rebooked bookings keep their charges.
+func WithPricing(rules domain.PricingRules, rates ExchangeRateSource) Option {
+	return func(s *Service) {
+		s.pricing = &rules
+		s.exchangeRates = rates
+	}
+}
+
+// WithOversoldWindow sets how far ahead OversoldFlights looks by default.
+func WithOversoldWindow(window time.Duration) Option {
+	return func(s *Service) {
+		if window > 0 {
+			s.oversoldWindow = window
+		}
+	}
+}
+
+// NewService creates the operations service. Alternatives are searched on the
+// same route within window of the cancelled departure.
+func NewService(flights repository.FlightRepository, bookings repository.BookingRepository, cache Cache, producer Producer, topics Topics, window time.Duration, maxAlternatives int, opts ...Option) *Service {
+	if maxAlternatives <= 0 {
+		maxAlternatives = defaultMaxAlternatives
+	}
+	s := &Service{
+		flights:         flights,
+		bookings:        bookings,
+		cache:           cache,
+		producer:        producer,
+		topics:          topics,
+		window:          window,
+		maxAlternatives: maxAlternatives,
+		oversoldWindow:  defaultOversoldWindow,
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// CancelFlight cancels the flight and rebooks its confirmed and checked-in
+// passengers onto the closest alternative flights with free seats, earliest
+// bookings first. The miles and credit held by cancelled pending bookings
+// are released with the cancellation. It is
+// safe to call again for a cancelled flight: only bookings that are still on
+// the flight are processed.
+func (s *Service) CancelFlight(ctx context.Context, input CancelFlightInput) (*CancellationResult, error) {
+	reason := "flight cancelled"
+	if input.Reason != "" {
+		reason += ": " + input.Reason
+	}
+	flight, pending, err := s.flights.Cancel(audit.WithReason(ctx, reason), input.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	s.flightChanged(ctx, flightCancelledEvent, "cancelled", flight, input.Reason)
+
+	result := &CancellationResult{Flight: flight, CancelledPending: pending}
+	for i := range pending {
+		b := &pending[i]
+		if s.cache != nil {
+			_ = s.cache.ReleaseSeatLock(ctx, b.FlightID, b.SeatNumber)
+		}
+		s.publish(ctx, bookingCancelledEvent, b, func(e *kafka.BookingEvent) { e.Reason = input.Reason })
+	}
+
+	passengers, err := s.bookings.ListByFlight(ctx, flight.ID, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn)
+	if err != nil {
+		return result, err
+	}
+	if len(passengers) == 0 {
+		return result, nil
+	}
+
+	alternatives, err := s.flights.FindAlternatives(ctx, flight, s.window, s.maxAlternatives)
+	if err != nil {
+		return result, err
+	}
+	rates, err := s.rates(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, b := range passengers {
+		rebooking, err := s.rebook(ctx, b, alternatives, rates, input.OfferOnly)
+		if err != nil {
+			return result, err
+		}
+		result.Rebookings = append(result.Rebookings, rebooking)
+		s.notify(ctx, rebooking, input.Reason)
+	}
+	return result, nil
+}
+
+// UpdateFlightStatus applies an ops status report. Reports older than the last
+// applied one, or read before the flight was cancelled, are rejected with
+// domain.ErrStaleStatusUpdate. Significant changes are sent to every
+// passenger holding a pending, confirmed or checked-in booking.
+func (s *Service) UpdateFlightStatus(ctx context.Context, update domain.FlightStatusUpdate) (*StatusUpdateResult, error) {
+	if update.ReportedAt.IsZero() {
+		update.ReportedAt = s.now()
+	}
+	if update.DivertedTo != "" {
+		exists, err := s.flights.AirportExists(ctx, update.DivertedTo)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: %s", domain.ErrAirportNotFound, update.DivertedTo)
+		}
+	}
+
+	flight, err := s.flights.GetByID(ctx, update.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	previousStatus, previousUpdate := flight.Status, flight.StatusUpdatedAt
+	change, err := flight.ApplyStatusUpdate(update)
+	if err != nil {
+		return nil, err
+	}
+	result := &StatusUpdateResult{Flight: flight, Change: change}
+	if len(change.Changes) == 0 {
+		return result, nil
+	}
+
+	updated, err := s.flights.UpdateStatus(ctx, flight, previousStatus, previousUpdate)
+	if err != nil {
+		return nil, err
+	}
+	result.Flight = updated
+	s.flightChanged(ctx, statusChangedEvent, "status", updated, "")
+
+	if !change.Significant {
+		return result, nil
+	}
+	passengers, err := s.bookings.ListByFlight(ctx, updated.ID, domain.BookingStatusPending, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn)
+	if err != nil {
+		return result, err
+	}
+	info := &kafka.FlightStatusInfo{
+		Status:     string(updated.Status),
+		Gate:       updated.Gate,
+		Terminal:   updated.Terminal,
+		DivertedTo: updated.DivertedTo,
+		Changes:    change.Changes,
+	}
+	if !updated.EstimatedDeparture.IsZero() {
+		estimate := updated.EstimatedDeparture
+		info.EstimatedDeparture = &estimate
+	}
+	for i := range passengers {
+		s.notifyPassenger(ctx, statusChangedEvent, &passengers[i], func(e *kafka.BookingEvent) { e.FlightStatus = info })
+	}
+	result.Notified = len(passengers)
+	return result, nil
+}
+
+func (s *Service) OversoldFlights(ctx context.Context, within time.Duration) ([]domain.Flight, error) {
+	if within <= 0 {
+		within = s.oversoldWindow
+	}
+	return s.flights.ListOversold(ctx, s.now().Add(within))
+}
+
+// DenyBoarding resolves an oversold flight: volunteers are taken off first,
+// then the most recent bookings, until the flight is no longer oversold.
+// Every denied passenger is told about the compensation.
+func (s *Service) DenyBoarding(ctx context.Context, input DenyBoardingInput) (*DeniedBoardingResult, error) {
+	if s.deniedBoardings == nil {
+		return nil, ErrDeniedBoardingDisabled
+	}
+	compensation := s.compensation
+	if input.VoluntaryCompensationCents != 0 {
+		compensation.VoluntaryCents = input.VoluntaryCompensationCents
+	}
+	if err := compensation.Validate(); err != nil {
+		return nil, err
+	}
+
+	denied, err := s.deniedBoardings.DenyBoarding(audit.WithReason(ctx, "flight oversold"), input.FlightID, input.VolunteerTokens, compensation)
+	if err != nil {
+		return nil, err
+	}
+	flight, err := s.flights.GetByID(ctx, input.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	s.flightChanged(ctx, "flight_updated", "denied_boarding", flight, "")
+
+	for _, d := range denied {
+		b := &domain.Booking{
+			ID:         d.BookingID,
+			FlightID:   d.FlightID,
+			SeatNumber: d.SeatNumber,
+			Token:      d.Token,
+			Email:      d.Email,
+			Status:     domain.BookingStatusDeniedBoarding,
+		}
+		kind, cents := string(d.Kind), d.CompensationCents
+		s.publish(ctx, deniedBoardingEvent, b, func(e *kafka.BookingEvent) {
+			e.DeniedBoardingKind = kind
+			e.CompensationCents = cents
+		})
+	}
+	return &DeniedBoardingResult{Flight: flight, Denied: denied}, nil
+}
+
+func (s *Service) ListDeniedBoardings(ctx context.Context, flightID int64) ([]domain.DeniedBoarding, error) {
+	if s.deniedBoardings == nil {
+		return nil, ErrDeniedBoardingDisabled
+	}
+	return s.deniedBoardings.ListByFlight(ctx, flightID)
+}
+
+func (s *Service) SetBookingStatus(ctx context.Context, input SetBookingStatusInput) (*domain.Booking, error) {
+	switch input.Status {
+	case domain.BookingStatusFlown, domain.BookingStatusNoShow, domain.BookingStatusRefunded:
+	default:
+		return nil, fmt.Errorf("%w: %q cannot be set by operations", domain.ErrInvalidBookingStatus, input.Status)
+	}
+	current, err := s.bookingByToken(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := current.Transition(input.Status); err != nil {
+		return nil, err
+	}
+	updated, err := s.bookings.UpdateStatus(audit.WithReason(ctx, input.Reason), current.Token, current.Status, input.Status)
+	if err != nil {
+		return nil, err
+	}
+	// The token a cancelled booking was rotated to is not handed out.
+	updated.Token = input.Token
+	s.publish(ctx, "booking_"+strings.ToLower(string(updated.Status)), updated, func(e *kafka.BookingEvent) { e.Reason = input.Reason })
+	return updated, nil
+}
+
+// bookingByToken returns the booking with token. Cancelling a booking
+// rotates its token, so a cancelled booking is also found by the token it
+// had, through its history.
+func (s *Service) bookingByToken(ctx context.Context, token string) (*domain.Booking, error) {
+	b, err := s.bookings.GetByToken(ctx, token)
+	if !errors.Is(err, domain.ErrBookingNotFound) {
+		return b, err
+	}
+	history, historyErr := s.bookings.History(ctx, token)
+	if historyErr != nil || len(history) == 0 {
+		return nil, err
+	}
+	return s.bookings.GetByID(ctx, history[0].BookingID)
+}
+
+// rebook moves the booking onto the first alternative that still has a seat.
+// alternatives is updated in place so that later bookings skip full flights.
+func (s *Service) rebook(ctx context.Context, b domain.Booking, alternatives []domain.Flight, rates domain.ExchangeRates, offerOnly bool) (Rebooking, error) {
+	rebooking := Rebooking{
+		Booking:            b,
+		PreviousFlightID:   b.FlightID,
+		PreviousSeatNumber: b.SeatNumber,
+		Outcome:            RebookingOutcomeNoAlternative,
+	}
+	for _, alt := range alternatives {
+		if alt.Sellable() > 0 {
+			rebooking.Alternatives = append(rebooking.Alternatives, alt)
+		}
+	}
+	if len(rebooking.Alternatives) == 0 {
+		return rebooking, nil
+	}
+	if offerOnly {
+		rebooking.Outcome = RebookingOutcomeOffered
+		return rebooking, nil
+	}
+
+	for i := range alternatives {
+		alt := &alternatives[i]
+		if alt.Sellable() == 0 {
+			continue
+		}
+		charges, err := s.charges(b, *alt, rates)
+		if err != nil {
+			return rebooking, err
+		}
+		moved, err := s.bookings.Rebook(ctx, b.Token, b.FlightID, alt.ID, charges)
+		if errors.Is(err, domain.ErrNoSeatsAvailable) {
+			alt.AvailableSeats = -alt.OverbookingLimit
+			continue
+		}
+		if err != nil {
+			return rebooking, err
+		}
+		alt.AvailableSeats--
+		rebooking.Booking = *moved
+		rebooking.Outcome = RebookingOutcomeRebooked
+		rebooking.Alternatives = nil
+		return rebooking, nil
+	}
+	rebooking.Alternatives = nil
+	return rebooking, nil
+}
+
+// charges prices the taxes and fees of booking b on flight f. The booking
+// keeps its fare and currency.
+func (s *Service) charges(b domain.Booking, f domain.Flight, rates domain.ExchangeRates) (domain.FareCharges, error) {
+	if s.pricing == nil {
+		return b.Charges, nil
+	}
+	f.Currency = b.Currency
+	return s.pricing.Charges(f, b.Channel, b.FareClass, b.PriceCents, rates)
+}
+
+func (s *Service) rates(ctx context.Context) (domain.ExchangeRates, error) {
+	if s.pricing == nil || s.exchangeRates == nil {
+		return domain.ExchangeRates{}, nil
+	}
+	return s.exchangeRates.Rates(ctx)
+}
+
+func (s *Service) notify(ctx context.Context, r Rebooking, reason string) {
+	withDisruption := func(e *kafka.BookingEvent) {
+		e.PreviousFlightID = r.PreviousFlightID
+		e.PreviousSeatNumber = r.PreviousSeatNumber
+		e.Reason = reason
+		for _, alt := range r.Alternatives {
+			e.AlternativeFlightIDs = append(e.AlternativeFlightIDs, alt.ID)
+		}
+	}
+	switch r.Outcome {
+	case RebookingOutcomeRebooked:
+		s.publish(ctx, bookingRebookedEvent, &r.Booking, withDisruption)
+	case RebookingOutcomeOffered:
+		s.publish(ctx, rebookingOfferedEvent, &r.Booking, withDisruption)
+	default:
+		s.publish(ctx, flightCancelledEvent, &r.Booking, withDisruption)
+	}
+}
+
+// flightChanged invalidates the flights cache and publishes a flight event to
+// the flight events topic. Both are best effort.
+func (s *Service) flightChanged(ctx context.Context, eventType, action string, flight *domain.Flight, reason string) {
+	if s.cache != nil {
+		if err := s.cache.InvalidateFlights(ctx); err != nil {
+			log.Printf("WARNING: failed to invalidate flights cache: %v", err)
+		}
+	}
+	if s.producer == nil || s.topics.FlightEvents == "" {
+		return
+	}
+	event := kafka.FlightEvent{
+		Type:           eventType,
+		Action:         action,
+		FlightID:       flight.ID,
+		FromAirport:    flight.FromAirport,
+		ToAirport:      flight.ToAirport,
+		DepartureTime:  flight.DepartureTime,
+		ArrivalTime:    flight.ArrivalTime,
+		TotalSeats:     flight.TotalSeats,
+		AvailableSeats: flight.AvailableSeats,
+		PriceCents:     flight.PriceCents,
+		Currency:       flight.Currency,
+		Status:         string(flight.Status),
+		Reason:         reason,
+	}
+	if err := s.producer.Publish(ctx, s.topics.FlightEvents, strconv.FormatInt(flight.ID, 10), event); err != nil {
+		log.Printf("WARNING: failed to publish %s event for flight %d: %v", eventType, flight.ID, err)
+	}
+}
+
+// publish sends a booking event to the booking events and notifications
+// topics. Failures are logged: the rebooking is already committed.
+func (s *Service) publish(ctx context.Context, eventType string, b *domain.Booking, decorate func(*kafka.BookingEvent)) {
+	if s.producer == nil {
+		return
+	}
+	event := newBookingEvent(eventType, b)
+	if decorate != nil {
+		decorate(&event)
+	}
+	for _, topic := range []string{s.topics.BookingEvents, s.topics.Notifications} {
+		if topic == "" {
+			continue
+		}
+		if err := s.producer.Publish(ctx, topic, b.Token, event); err != nil {
+			log.Printf("WARNING: failed to publish %s event for booking %s: %v", eventType, b.Token, err)
+		}
+	}
+}
+
+// notifyPassenger sends a booking event to the notifications topic only.
+func (s *Service) notifyPassenger(ctx context.Context, eventType string, b *domain.Booking, decorate func(*kafka.BookingEvent)) {
+	if s.producer == nil || s.topics.Notifications == "" {
+		return
+	}
+	event := newBookingEvent(eventType, b)
+	decorate(&event)
+	if err := s.producer.Publish(ctx, s.topics.Notifications, b.Token, event); err != nil {
+		log.Printf("WARNING: failed to publish %s event for booking %s: %v", eventType, b.Token, err)
+	}
+}
+
+func newBookingEvent(eventType string, b *domain.Booking) kafka.BookingEvent {
+	return kafka.BookingEvent{
+		Type:       eventType,
+		Token:      b.Token,
+		BookingID:  b.ID,
+		FlightID:   b.FlightID,
+		SeatNumber: b.SeatNumber,
+		Email:      b.Email,
+		Status:     string(b.Status),
+		ExpiresAt:  b.ExpiresAt,
+	}
+}
+
+var _ OperationsUseCase = (*Service)(nil)
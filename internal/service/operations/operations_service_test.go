@@ -0,0 +1,576 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/audit"
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockFlightRepository struct {
+	mock.Mock
+}
+
+func (m *MockFlightRepository) List(ctx context.Context) ([]domain.Flight, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Flight), args.Error(1)
+}
+
+func (m *MockFlightRepository) GetByID(ctx context.Context, id int64) (*domain.Flight, error) {
+	args := m.Called(ctx, id)
+	flight, _ := args.Get(0).(*domain.Flight)
+	return flight, args.Error(1)
+}
+
+func (m *MockFlightRepository) ReserveSeat(ctx context.Context, flightID int64) error {
+	return m.Called(ctx, flightID).Error(0)
+}
+
+func (m *MockFlightRepository) ReleaseSeat(ctx context.Context, flightID int64) error {
+	return m.Called(ctx, flightID).Error(0)
+}
+
+func (m *MockFlightRepository) Create(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	args := m.Called(ctx, flight)
+	created, _ := args.Get(0).(*domain.Flight)
+	return created, args.Error(1)
+}
+
+func (m *MockFlightRepository) Update(ctx context.Context, flight *domain.Flight) (*domain.Flight, error) {
+	args := m.Called(ctx, flight)
+	updated, _ := args.Get(0).(*domain.Flight)
+	return updated, args.Error(1)
+}
+
+func (m *MockFlightRepository) Delete(ctx context.Context, id int64) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockFlightRepository) AirportExists(ctx context.Context, code string) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFlightRepository) Cancel(ctx context.Context, id int64) (*domain.Flight, []domain.Booking, error) {
+	args := m.Called(ctx, id)
+	flight, _ := args.Get(0).(*domain.Flight)
+	bookings, _ := args.Get(1).([]domain.Booking)
+	return flight, bookings, args.Error(2)
+}
+
+func (m *MockFlightRepository) FindAlternatives(ctx context.Context, flight *domain.Flight, window time.Duration, limit int) ([]domain.Flight, error) {
+	args := m.Called(ctx, flight, window, limit)
+	flights, _ := args.Get(0).([]domain.Flight)
+	return flights, args.Error(1)
+}
+
+func (m *MockFlightRepository) UpdateStatus(ctx context.Context, flight *domain.Flight, previousStatus domain.FlightStatus, previousUpdate time.Time) (*domain.Flight, error) {
+	args := m.Called(ctx, flight, previousStatus, previousUpdate)
+	updated, _ := args.Get(0).(*domain.Flight)
+	return updated, args.Error(1)
+}
+
+func (m *MockFlightRepository) ListOversold(ctx context.Context, departingBefore time.Time) ([]domain.Flight, error) {
+	args := m.Called(ctx, departingBefore)
+	flights, _ := args.Get(0).([]domain.Flight)
+	return flights, args.Error(1)
+}
+
+type MockBookingRepository struct {
+	mock.Mock
+}
+
+func (m *MockBookingRepository) CreatePending(ctx context.Context, booking *domain.Booking) error {
+	return m.Called(ctx, booking).Error(0)
+}
+
+func (m *MockBookingRepository) GetByToken(ctx context.Context, token string) (*domain.Booking, error) {
+	args := m.Called(ctx, token)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) UpdateStatus(ctx context.Context, token string, from, to domain.BookingStatus) (*domain.Booking, error) {
+	args := m.Called(ctx, token, from, to)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) ExpirePendingBefore(ctx context.Context, deadline time.Time) ([]domain.Booking, error) {
+	args := m.Called(ctx, deadline)
+	bookings, _ := args.Get(0).([]domain.Booking)
+	return bookings, args.Error(1)
+}
+
+func (m *MockBookingRepository) ReleaseSeat(ctx context.Context, flightID int64) error {
+	return m.Called(ctx, flightID).Error(0)
+}
+
+func (m *MockBookingRepository) ListByFlight(ctx context.Context, flightID int64, statuses ...domain.BookingStatus) ([]domain.Booking, error) {
+	args := m.Called(ctx, flightID, statuses)
+	bookings, _ := args.Get(0).([]domain.Booking)
+	return bookings, args.Error(1)
+}
+
+func (m *MockBookingRepository) Rebook(ctx context.Context, token string, fromFlightID, toFlightID int64, charges domain.FareCharges) (*domain.Booking, error) {
+	args := m.Called(ctx, token, fromFlightID, toFlightID, charges)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) ExtendHold(ctx context.Context, token string, expiresAt time.Time, maxExtensions int) (*domain.Booking, error) {
+	args := m.Called(ctx, token, expiresAt, maxExtensions)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) ChangeSeat(ctx context.Context, token string, seatNumber int) (*domain.Booking, error) {
+	args := m.Called(ctx, token, seatNumber)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) ChangeFlight(ctx context.Context, change *domain.FlightChange, seatNumber int) (*domain.Booking, error) {
+	args := m.Called(ctx, change, seatNumber)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) History(ctx context.Context, token string) ([]domain.BookingTransition, error) {
+	args := m.Called(ctx, token)
+	history, _ := args.Get(0).([]domain.BookingTransition)
+	return history, args.Error(1)
+}
+
+func (m *MockBookingRepository) ListByCustomer(ctx context.Context, customerID int64, page domain.BookingPage) ([]domain.Booking, error) {
+	args := m.Called(ctx, customerID, page)
+	bookings, _ := args.Get(0).([]domain.Booking)
+	return bookings, args.Error(1)
+}
+
+func (m *MockBookingRepository) GetByID(ctx context.Context, id int64) (*domain.Booking, error) {
+	args := m.Called(ctx, id)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+func (m *MockBookingRepository) Cancel(ctx context.Context, token string, from domain.BookingStatus) (*domain.Booking, error) {
+	args := m.Called(ctx, token, from)
+	booking, _ := args.Get(0).(*domain.Booking)
+	return booking, args.Error(1)
+}
+
+// withReason matches a context carrying the audit reason.
+func withReason(reason string) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return audit.FromContext(ctx).Reason == reason
+	})
+}
+
+type MockCache struct {
+	mock.Mock
+}
+
+func (m *MockCache) InvalidateFlights(ctx context.Context) error {
+	return m.Called(ctx).Error(0)
+}
+
+func (m *MockCache) ReleaseSeatLock(ctx context.Context, flightID int64, seatNumber int) error {
+	return m.Called(ctx, flightID, seatNumber).Error(0)
+}
+
+type MockProducer struct {
+	mock.Mock
+}
+
+func (m *MockProducer) Publish(ctx context.Context, topic, key string, value interface{}) error {
+	return m.Called(ctx, topic, key, value).Error(0)
+}
+
+var testTopics = Topics{FlightEvents: "flight-events", BookingEvents: "booking-events"}
+
+func newTestService() (*Service, *MockFlightRepository, *MockBookingRepository, *MockCache, *MockProducer) {
+	flights := &MockFlightRepository{}
+	bookings := &MockBookingRepository{}
+	cache := &MockCache{}
+	producer := &MockProducer{}
+	cache.On("InvalidateFlights", mock.Anything).Return(nil)
+	producer.On("Publish", mock.Anything, "flight-events", "10", mock.MatchedBy(func(e kafka.FlightEvent) bool {
+		return e.Type == flightCancelledEvent && e.Reason == "weather"
+	})).Return(nil).Once()
+	return NewService(flights, bookings, cache, producer, testTopics, 24*time.Hour, 0), flights, bookings, cache, producer
+}
+
+func bookingEvent(eventType, token string) interface{} {
+	return mock.MatchedBy(func(e kafka.BookingEvent) bool { return e.Type == eventType && e.Token == token })
+}
+
+func TestCancelFlight_RebooksConfirmedPassengers(t *testing.T) {
+	ctx := context.Background()
+	svc, flights, bookings, cache, producer := newTestService()
+
+	cancelled := &domain.Flight{ID: 10, FromAirport: "SVO", ToAirport: "LED", Status: domain.FlightStatusCancelled}
+	pending := domain.Booking{Token: "p1", FlightID: 10, SeatNumber: 7, Status: domain.BookingStatusCancelled}
+	first := domain.Booking{Token: "c1", FlightID: 10, SeatNumber: 1, Status: domain.BookingStatusCheckedIn}
+	second := domain.Booking{Token: "c2", FlightID: 10, SeatNumber: 2, Status: domain.BookingStatusConfirmed}
+	third := domain.Booking{Token: "c3", FlightID: 10, SeatNumber: 3, Status: domain.BookingStatusConfirmed}
+
+	flights.On("Cancel", withReason("flight cancelled: weather"), int64(10)).Return(cancelled, []domain.Booking{pending}, nil)
+	bookings.On("ListByFlight", ctx, int64(10), []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).Return([]domain.Booking{first, second, third}, nil)
+	flights.On("FindAlternatives", ctx, cancelled, 24*time.Hour, defaultMaxAlternatives).Return([]domain.Flight{
+		{ID: 11, AvailableSeats: 1},
+		{ID: 12, AvailableSeats: 5},
+	}, nil)
+	bookings.On("Rebook", ctx, "c1", int64(10), int64(11), domain.FareCharges{}).Return(&domain.Booking{Token: "c1", FlightID: 11, SeatNumber: 4, Status: domain.BookingStatusConfirmed}, nil)
+	bookings.On("Rebook", ctx, "c2", int64(10), int64(12), domain.FareCharges{}).Return(nil, domain.ErrNoSeatsAvailable)
+	cache.On("ReleaseSeatLock", ctx, int64(10), 7).Return(nil)
+	producer.On("Publish", ctx, "booking-events", "p1", bookingEvent(bookingCancelledEvent, "p1")).Return(nil)
+	producer.On("Publish", ctx, "booking-events", "c1", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == bookingRebookedEvent && e.FlightID == 11 && e.SeatNumber == 4 && e.PreviousFlightID == 10 && e.PreviousSeatNumber == 1
+	})).Return(nil)
+	producer.On("Publish", ctx, "booking-events", "c2", bookingEvent(flightCancelledEvent, "c2")).Return(nil)
+	producer.On("Publish", ctx, "booking-events", "c3", bookingEvent(flightCancelledEvent, "c3")).Return(nil)
+
+	result, err := svc.CancelFlight(ctx, CancelFlightInput{FlightID: 10, Reason: "weather"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, cancelled, result.Flight)
+	assert.Equal(t, []domain.Booking{pending}, result.CancelledPending)
+	assert.Len(t, result.Rebookings, 3)
+	assert.Equal(t, RebookingOutcomeRebooked, result.Rebookings[0].Outcome)
+	assert.Equal(t, int64(11), result.Rebookings[0].Booking.FlightID)
+	assert.Equal(t, domain.BookingStatusConfirmed, result.Rebookings[0].Booking.Status, "checked-in passengers check in again on the new flight")
+	assert.Equal(t, int64(10), result.Rebookings[0].PreviousFlightID)
+	assert.Equal(t, RebookingOutcomeNoAlternative, result.Rebookings[1].Outcome, "both alternatives turned out to be full")
+	assert.Equal(t, RebookingOutcomeNoAlternative, result.Rebookings[2].Outcome)
+	bookings.AssertNumberOfCalls(t, "Rebook", 2)
+	flights.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestCancelFlight_RepricesRebookedBookings(t *testing.T) {
+	ctx := context.Background()
+	svc, flights, bookings, _, producer := newTestService()
+	WithPricing(domain.PricingRules{
+		Taxes: []domain.TaxRule{{Code: "DE", LevyOn: domain.TaxOnArrival, Country: "DE", Percent: 10}},
+	}, nil)(svc)
+
+	cancelled := &domain.Flight{ID: 10, FromAirport: "SVO", ToAirport: "LED", Status: domain.FlightStatusCancelled}
+	confirmed := domain.Booking{Token: "c1", FlightID: 10, SeatNumber: 1, Status: domain.BookingStatusConfirmed, PriceCents: 10000, Currency: "EUR"}
+	charges := domain.FareCharges{Taxes: []domain.TaxCharge{{Code: "DE", Airport: "BER", AmountCents: 1000}}}
+
+	flights.On("Cancel", mock.Anything, int64(10)).Return(cancelled, nil, nil)
+	bookings.On("ListByFlight", ctx, int64(10), []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).Return([]domain.Booking{confirmed}, nil)
+	flights.On("FindAlternatives", ctx, cancelled, 24*time.Hour, defaultMaxAlternatives).Return([]domain.Flight{
+		{ID: 11, FromAirport: "SVO", ToAirport: "BER", ArrivalCountry: "DE", Currency: "EUR", AvailableSeats: 1},
+	}, nil)
+	bookings.On("Rebook", ctx, "c1", int64(10), int64(11), charges).Return(&domain.Booking{Token: "c1", FlightID: 11, SeatNumber: 4, Status: domain.BookingStatusConfirmed, Charges: charges}, nil).Once()
+	producer.On("Publish", ctx, "booking-events", "c1", bookingEvent(bookingRebookedEvent, "c1")).Return(nil)
+
+	result, err := svc.CancelFlight(ctx, CancelFlightInput{FlightID: 10, Reason: "weather"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Rebookings, 1)
+	assert.Equal(t, RebookingOutcomeRebooked, result.Rebookings[0].Outcome)
+	assert.Equal(t, charges, result.Rebookings[0].Booking.Charges)
+	bookings.AssertExpectations(t)
+}
+
+func TestCancelFlight_OfferOnly(t *testing.T) {
+	ctx := context.Background()
+	svc, flights, bookings, _, producer := newTestService()
+
+	cancelled := &domain.Flight{ID: 10, Status: domain.FlightStatusCancelled}
+	confirmed := domain.Booking{Token: "c1", FlightID: 10, SeatNumber: 1, Status: domain.BookingStatusConfirmed}
+
+	flights.On("Cancel", mock.Anything, int64(10)).Return(cancelled, nil, nil)
+	bookings.On("ListByFlight", ctx, int64(10), []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).Return([]domain.Booking{confirmed}, nil)
+	flights.On("FindAlternatives", ctx, cancelled, 24*time.Hour, defaultMaxAlternatives).Return([]domain.Flight{{ID: 11, AvailableSeats: 3}, {ID: 12, AvailableSeats: 0}, {ID: 13, AvailableSeats: 0, OverbookingLimit: 2}}, nil)
+	producer.On("Publish", ctx, "booking-events", "c1", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == rebookingOfferedEvent && assert.ObjectsAreEqual([]int64{11, 13}, e.AlternativeFlightIDs)
+	})).Return(nil)
+
+	result, err := svc.CancelFlight(ctx, CancelFlightInput{FlightID: 10, Reason: "weather", OfferOnly: true})
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Rebookings, 1)
+	assert.Equal(t, RebookingOutcomeOffered, result.Rebookings[0].Outcome)
+	assert.Equal(t, confirmed, result.Rebookings[0].Booking)
+	assert.Len(t, result.Rebookings[0].Alternatives, 2, "flights that may still be oversold are alternatives")
+	bookings.AssertNotCalled(t, "Rebook", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	producer.AssertExpectations(t)
+}
+
+func TestCancelFlight_NoConfirmedBookings(t *testing.T) {
+	ctx := context.Background()
+	svc, flights, bookings, _, _ := newTestService()
+
+	cancelled := &domain.Flight{ID: 10, Status: domain.FlightStatusCancelled}
+	flights.On("Cancel", mock.Anything, int64(10)).Return(cancelled, nil, nil)
+	bookings.On("ListByFlight", ctx, int64(10), []domain.BookingStatus{domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).Return(nil, nil)
+
+	result, err := svc.CancelFlight(ctx, CancelFlightInput{FlightID: 10, Reason: "weather"})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Rebookings)
+	flights.AssertNotCalled(t, "FindAlternatives", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCancelFlight_NotFound(t *testing.T) {
+	flights := &MockFlightRepository{}
+	svc := NewService(flights, &MockBookingRepository{}, nil, nil, testTopics, time.Hour, 1)
+	flights.On("Cancel", mock.Anything, int64(404)).Return(nil, nil, domain.ErrFlightNotFound)
+
+	_, err := svc.CancelFlight(context.Background(), CancelFlightInput{FlightID: 404})
+
+	assert.True(t, errors.Is(err, domain.ErrFlightNotFound))
+}
+
+func TestUpdateFlightStatus_NotifiesPassengersOfSignificantChange(t *testing.T) {
+	ctx := context.Background()
+	flights := &MockFlightRepository{}
+	bookings := &MockBookingRepository{}
+	cache := &MockCache{}
+	producer := &MockProducer{}
+	svc := NewService(flights, bookings, cache, producer, Topics{FlightEvents: "flight-events", Notifications: "notifications"}, time.Hour, 1)
+	reported := time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return reported }
+
+	departure := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+	current := &domain.Flight{ID: 10, DepartureTime: departure, Status: domain.FlightStatusScheduled}
+	stored := &domain.Flight{ID: 10, DepartureTime: departure, Status: domain.FlightStatusDelayed}
+	stored.EstimatedDeparture = departure.Add(time.Hour)
+
+	flights.On("GetByID", ctx, int64(10)).Return(current, nil)
+	flights.On("UpdateStatus", ctx, mock.MatchedBy(func(f *domain.Flight) bool {
+		return f.Status == domain.FlightStatusDelayed && f.StatusUpdatedAt.Equal(reported)
+	}), domain.FlightStatusScheduled, time.Time{}).Return(stored, nil)
+	cache.On("InvalidateFlights", ctx).Return(nil)
+	producer.On("Publish", ctx, "flight-events", "10", mock.MatchedBy(func(e kafka.FlightEvent) bool {
+		return e.Type == statusChangedEvent && e.Status == "DELAYED"
+	})).Return(nil)
+	bookings.On("ListByFlight", ctx, int64(10), []domain.BookingStatus{domain.BookingStatusPending, domain.BookingStatusConfirmed, domain.BookingStatusCheckedIn}).
+		Return([]domain.Booking{{Token: "a", FlightID: 10}, {Token: "b", FlightID: 10}, {Token: "c", FlightID: 10, Status: domain.BookingStatusCheckedIn}}, nil)
+	for _, token := range []string{"a", "b", "c"} {
+		producer.On("Publish", ctx, "notifications", token, mock.MatchedBy(func(e kafka.BookingEvent) bool {
+			return e.Type == statusChangedEvent && e.FlightStatus != nil && e.FlightStatus.Status == "DELAYED" &&
+				e.FlightStatus.EstimatedDeparture.Equal(departure.Add(time.Hour))
+		})).Return(nil).Once()
+	}
+
+	result, err := svc.UpdateFlightStatus(ctx, domain.FlightStatusUpdate{FlightID: 10, Status: domain.FlightStatusDelayed, EstimatedDeparture: departure.Add(time.Hour)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored, result.Flight)
+	assert.True(t, result.Change.Significant)
+	assert.Equal(t, 3, result.Notified)
+	flights.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestUpdateFlightStatus_MinorChangeIsNotSentToPassengers(t *testing.T) {
+	ctx := context.Background()
+	flights := &MockFlightRepository{}
+	bookings := &MockBookingRepository{}
+	svc := NewService(flights, bookings, nil, nil, testTopics, time.Hour, 1)
+
+	departure := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+	previous := time.Date(2025, 3, 10, 5, 0, 0, 0, time.UTC)
+	current := &domain.Flight{ID: 10, DepartureTime: departure, Status: domain.FlightStatusDelayed}
+	current.EstimatedDeparture = departure.Add(time.Hour)
+	current.StatusUpdatedAt = previous
+
+	flights.On("GetByID", ctx, int64(10)).Return(current, nil)
+	flights.On("UpdateStatus", ctx, mock.Anything, domain.FlightStatusDelayed, previous).Return(current, nil)
+
+	result, err := svc.UpdateFlightStatus(ctx, domain.FlightStatusUpdate{FlightID: 10, EstimatedDeparture: departure.Add(65 * time.Minute), ReportedAt: previous.Add(time.Minute)})
+
+	assert.NoError(t, err)
+	assert.False(t, result.Change.Significant)
+	assert.Zero(t, result.Notified)
+	bookings.AssertNotCalled(t, "ListByFlight", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateFlightStatus_Errors(t *testing.T) {
+	ctx := context.Background()
+	departure := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+
+	t.Run("unknown diversion airport", func(t *testing.T) {
+		flights := &MockFlightRepository{}
+		svc := NewService(flights, &MockBookingRepository{}, nil, nil, testTopics, time.Hour, 1)
+		flights.On("AirportExists", ctx, "XXX").Return(false, nil)
+
+		_, err := svc.UpdateFlightStatus(ctx, domain.FlightStatusUpdate{FlightID: 10, Status: domain.FlightStatusDiverted, DivertedTo: "XXX"})
+		assert.ErrorIs(t, err, domain.ErrAirportNotFound)
+	})
+
+	t.Run("invalid transition is not stored", func(t *testing.T) {
+		flights := &MockFlightRepository{}
+		svc := NewService(flights, &MockBookingRepository{}, nil, nil, testTopics, time.Hour, 1)
+		flights.On("GetByID", ctx, int64(10)).Return(&domain.Flight{ID: 10, DepartureTime: departure, Status: domain.FlightStatusScheduled}, nil)
+
+		_, err := svc.UpdateFlightStatus(ctx, domain.FlightStatusUpdate{FlightID: 10, Status: domain.FlightStatusArrived})
+		assert.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+		flights.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type MockDeniedBoardingRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeniedBoardingRepository) DenyBoarding(ctx context.Context, flightID int64, volunteerTokens []string, compensation domain.DeniedBoardingCompensation) ([]domain.DeniedBoarding, error) {
+	args := m.Called(ctx, flightID, volunteerTokens, compensation)
+	denied, _ := args.Get(0).([]domain.DeniedBoarding)
+	return denied, args.Error(1)
+}
+
+func (m *MockDeniedBoardingRepository) ListByFlight(ctx context.Context, flightID int64) ([]domain.DeniedBoarding, error) {
+	args := m.Called(ctx, flightID)
+	denied, _ := args.Get(0).([]domain.DeniedBoarding)
+	return denied, args.Error(1)
+}
+
+func TestOversoldFlights_DefaultWindow(t *testing.T) {
+	ctx := context.Background()
+	flights := &MockFlightRepository{}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewService(flights, &MockBookingRepository{}, nil, nil, testTopics, time.Hour, 1, WithOversoldWindow(48*time.Hour))
+	svc.now = func() time.Time { return now }
+	oversold := []domain.Flight{{ID: 10, TotalSeats: 100, AvailableSeats: -2, OverbookingLimit: 5}}
+	flights.On("ListOversold", ctx, now.Add(48*time.Hour)).Return(oversold, nil).Once()
+	flights.On("ListOversold", ctx, now.Add(6*time.Hour)).Return(nil, nil).Once()
+
+	got, err := svc.OversoldFlights(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, oversold, got)
+	assert.Equal(t, 2, got[0].Oversold())
+
+	got, err = svc.OversoldFlights(ctx, 6*time.Hour)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	flights.AssertExpectations(t)
+}
+
+func TestDenyBoarding_VolunteersAndInvoluntary(t *testing.T) {
+	ctx := context.Background()
+	flights := &MockFlightRepository{}
+	denials := &MockDeniedBoardingRepository{}
+	cache := &MockCache{}
+	producer := &MockProducer{}
+	compensation := domain.DeniedBoardingCompensation{VoluntaryCents: 30000, InvoluntaryCents: 60000}
+	svc := NewService(flights, &MockBookingRepository{}, cache, producer, testTopics, time.Hour, 1, WithDeniedBoarding(denials, compensation))
+
+	volunteers := []string{"v1"}
+	raised := domain.DeniedBoardingCompensation{VoluntaryCents: 45000, InvoluntaryCents: 60000}
+	denials.On("DenyBoarding", withReason("flight oversold"), int64(10), volunteers, raised).Return([]domain.DeniedBoarding{
+		{ID: 1, FlightID: 10, BookingID: 5, Token: "v1", SeatNumber: 3, Kind: domain.DeniedBoardingVoluntary, CompensationCents: 45000},
+		{ID: 2, FlightID: 10, BookingID: 9, Token: "c9", SeatNumber: 8, Kind: domain.DeniedBoardingInvoluntary, CompensationCents: 60000},
+	}, nil).Once()
+	resolved := &domain.Flight{ID: 10, TotalSeats: 100, AvailableSeats: 0}
+	flights.On("GetByID", ctx, int64(10)).Return(resolved, nil).Once()
+	cache.On("InvalidateFlights", ctx).Return(nil).Once()
+	producer.On("Publish", ctx, "flight-events", "10", mock.AnythingOfType("kafka.FlightEvent")).Return(nil).Once()
+	producer.On("Publish", ctx, "booking-events", "v1", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == deniedBoardingEvent && e.DeniedBoardingKind == "VOLUNTARY" && e.CompensationCents == 45000 && e.Status == "DENIED_BOARDING"
+	})).Return(nil).Once()
+	producer.On("Publish", ctx, "booking-events", "c9", mock.MatchedBy(func(e kafka.BookingEvent) bool {
+		return e.Type == deniedBoardingEvent && e.DeniedBoardingKind == "INVOLUNTARY" && e.CompensationCents == 60000
+	})).Return(nil).Once()
+
+	result, err := svc.DenyBoarding(ctx, DenyBoardingInput{FlightID: 10, VolunteerTokens: volunteers, VoluntaryCompensationCents: 45000})
+
+	assert.NoError(t, err)
+	assert.Equal(t, resolved, result.Flight)
+	assert.Len(t, result.Denied, 2)
+	denials.AssertExpectations(t)
+	cache.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestDenyBoarding_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	svc := NewService(&MockFlightRepository{}, &MockBookingRepository{}, nil, nil, testTopics, time.Hour, 1)
+	_, err := svc.DenyBoarding(ctx, DenyBoardingInput{FlightID: 10})
+	assert.ErrorIs(t, err, ErrDeniedBoardingDisabled)
+
+	denials := &MockDeniedBoardingRepository{}
+	svc = NewService(&MockFlightRepository{}, &MockBookingRepository{}, nil, nil, testTopics, time.Hour, 1, WithDeniedBoarding(denials, domain.DeniedBoardingCompensation{}))
+	_, err = svc.DenyBoarding(ctx, DenyBoardingInput{FlightID: 10, VoluntaryCompensationCents: -1})
+	assert.ErrorIs(t, err, domain.ErrInvalidCompensation)
+
+	denials.On("DenyBoarding", withReason("flight oversold"), int64(10), []string(nil), domain.DeniedBoardingCompensation{}).Return(nil, domain.ErrFlightNotOversold).Once()
+	_, err = svc.DenyBoarding(ctx, DenyBoardingInput{FlightID: 10})
+	assert.ErrorIs(t, err, domain.ErrFlightNotOversold)
+	denials.AssertExpectations(t)
+}
+
+func TestSetBookingStatus(t *testing.T) {
+	ctx := context.Background()
+	svc, _, bookings, _, producer := newTestService()
+
+	current := &domain.Booking{Token: "t1", FlightID: 10, Status: domain.BookingStatusCheckedIn}
+	flown := *current
+	flown.Status = domain.BookingStatusFlown
+	bookings.On("GetByToken", ctx, "t1").Return(current, nil).Once()
+	bookings.On("UpdateStatus", withReason("boarded"), "t1", domain.BookingStatusCheckedIn, domain.BookingStatusFlown).Return(&flown, nil).Once()
+	producer.On("Publish", ctx, mock.Anything, "t1", bookingEvent("booking_flown", "t1")).Return(nil)
+
+	updated, err := svc.SetBookingStatus(ctx, SetBookingStatusInput{Token: "t1", Status: domain.BookingStatusFlown, Reason: "boarded"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BookingStatusFlown, updated.Status)
+	bookings.AssertExpectations(t)
+}
+
+func TestSetBookingStatus_RefundsCancelledBooking(t *testing.T) {
+	ctx := context.Background()
+	svc, _, bookings, _, producer := newTestService()
+
+	// Cancelling rotated the token; the booking is found through its history.
+	cancelled := &domain.Booking{ID: 7, Token: "rotated", FlightID: 10, Status: domain.BookingStatusCancelled}
+	refunded := *cancelled
+	refunded.Status = domain.BookingStatusRefunded
+	bookings.On("GetByToken", ctx, "t1").Return(nil, domain.ErrBookingNotFound).Once()
+	bookings.On("History", ctx, "t1").Return([]domain.BookingTransition{
+		{BookingID: 7, Token: "t1", To: domain.BookingStatusPending},
+		{BookingID: 7, Token: "t1", From: domain.BookingStatusConfirmed, To: domain.BookingStatusCancelled},
+	}, nil).Once()
+	bookings.On("GetByID", ctx, int64(7)).Return(cancelled, nil).Once()
+	bookings.On("UpdateStatus", withReason("refund approved"), "rotated", domain.BookingStatusCancelled, domain.BookingStatusRefunded).Return(&refunded, nil).Once()
+	producer.On("Publish", ctx, mock.Anything, "t1", bookingEvent("booking_refunded", "t1")).Return(nil)
+
+	updated, err := svc.SetBookingStatus(ctx, SetBookingStatusInput{Token: "t1", Status: domain.BookingStatusRefunded, Reason: "refund approved"})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.BookingStatusRefunded, updated.Status)
+	assert.Equal(t, "t1", updated.Token, "the rotated token is not handed out")
+	bookings.AssertExpectations(t)
+
+	bookings.On("GetByToken", ctx, "unknown").Return(nil, domain.ErrBookingNotFound).Once()
+	bookings.On("History", ctx, "unknown").Return(nil, nil).Once()
+	_, err = svc.SetBookingStatus(ctx, SetBookingStatusInput{Token: "unknown", Status: domain.BookingStatusRefunded})
+	assert.ErrorIs(t, err, domain.ErrBookingNotFound)
+}
+
+func TestSetBookingStatus_Errors(t *testing.T) {
+	ctx := context.Background()
+	svc, _, bookings, _, _ := newTestService()
+
+	_, err := svc.SetBookingStatus(ctx, SetBookingStatusInput{Token: "t1", Status: domain.BookingStatusConfirmed})
+	assert.ErrorIs(t, err, domain.ErrInvalidBookingStatus)
+
+	bookings.On("GetByToken", ctx, "t2").Return(&domain.Booking{Token: "t2", Status: domain.BookingStatusPending}, nil).Once()
+	_, err = svc.SetBookingStatus(ctx, SetBookingStatusInput{Token: "t2", Status: domain.BookingStatusNoShow})
+	assert.ErrorIs(t, err, domain.ErrInvalidBookingTransition)
+	bookings.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,66 @@
+package promotions
+
+import (
+	"context"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+type PromotionUseCase interface {
+	// CreatePromotion starts a campaign with a new promo code.
+	CreatePromotion(ctx context.Context, promotion domain.Promotion) (*domain.Promotion, error)
+	// UpdatePromotion replaces the terms of a campaign. Bookings already
+	// made keep the discount they were given.
+	UpdatePromotion(ctx context.Context, promotion domain.Promotion) (*domain.Promotion, error)
+	GetPromotion(ctx context.Context, id int64) (*domain.Promotion, error)
+	// ListPromotions returns the campaigns, newest first.
+	ListPromotions(ctx context.Context) ([]domain.Promotion, error)
+	// GetUsage reports the bookings made with the promo code of a campaign.
+	GetUsage(ctx context.Context, id int64) (*domain.PromotionUsage, error)
+}
+
+type Service struct {
+	promotions repository.PromotionRepository
+}
+
+func NewService(promotions repository.PromotionRepository) *Service {
+	return &Service{promotions: promotions}
+}
+
+func (s *Service) CreatePromotion(ctx context.Context, promotion domain.Promotion) (*domain.Promotion, error) {
+	if err := promotion.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.promotions.Create(ctx, &promotion); err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+func (s *Service) UpdatePromotion(ctx context.Context, promotion domain.Promotion) (*domain.Promotion, error) {
+	if promotion.ID <= 0 {
+		return nil, domain.NewValidationError("id", "id must be positive")
+	}
+	if err := promotion.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.promotions.Update(ctx, &promotion); err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+func (s *Service) GetPromotion(ctx context.Context, id int64) (*domain.Promotion, error) {
+	return s.promotions.GetByID(ctx, id)
+}
+
+func (s *Service) ListPromotions(ctx context.Context) ([]domain.Promotion, error) {
+	return s.promotions.List(ctx)
+}
+
+func (s *Service) GetUsage(ctx context.Context, id int64) (*domain.PromotionUsage, error) {
+	return s.promotions.Usage(ctx, id)
+}
+
+var _ PromotionUseCase = (*Service)(nil)
@@ -0,0 +1,63 @@
+package promotions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPromotionRepository implements only Create and Update; the embedded
+// interface makes any other call panic.
+type MockPromotionRepository struct {
+	mock.Mock
+	repository.PromotionRepository
+}
+
+func (m *MockPromotionRepository) Create(ctx context.Context, promotion *domain.Promotion) error {
+	args := m.Called(ctx, promotion)
+	return args.Error(0)
+}
+
+func (m *MockPromotionRepository) Update(ctx context.Context, promotion *domain.Promotion) error {
+	args := m.Called(ctx, promotion)
+	return args.Error(0)
+}
+
+func TestService_CreatePromotion(t *testing.T) {
+	repo := &MockPromotionRepository{}
+	svc := NewService(repo)
+	repo.On("Create", mock.Anything, mock.MatchedBy(func(p *domain.Promotion) bool {
+		return p.Code == "SUMMER"
+	})).Return(nil).Once()
+
+	created, err := svc.CreatePromotion(context.Background(), domain.Promotion{Code: "summer", Type: domain.DiscountFixed, AmountOffCents: 500})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SUMMER", created.Code)
+	repo.AssertExpectations(t)
+}
+
+func TestService_CreatePromotion_Invalid(t *testing.T) {
+	repo := &MockPromotionRepository{}
+	svc := NewService(repo)
+
+	_, err := svc.CreatePromotion(context.Background(), domain.Promotion{Code: "SUMMER", Type: domain.DiscountPercent, PercentOff: 150})
+
+	var validation *domain.ValidationError
+	assert.ErrorAs(t, err, &validation)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_UpdatePromotion_TakenCode(t *testing.T) {
+	repo := &MockPromotionRepository{}
+	svc := NewService(repo)
+	repo.On("Update", mock.Anything, mock.Anything).Return(domain.ErrPromoCodeTaken)
+
+	_, err := svc.UpdatePromotion(context.Background(), domain.Promotion{ID: 3, Code: "WINTER", Type: domain.DiscountFixed, AmountOffCents: 500})
+
+	assert.ErrorIs(t, err, domain.ErrPromoCodeTaken)
+}
@@ -0,0 +1,186 @@
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+type ChangeKind string
+
+const (
+	ChangeAdd    ChangeKind = "add"
+	ChangeUpdate ChangeKind = "update"
+	ChangeRemove ChangeKind = "remove"
+)
+
+// ImportChange is a single difference between an imported schedule file and
+// the schedules already stored.
+type ImportChange struct {
+	Kind     ChangeKind
+	Schedule domain.Schedule
+	// Fields lists "name: old -> new" descriptions for updates.
+	Fields []string
+}
+
+func (c ImportChange) String() string {
+	sign := map[ChangeKind]string{ChangeAdd: "+", ChangeUpdate: "~", ChangeRemove: "-"}[c.Kind]
+	line := fmt.Sprintf("%s %s", sign, describeSchedule(c.Schedule))
+	if len(c.Fields) > 0 {
+		line += " (" + strings.Join(c.Fields, "; ") + ")"
+	}
+	return line
+}
+
+type InvalidSchedule struct {
+	Schedule domain.Schedule
+	Err      error
+}
+
+type ImportPlan struct {
+	Changes   []ImportChange
+	Invalid   []InvalidSchedule
+	Unchanged int
+}
+
+func (p ImportPlan) Count(kind ChangeKind) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// Importer reconciles externally planned schedules (e.g. an SSIM file) with
+// the schedules table.
+type Importer struct {
+	schedules repository.ScheduleRepository
+}
+
+func NewImporter(schedules repository.ScheduleRepository) *Importer {
+	return &Importer{schedules: schedules}
+}
+
+// Plan compares incoming schedules with the stored ones. Schedules are
+// matched by flight number, departure airport and start of the effective
+// period. Stored schedules of the given airlines that are missing from the
+// import are planned for removal.
+func (i *Importer) Plan(ctx context.Context, incoming []domain.Schedule, airlines []string) (ImportPlan, error) {
+	var plan ImportPlan
+
+	existing, err := i.schedules.List(ctx)
+	if err != nil {
+		return plan, err
+	}
+	stored := make(map[string]domain.Schedule, len(existing))
+	for _, s := range existing {
+		stored[scheduleKey(s)] = s
+	}
+
+	seen := make(map[string]bool, len(incoming))
+	for _, s := range incoming {
+		if err := s.Validate(); err != nil {
+			plan.Invalid = append(plan.Invalid, InvalidSchedule{Schedule: s, Err: err})
+			continue
+		}
+		key := scheduleKey(s)
+		if seen[key] {
+			plan.Invalid = append(plan.Invalid, InvalidSchedule{Schedule: s, Err: fmt.Errorf("duplicate schedule %s", key)})
+			continue
+		}
+		seen[key] = true
+
+		old, ok := stored[key]
+		if !ok {
+			plan.Changes = append(plan.Changes, ImportChange{Kind: ChangeAdd, Schedule: s})
+			continue
+		}
+		s.ID = old.ID
+		s.PriceCents = old.PriceCents
+		if fields := diffSchedules(old, s); len(fields) > 0 {
+			plan.Changes = append(plan.Changes, ImportChange{Kind: ChangeUpdate, Schedule: s, Fields: fields})
+		} else {
+			plan.Unchanged++
+		}
+	}
+
+	for _, s := range existing {
+		if !seen[scheduleKey(s)] && ownedBy(s, airlines) {
+			plan.Changes = append(plan.Changes, ImportChange{Kind: ChangeRemove, Schedule: s})
+		}
+	}
+
+	sort.SliceStable(plan.Changes, func(a, b int) bool {
+		return scheduleKey(plan.Changes[a].Schedule) < scheduleKey(plan.Changes[b].Schedule)
+	})
+	return plan, nil
+}
+
+// Apply writes the plan. Removals are only applied when prune is set.
+func (i *Importer) Apply(ctx context.Context, plan ImportPlan, prune bool) error {
+	for _, c := range plan.Changes {
+		s := c.Schedule
+		var err error
+		switch c.Kind {
+		case ChangeAdd:
+			err = i.schedules.Create(ctx, &s)
+		case ChangeUpdate:
+			err = i.schedules.Update(ctx, &s)
+		case ChangeRemove:
+			if prune {
+				err = i.schedules.Delete(ctx, s.ID)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", c.Kind, describeSchedule(s), err)
+		}
+	}
+	return nil
+}
+
+func scheduleKey(s domain.Schedule) string {
+	return fmt.Sprintf("%s/%s/%s", s.FlightNumber, s.FromAirport, s.EffectiveFrom.Format(time.DateOnly))
+}
+
+func ownedBy(s domain.Schedule, airlines []string) bool {
+	for _, airline := range airlines {
+		if strings.HasPrefix(s.FlightNumber, airline) {
+			return true
+		}
+	}
+	return false
+}
+
+func diffSchedules(old, updated domain.Schedule) []string {
+	var fields []string
+	add := func(name string, from, to interface{}) {
+		if from != to {
+			fields = append(fields, fmt.Sprintf("%s: %v -> %v", name, from, to))
+		}
+	}
+	add("to", old.ToAirport, updated.ToAirport)
+	add("days", "'"+old.DaysOfWeek.String()+"'", "'"+updated.DaysOfWeek.String()+"'")
+	add("departure", clock(old.DepartureLocal), clock(updated.DepartureLocal))
+	add("block", old.BlockTime, updated.BlockTime)
+	add("effective_to", old.EffectiveTo.Format(time.DateOnly), updated.EffectiveTo.Format(time.DateOnly))
+	add("aircraft", old.Aircraft, updated.Aircraft)
+	add("seats", old.TotalSeats, updated.TotalSeats)
+	return fields
+}
+
+func describeSchedule(s domain.Schedule) string {
+	return fmt.Sprintf("%s %s-%s '%s' %s %s..%s %s %d seats",
+		s.FlightNumber, s.FromAirport, s.ToAirport, s.DaysOfWeek, clock(s.DepartureLocal),
+		s.EffectiveFrom.Format(time.DateOnly), s.EffectiveTo.Format(time.DateOnly), s.Aircraft, s.TotalSeats)
+}
+
+func clock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
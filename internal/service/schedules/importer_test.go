@@ -0,0 +1,99 @@
+package schedules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImporter_Plan(t *testing.T) {
+	repo := &MockScheduleRepository{}
+	importer := NewImporter(repo)
+	ctx := context.Background()
+
+	unchanged := schedule(1, domain.AllWeekdays)
+	unchanged.FlightNumber = "SU100"
+	changed := schedule(2, domain.AllWeekdays)
+	changed.FlightNumber = "SU200"
+	changed.PriceCents = 990000
+	missing := schedule(3, domain.AllWeekdays)
+	missing.FlightNumber = "SU300"
+	otherAirline := schedule(4, domain.AllWeekdays)
+	otherAirline.FlightNumber = "S7100"
+
+	repo.On("List", ctx).Return([]domain.Schedule{unchanged, changed, missing, otherAirline}, nil).Once()
+
+	incomingChanged := changed
+	incomingChanged.ID = 0
+	incomingChanged.PriceCents = 0
+	incomingChanged.DaysOfWeek = 1
+	incomingChanged.Aircraft = "321"
+	added := schedule(0, domain.AllWeekdays)
+	added.FlightNumber = "SU400"
+	invalid := schedule(0, domain.AllWeekdays)
+	invalid.FlightNumber = "SU500"
+	invalid.BlockTime = 0
+
+	plan, err := importer.Plan(ctx, []domain.Schedule{unchanged, incomingChanged, added, invalid}, []string{"SU"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, plan.Unchanged)
+	assert.Len(t, plan.Invalid, 1)
+	assert.Equal(t, "SU500", plan.Invalid[0].Schedule.FlightNumber)
+	assert.Len(t, plan.Changes, 3)
+
+	update := plan.Changes[0]
+	assert.Equal(t, ChangeUpdate, update.Kind)
+	assert.Equal(t, int64(2), update.Schedule.ID)
+	assert.Equal(t, int64(990000), update.Schedule.PriceCents, "price is not part of SSIM and must be kept")
+	assert.Equal(t, []string{"days: '1234567' -> '1      '", "aircraft: 320 -> 321"}, update.Fields)
+
+	assert.Equal(t, ChangeRemove, plan.Changes[1].Kind)
+	assert.Equal(t, "SU300", plan.Changes[1].Schedule.FlightNumber)
+	assert.Equal(t, ChangeAdd, plan.Changes[2].Kind)
+	assert.Equal(t, "SU400", plan.Changes[2].Schedule.FlightNumber)
+	assert.Contains(t, plan.Changes[2].String(), "+ SU400 SVO-LED '1234567' 10:00")
+	repo.AssertExpectations(t)
+}
+
+func TestImporter_Apply(t *testing.T) {
+	ctx := context.Background()
+	plan := ImportPlan{Changes: []ImportChange{
+		{Kind: ChangeAdd, Schedule: schedule(0, domain.AllWeekdays)},
+		{Kind: ChangeUpdate, Schedule: schedule(2, domain.AllWeekdays)},
+		{Kind: ChangeRemove, Schedule: schedule(3, domain.AllWeekdays)},
+	}}
+
+	t.Run("without prune", func(t *testing.T) {
+		repo := &MockScheduleRepository{}
+		repo.On("Create", ctx, mock.AnythingOfType("*domain.Schedule")).Return(nil).Once()
+		repo.On("Update", ctx, mock.MatchedBy(func(s *domain.Schedule) bool { return s.ID == 2 })).Return(nil).Once()
+
+		assert.NoError(t, NewImporter(repo).Apply(ctx, plan, false))
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("with prune", func(t *testing.T) {
+		repo := &MockScheduleRepository{}
+		repo.On("Create", ctx, mock.Anything).Return(nil).Once()
+		repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+		repo.On("Delete", ctx, int64(3)).Return(nil).Once()
+
+		assert.NoError(t, NewImporter(repo).Apply(ctx, plan, true))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("error stops the import", func(t *testing.T) {
+		repo := &MockScheduleRepository{}
+		repo.On("Create", ctx, mock.Anything).Return(errors.New("violates foreign key constraint")).Once()
+
+		err := NewImporter(repo).Apply(ctx, plan, true)
+		assert.ErrorContains(t, err, "add SU100 SVO-LED")
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,71 @@
+package schedules
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/Domenick1991/airbooking/internal/service/flights"
+)
+
+type ScheduleUseCase interface {
+	MaterializeFlights(ctx context.Context) (MaterializeResult, error)
+}
+
+type MaterializeResult struct {
+	Schedules int
+	Created   int
+	Updated   int
+	Removed   int
+}
+
+// ScheduleService turns recurring schedules into flights rows for a rolling
+// horizon. Running it repeatedly is safe: instances are keyed by
+// (schedule_id, departure_time) and flights with bookings are left alone.
+type ScheduleService struct {
+	schedules repository.ScheduleRepository
+	cache     flights.FlightsInvalidator
+	horizon   time.Duration
+	now       func() time.Time
+}
+
+func NewScheduleService(schedules repository.ScheduleRepository, cache flights.FlightsInvalidator, horizon time.Duration) *ScheduleService {
+	return &ScheduleService{schedules: schedules, cache: cache, horizon: horizon, now: time.Now}
+}
+
+func (s *ScheduleService) MaterializeFlights(ctx context.Context) (MaterializeResult, error) {
+	var result MaterializeResult
+
+	from := s.now()
+	to := from.Add(s.horizon)
+
+	list, err := s.schedules.ListActive(ctx, from)
+	if err != nil {
+		return result, err
+	}
+
+	for _, schedule := range list {
+		if err := schedule.Validate(); err != nil {
+			log.Printf("skip schedule %d (%s): %v", schedule.ID, schedule.FlightNumber, err)
+			continue
+		}
+		synced, err := s.schedules.SyncFlights(ctx, schedule.ID, from, to, schedule.Occurrences(from, to))
+		if err != nil {
+			return result, err
+		}
+		result.Schedules++
+		result.Created += synced.Created
+		result.Updated += synced.Updated
+		result.Removed += synced.Removed
+	}
+
+	if s.cache != nil && result.Created+result.Updated+result.Removed > 0 {
+		if err := s.cache.InvalidateFlights(ctx); err != nil {
+			log.Printf("WARNING: failed to invalidate flights cache: %v", err)
+		}
+	}
+	return result, nil
+}
+
+var _ ScheduleUseCase = (*ScheduleService)(nil)
@@ -0,0 +1,133 @@
+package schedules
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockScheduleRepository struct {
+	mock.Mock
+}
+
+func (m *MockScheduleRepository) List(ctx context.Context) ([]domain.Schedule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Schedule), args.Error(1)
+}
+
+func (m *MockScheduleRepository) Create(ctx context.Context, schedule *domain.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepository) Update(ctx context.Context, schedule *domain.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepository) ListActive(ctx context.Context, since time.Time) ([]domain.Schedule, error) {
+	args := m.Called(ctx, since)
+	return args.Get(0).([]domain.Schedule), args.Error(1)
+}
+
+func (m *MockScheduleRepository) SyncFlights(ctx context.Context, scheduleID int64, from, to time.Time, flights []domain.Flight) (repository.ScheduleSyncResult, error) {
+	args := m.Called(ctx, scheduleID, from, to, flights)
+	return args.Get(0).(repository.ScheduleSyncResult), args.Error(1)
+}
+
+type MockInvalidator struct {
+	mock.Mock
+}
+
+func (m *MockInvalidator) InvalidateFlights(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+var now = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestService() (*ScheduleService, *MockScheduleRepository, *MockInvalidator) {
+	repo := &MockScheduleRepository{}
+	cache := &MockInvalidator{}
+	service := NewScheduleService(repo, cache, 7*24*time.Hour)
+	service.now = func() time.Time { return now }
+	return service, repo, cache
+}
+
+func schedule(id int64, days domain.Weekdays) domain.Schedule {
+	return domain.Schedule{
+		ID:             id,
+		FlightNumber:   "SU100",
+		FromAirport:    "SVO",
+		ToAirport:      "LED",
+		DaysOfWeek:     days,
+		DepartureLocal: 10 * time.Hour,
+		BlockTime:      time.Hour,
+		EffectiveFrom:  time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		EffectiveTo:    time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC),
+		Aircraft:       "320",
+		TotalSeats:     100,
+	}
+}
+
+func TestScheduleService_MaterializeFlights(t *testing.T) {
+	service, repo, cache := newTestService()
+	ctx := context.Background()
+	to := now.Add(7 * 24 * time.Hour)
+
+	daily := schedule(1, domain.AllWeekdays)
+	invalid := schedule(2, domain.AllWeekdays)
+	invalid.BlockTime = 0
+	weekly := schedule(3, 1) // Mondays only
+
+	repo.On("ListActive", ctx, now).Return([]domain.Schedule{daily, invalid, weekly}, nil).Once()
+	repo.On("SyncFlights", ctx, int64(1), now, to, mock.MatchedBy(func(f []domain.Flight) bool { return len(f) == 7 })).
+		Return(repository.ScheduleSyncResult{Created: 5, Updated: 1}, nil).Once()
+	repo.On("SyncFlights", ctx, int64(3), now, to, mock.MatchedBy(func(f []domain.Flight) bool { return len(f) == 1 })).
+		Return(repository.ScheduleSyncResult{Removed: 2}, nil).Once()
+	cache.On("InvalidateFlights", ctx).Return(nil).Once()
+
+	result, err := service.MaterializeFlights(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, MaterializeResult{Schedules: 2, Created: 5, Updated: 1, Removed: 2}, result)
+	repo.AssertExpectations(t)
+	cache.AssertExpectations(t)
+}
+
+func TestScheduleService_MaterializeFlights_NoChanges(t *testing.T) {
+	service, repo, cache := newTestService()
+	ctx := context.Background()
+
+	repo.On("ListActive", ctx, now).Return([]domain.Schedule{schedule(1, domain.AllWeekdays)}, nil).Once()
+	repo.On("SyncFlights", ctx, int64(1), mock.Anything, mock.Anything, mock.Anything).Return(repository.ScheduleSyncResult{}, nil).Once()
+
+	result, err := service.MaterializeFlights(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Schedules)
+	cache.AssertNotCalled(t, "InvalidateFlights", mock.Anything)
+}
+
+func TestScheduleService_MaterializeFlights_SyncError(t *testing.T) {
+	service, repo, _ := newTestService()
+	ctx := context.Background()
+
+	repo.On("ListActive", ctx, now).Return([]domain.Schedule{schedule(1, domain.AllWeekdays)}, nil).Once()
+	repo.On("SyncFlights", ctx, int64(1), mock.Anything, mock.Anything, mock.Anything).Return(repository.ScheduleSyncResult{}, errors.New("db error")).Once()
+
+	_, err := service.MaterializeFlights(ctx)
+
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+)
+
+type WalletUseCase interface {
+	// GetBalance returns the travel credit and miles of a customer and what
+	// its pending bookings hold of them.
+	GetBalance(ctx context.Context, customerID int64) (domain.WalletBalance, error)
+	// ListHistory returns a page of the travel credit ledger of a customer,
+	// newest first, and the token of the next page.
+	ListHistory(ctx context.Context, customerID int64, input ListHistoryInput) ([]domain.WalletEntry, string, error)
+}
+
+// ListHistoryInput selects a page of the ledger.
+type ListHistoryInput struct {
+	// PageSize defaults to 20 and is capped at 100.
+	PageSize int
+	// PageToken is the token returned with the previous page.
+	PageToken string
+}
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type Service struct {
+	wallet repository.WalletRepository
+}
+
+func NewService(wallet repository.WalletRepository) *Service {
+	return &Service{wallet: wallet}
+}
+
+func (s *Service) GetBalance(ctx context.Context, customerID int64) (domain.WalletBalance, error) {
+	return s.wallet.Balance(ctx, customerID)
+}
+
+func (s *Service) ListHistory(ctx context.Context, customerID int64, input ListHistoryInput) ([]domain.WalletEntry, string, error) {
+	page := domain.LedgerPage{Limit: input.PageSize}
+	switch {
+	case input.PageSize < 0:
+		return nil, "", domain.NewValidationError("page_size", "page size must not be negative")
+	case input.PageSize == 0:
+		page.Limit = defaultPageSize
+	case input.PageSize > maxPageSize:
+		page.Limit = maxPageSize
+	}
+	if input.PageToken != "" {
+		id, err := decodePageToken(input.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		page.BeforeID = id
+	}
+
+	limit := page.Limit
+	page.Limit++
+	entries, err := s.wallet.ListEntries(ctx, customerID, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) <= limit {
+		return entries, "", nil
+	}
+	entries = entries[:limit]
+	return entries, encodePageToken(entries[len(entries)-1].ID), nil
+}
+
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		if id, err := strconv.ParseInt(string(raw), 10, 64); err == nil && id > 0 {
+			return id, nil
+		}
+	}
+	return 0, domain.NewValidationError("page_token", "invalid page token")
+}
+
+var _ WalletUseCase = (*Service)(nil)
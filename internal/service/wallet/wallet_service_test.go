@@ -0,0 +1,61 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/Domenick1991/airbooking/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWalletRepository implements only the reads; the embedded interface
+// makes any other call panic.
+type MockWalletRepository struct {
+	mock.Mock
+	repository.WalletRepository
+}
+
+func (m *MockWalletRepository) Balance(ctx context.Context, customerID int64) (domain.WalletBalance, error) {
+	args := m.Called(ctx, customerID)
+	return args.Get(0).(domain.WalletBalance), args.Error(1)
+}
+
+func (m *MockWalletRepository) ListEntries(ctx context.Context, customerID int64, page domain.LedgerPage) ([]domain.WalletEntry, error) {
+	args := m.Called(ctx, customerID, page)
+	entries, _ := args.Get(0).([]domain.WalletEntry)
+	return entries, args.Error(1)
+}
+
+func TestService_GetBalance(t *testing.T) {
+	repo := &MockWalletRepository{}
+	repo.On("Balance", mock.Anything, int64(3)).Return(domain.WalletBalance{CreditCents: 5000, ReservedCreditCents: 1500}, nil)
+
+	balance, err := NewService(repo).GetBalance(context.Background(), 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3500), balance.AvailableCreditCents())
+}
+
+func TestService_ListHistory_Pages(t *testing.T) {
+	repo := &MockWalletRepository{}
+	svc := NewService(repo)
+	repo.On("ListEntries", mock.Anything, int64(3), domain.LedgerPage{Limit: 2}).
+		Return([]domain.WalletEntry{{ID: 8}, {ID: 6}}, nil)
+
+	entries, next, err := svc.ListHistory(context.Background(), 3, ListHistoryInput{PageSize: 1})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	repo.On("ListEntries", mock.Anything, int64(3), domain.LedgerPage{BeforeID: 8, Limit: 2}).
+		Return([]domain.WalletEntry{{ID: 6}}, nil)
+	entries, next, err = svc.ListHistory(context.Background(), 3, ListHistoryInput{PageSize: 1, PageToken: next})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), entries[0].ID)
+	assert.Empty(t, next)
+
+	_, _, err = svc.ListHistory(context.Background(), 3, ListHistoryInput{PageSize: -1})
+	var validation *domain.ValidationError
+	assert.ErrorAs(t, err, &validation)
+}
@@ -0,0 +1,250 @@
+// Package ssim parses IATA SSIM (Standard Schedules Information Manual,
+// Chapter 7) schedule files. Only flight leg records (type 3) are decoded;
+// header, carrier, segment and trailer records are skipped.
+package ssim
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+)
+
+const recordLength = 200
+
+// OpenEnded is used as the effective end date of periods encoded as "00XXX00".
+var OpenEnded = time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// LegRecord is a decoded type 3 (flight leg) record.
+type LegRecord struct {
+	Line                   int
+	OperationalSuffix      string
+	Airline                string
+	FlightNumber           string
+	ItineraryVariation     string
+	LegSequence            int
+	ServiceType            string
+	PeriodFrom             time.Time
+	PeriodTo               time.Time
+	Days                   domain.Weekdays
+	DepartureStation       string
+	PassengerSTD           time.Duration // local time after midnight
+	DepartureUTCVariation  time.Duration
+	DepartureTerminal      string
+	ArrivalStation         string
+	PassengerSTA           time.Duration // local time after midnight
+	ArrivalUTCVariation    time.Duration
+	ArrivalTerminal        string
+	AircraftType           string
+	AircraftConfiguration  string
+	DepartureDateVariation int
+	ArrivalDateVariation   int
+	SerialNumber           int
+}
+
+// Designator returns the commercial flight number, e.g. "SU123".
+func (r LegRecord) Designator() string {
+	number := strings.TrimLeft(r.FlightNumber, "0")
+	if number == "" {
+		number = "0"
+	}
+	return r.Airline + number + r.OperationalSuffix
+}
+
+// BlockTime is the scheduled gate-to-gate duration of the leg.
+func (r LegRecord) BlockTime() time.Duration {
+	departureUTC := r.PassengerSTD - r.DepartureUTCVariation + time.Duration(r.DepartureDateVariation)*24*time.Hour
+	arrivalUTC := r.PassengerSTA - r.ArrivalUTCVariation + time.Duration(r.ArrivalDateVariation)*24*time.Hour
+	return arrivalUTC - departureUTC
+}
+
+// Seats sums the compartment counts of the aircraft configuration, e.g.
+// "C12Y150" gives 162. It returns 0 when the configuration is missing.
+func (r LegRecord) Seats() int {
+	total, current := 0, ""
+	flush := func() {
+		if n, err := strconv.Atoi(current); err == nil {
+			total += n
+		}
+		current = ""
+	}
+	for _, c := range r.AircraftConfiguration {
+		if c >= '0' && c <= '9' {
+			current += string(c)
+			continue
+		}
+		flush()
+	}
+	flush()
+	return total
+}
+
+// Schedule converts the leg into a schedule definition. defaultSeats is used
+// when the record carries no aircraft configuration.
+func (r LegRecord) Schedule(defaultSeats int) domain.Schedule {
+	seats := r.Seats()
+	if seats == 0 {
+		seats = defaultSeats
+	}
+	return domain.Schedule{
+		FlightNumber:   r.Designator(),
+		FromAirport:    r.DepartureStation,
+		ToAirport:      r.ArrivalStation,
+		DaysOfWeek:     r.Days,
+		DepartureLocal: r.PassengerSTD,
+		BlockTime:      r.BlockTime(),
+		EffectiveFrom:  r.PeriodFrom,
+		EffectiveTo:    r.PeriodTo,
+		Aircraft:       r.AircraftType,
+		TotalSeats:     seats,
+	}
+}
+
+// Parse reads an SSIM file and returns its flight leg records.
+func Parse(r io.Reader) ([]LegRecord, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 1024), 64*1024)
+
+	var legs []LegRecord
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if len(text) == 0 || text[0] != '3' {
+			continue
+		}
+		leg, err := ParseLeg(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		leg.Line = line
+		legs = append(legs, leg)
+	}
+	return legs, scanner.Err()
+}
+
+// ParseLeg decodes a single type 3 record. Positions below are 1-based as
+// in the SSIM manual.
+func ParseLeg(record string) (LegRecord, error) {
+	if len(record) < 75 {
+		return LegRecord{}, fmt.Errorf("record too short: %d characters", len(record))
+	}
+	if len(record) < recordLength {
+		record += strings.Repeat(" ", recordLength-len(record))
+	}
+	field := func(from, to int) string {
+		return strings.TrimSpace(record[from-1 : to])
+	}
+
+	var (
+		leg LegRecord
+		err error
+	)
+	leg.OperationalSuffix = field(2, 2)
+	leg.Airline = field(3, 5)
+	leg.FlightNumber = field(6, 9)
+	leg.ItineraryVariation = field(10, 11)
+	if leg.LegSequence, err = strconv.Atoi(field(12, 13)); err != nil {
+		return leg, fmt.Errorf("leg sequence number: %w", err)
+	}
+	leg.ServiceType = field(14, 14)
+	if leg.PeriodFrom, err = parseDate(field(15, 21)); err != nil {
+		return leg, fmt.Errorf("period of operation from: %w", err)
+	}
+	if leg.PeriodTo, err = parseDate(field(22, 28)); err != nil {
+		return leg, fmt.Errorf("period of operation to: %w", err)
+	}
+	if leg.Days, err = domain.ParseWeekdays(record[28:35]); err != nil {
+		return leg, fmt.Errorf("days of operation %q: %w", record[28:35], err)
+	}
+	leg.DepartureStation = field(37, 39)
+	if leg.PassengerSTD, err = parseClock(field(40, 43)); err != nil {
+		return leg, fmt.Errorf("passenger STD: %w", err)
+	}
+	if leg.DepartureUTCVariation, err = parseVariation(field(48, 52)); err != nil {
+		return leg, fmt.Errorf("departure UTC variation: %w", err)
+	}
+	leg.DepartureTerminal = field(53, 54)
+	leg.ArrivalStation = field(55, 57)
+	if leg.PassengerSTA, err = parseClock(field(62, 65)); err != nil {
+		return leg, fmt.Errorf("passenger STA: %w", err)
+	}
+	if leg.ArrivalUTCVariation, err = parseVariation(field(66, 70)); err != nil {
+		return leg, fmt.Errorf("arrival UTC variation: %w", err)
+	}
+	leg.ArrivalTerminal = field(71, 72)
+	leg.AircraftType = field(73, 75)
+	leg.AircraftConfiguration = field(173, 192)
+	if leg.DepartureDateVariation, err = parseDateVariation(record[192]); err != nil {
+		return leg, fmt.Errorf("departure date variation: %w", err)
+	}
+	if leg.ArrivalDateVariation, err = parseDateVariation(record[193]); err != nil {
+		return leg, fmt.Errorf("arrival date variation: %w", err)
+	}
+	if serial := field(195, 200); serial != "" {
+		leg.SerialNumber, _ = strconv.Atoi(serial)
+	}
+
+	if leg.Airline == "" || leg.FlightNumber == "" {
+		return leg, fmt.Errorf("missing flight designator")
+	}
+	if leg.DepartureStation == "" || leg.ArrivalStation == "" {
+		return leg, fmt.Errorf("missing station")
+	}
+	return leg, nil
+}
+
+// parseDate parses DDMMMYY. "00XXX00" denotes an open-ended period.
+func parseDate(s string) (time.Time, error) {
+	if s == "00XXX00" {
+		return OpenEnded, nil
+	}
+	return time.Parse("02Jan06", s)
+}
+
+// parseClock parses HHMM; 2400 is accepted as end of day.
+func parseClock(s string) (time.Duration, error) {
+	if len(s) != 4 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	h, err1 := strconv.Atoi(s[:2])
+	m, err2 := strconv.Atoi(s[2:])
+	if err1 != nil || err2 != nil || h > 24 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// parseVariation parses the UTC/local time variation, e.g. "+0300" or "-0430".
+func parseVariation(s string) (time.Duration, error) {
+	if len(s) != 5 || (s[0] != '+' && s[0] != '-') {
+		return 0, fmt.Errorf("invalid UTC variation %q", s)
+	}
+	d, err := parseClock(s[1:])
+	if err != nil {
+		return 0, fmt.Errorf("invalid UTC variation %q", s)
+	}
+	if s[0] == '-' {
+		d = -d
+	}
+	return d, nil
+}
+
+// parseDateVariation decodes the day shift code: blank or 0-9 for days after
+// the period date, "A" for the day before.
+func parseDateVariation(c byte) (int, error) {
+	switch {
+	case c == ' ':
+		return 0, nil
+	case c >= '0' && c <= '9':
+		return int(c - '0'), nil
+	case c == 'A':
+		return -1, nil
+	default:
+		return 0, fmt.Errorf("invalid date variation %q", c)
+	}
+}
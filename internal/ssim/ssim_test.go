@@ -0,0 +1,159 @@
+package ssim
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Domenick1991/airbooking/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// leg builds a 200 character type 3 record from 1-based field positions.
+func leg(fields map[int]string) string {
+	b := []byte(strings.Repeat(" ", recordLength))
+	b[0] = '3'
+	for pos, value := range fields {
+		copy(b[pos-1:], value)
+	}
+	return string(b)
+}
+
+func sampleLeg() map[int]string {
+	return map[int]string{
+		3:   "SU ",
+		6:   "0123",
+		10:  "01",
+		12:  "01",
+		14:  "J",
+		15:  "30MAR25",
+		22:  "25OCT25",
+		29:  "12345 7",
+		37:  "SVO",
+		40:  "2330",
+		44:  "2330",
+		48:  "+0300",
+		53:  "D ",
+		55:  "KJA",
+		58:  "0815",
+		62:  "0815",
+		66:  "+0700",
+		73:  "321",
+		173: "C28Y142",
+		193: "01",
+		195: "000002",
+	}
+}
+
+func TestParseLeg(t *testing.T) {
+	rec, err := ParseLeg(leg(sampleLeg()))
+	require.NoError(t, err)
+
+	assert.Equal(t, "SU", rec.Airline)
+	assert.Equal(t, "SU123", rec.Designator())
+	assert.Equal(t, 1, rec.LegSequence)
+	assert.Equal(t, "J", rec.ServiceType)
+	assert.Equal(t, time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), rec.PeriodFrom)
+	assert.Equal(t, time.Date(2025, 10, 25, 0, 0, 0, 0, time.UTC), rec.PeriodTo)
+	assert.Equal(t, "12345 7", rec.Days.String())
+	assert.Equal(t, "SVO", rec.DepartureStation)
+	assert.Equal(t, 23*time.Hour+30*time.Minute, rec.PassengerSTD)
+	assert.Equal(t, 3*time.Hour, rec.DepartureUTCVariation)
+	assert.Equal(t, "D", rec.DepartureTerminal)
+	assert.Equal(t, "KJA", rec.ArrivalStation)
+	assert.Equal(t, 7*time.Hour, rec.ArrivalUTCVariation)
+	assert.Equal(t, "321", rec.AircraftType)
+	assert.Equal(t, 170, rec.Seats())
+	assert.Equal(t, 1, rec.ArrivalDateVariation)
+	assert.Equal(t, 2, rec.SerialNumber)
+
+	// 20:30Z -> 01:15Z next day.
+	assert.Equal(t, 4*time.Hour+45*time.Minute, rec.BlockTime())
+}
+
+func TestLegRecord_Schedule(t *testing.T) {
+	fields := sampleLeg()
+	fields[173] = "                    "
+	rec, err := ParseLeg(leg(fields))
+	require.NoError(t, err)
+
+	s := rec.Schedule(180)
+	assert.Equal(t, "SU123", s.FlightNumber)
+	assert.Equal(t, "SVO", s.FromAirport)
+	assert.Equal(t, "KJA", s.ToAirport)
+	assert.Equal(t, 180, s.TotalSeats)
+	assert.Equal(t, "321", s.Aircraft)
+	assert.Equal(t, rec.Days, s.DaysOfWeek)
+	assert.NoError(t, s.Validate())
+}
+
+func TestParseLeg_OpenEndedAndPreviousDay(t *testing.T) {
+	fields := sampleLeg()
+	fields[22] = "00XXX00"
+	fields[193] = "A0"
+	rec, err := ParseLeg(leg(fields))
+	require.NoError(t, err)
+
+	assert.Equal(t, OpenEnded, rec.PeriodTo)
+	assert.Equal(t, -1, rec.DepartureDateVariation)
+}
+
+func TestParseLeg_Errors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		field int
+		value string
+		err   string
+	}{
+		{"bad date", 15, "31FEB25", "period of operation from"},
+		{"bad days", 29, "1 2    ", "days of operation"},
+		{"bad time", 40, "2561", "passenger STD"},
+		{"bad variation", 48, "0300 ", "departure UTC variation"},
+		{"bad date variation", 193, "Z", "departure date variation"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fields := sampleLeg()
+			fields[tc.field] = tc.value
+			_, err := ParseLeg(leg(fields))
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	_, err := ParseLeg("3 SU 0123")
+	assert.ErrorContains(t, err, "too short")
+}
+
+func TestParse(t *testing.T) {
+	second := sampleLeg()
+	second[6] = "0124"
+	second[37] = "KJA"
+	second[55] = "SVO"
+	second[29] = " 2 4 6 "
+
+	file := strings.Join([]string{
+		"1AIRLINE STANDARD SCHEDULE DATA SET",
+		"2LSU  0008S25",
+		leg(sampleLeg()),
+		leg(second) + "\r",
+		"4 SU 0123",
+		"5 SU 000004E",
+	}, "\n")
+
+	legs, err := Parse(strings.NewReader(file))
+	require.NoError(t, err)
+	require.Len(t, legs, 2)
+	assert.Equal(t, 3, legs[0].Line)
+	assert.Equal(t, "SU124", legs[1].Designator())
+	assert.True(t, legs[1].Days.Has(time.Saturday))
+	assert.False(t, legs[1].Days.Has(time.Monday))
+	assert.Equal(t, domain.Weekdays(0b0101010), legs[1].Days)
+}
+
+func TestParse_ReportsLineNumber(t *testing.T) {
+	fields := sampleLeg()
+	fields[40] = "xx00"
+	_, err := Parse(strings.NewReader("1HEADER\n" + leg(fields)))
+	assert.ErrorContains(t, err, "line 2")
+}
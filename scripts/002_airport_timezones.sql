@@ -0,0 +1,4 @@
+ALTER TABLE airports ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'UTC';
+
+ALTER TABLE flights DROP CONSTRAINT IF EXISTS flights_arrival_after_departure;
+ALTER TABLE flights ADD CONSTRAINT flights_arrival_after_departure CHECK (arrival_time > departure_time);
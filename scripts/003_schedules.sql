@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS schedules (
+    id SERIAL PRIMARY KEY,
+    flight_number VARCHAR(10) NOT NULL,
+    from_airport VARCHAR(10) NOT NULL REFERENCES airports(code),
+    to_airport VARCHAR(10) NOT NULL REFERENCES airports(code),
+    -- bitmask, Monday = 1, Tuesday = 2, ... Sunday = 64
+    days_of_week SMALLINT NOT NULL CHECK (days_of_week BETWEEN 1 AND 127),
+    -- local time at the departure airport
+    departure_local TIME NOT NULL,
+    block_minutes INT NOT NULL CHECK (block_minutes > 0),
+    effective_from DATE NOT NULL,
+    effective_to DATE NOT NULL,
+    aircraft VARCHAR(10) NOT NULL,
+    total_seats INT NOT NULL CHECK (total_seats > 0),
+    price_cents BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    CHECK (effective_to >= effective_from)
+);
+
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS flight_number VARCHAR(10);
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS schedule_id INT REFERENCES schedules(id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_flights_schedule_departure ON flights (schedule_id, departure_time);
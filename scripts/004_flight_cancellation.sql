@@ -0,0 +1,9 @@
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'SCHEDULED';
+
+-- Cancelling a flight must never delete its bookings: they are rebooked onto
+-- alternative flights instead.
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_flight_id_fkey;
+ALTER TABLE bookings ADD CONSTRAINT bookings_flight_id_fkey
+    FOREIGN KEY (flight_id) REFERENCES flights(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_flights_route_departure ON flights (from_airport, to_airport, departure_time);
@@ -0,0 +1,8 @@
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS estimated_departure_time TIMESTAMPTZ;
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS actual_departure_time TIMESTAMPTZ;
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS actual_arrival_time TIMESTAMPTZ;
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS gate VARCHAR(10);
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS terminal VARCHAR(10);
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS diverted_to VARCHAR(10) REFERENCES airports(code);
+-- time of the last applied ops report; older reports are ignored
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS status_updated_at TIMESTAMPTZ;
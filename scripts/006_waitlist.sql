@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS waitlist (
+    id SERIAL PRIMARY KEY,
+    flight_id INT NOT NULL REFERENCES flights(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    fare_class TEXT NOT NULL,
+    loyalty_tier TEXT NOT NULL DEFAULT '',
+    -- higher is served first, ties are served in created_at order
+    priority INT NOT NULL,
+    status TEXT NOT NULL,
+    -- pending booking offered to the customer once a seat was released
+    booking_token TEXT,
+    offered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_waitlist_flight_email_waiting ON waitlist (flight_id, lower(email)) WHERE status = 'WAITING';
+CREATE INDEX IF NOT EXISTS idx_waitlist_queue ON waitlist (flight_id, priority DESC, created_at, id) WHERE status = 'WAITING';
@@ -0,0 +1,26 @@
+-- seats that may be sold above total_seats; available_seats goes negative when oversold
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS overbooking_limit INT NOT NULL DEFAULT 0;
+ALTER TABLE flights DROP CONSTRAINT IF EXISTS flights_overbooking_limit_check;
+ALTER TABLE flights ADD CONSTRAINT flights_overbooking_limit_check CHECK (overbooking_limit >= 0);
+
+-- Denied boardings are the record compensation was paid on: deleting their
+-- booking or flight must fail rather than take them along.
+CREATE TABLE IF NOT EXISTS denied_boardings (
+    id SERIAL PRIMARY KEY,
+    flight_id INT NOT NULL REFERENCES flights(id) ON DELETE RESTRICT,
+    booking_id INT NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE RESTRICT,
+    -- VOLUNTARY or INVOLUNTARY
+    kind TEXT NOT NULL,
+    compensation_cents BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+ALTER TABLE denied_boardings DROP CONSTRAINT IF EXISTS denied_boardings_flight_id_fkey;
+ALTER TABLE denied_boardings ADD CONSTRAINT denied_boardings_flight_id_fkey
+    FOREIGN KEY (flight_id) REFERENCES flights(id) ON DELETE RESTRICT;
+ALTER TABLE denied_boardings DROP CONSTRAINT IF EXISTS denied_boardings_booking_id_fkey;
+ALTER TABLE denied_boardings ADD CONSTRAINT denied_boardings_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_denied_boardings_flight ON denied_boardings (flight_id);
+CREATE INDEX IF NOT EXISTS idx_flights_oversold ON flights (departure_time) WHERE available_seats < 0;
@@ -0,0 +1,5 @@
+-- channel and fare class select the hold policy of a booking
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS channel TEXT NOT NULL DEFAULT 'WEB';
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS fare_class TEXT NOT NULL DEFAULT 'ECONOMY';
+-- number of times the pending hold was extended
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS hold_extensions INT NOT NULL DEFAULT 0;
@@ -0,0 +1,25 @@
+-- fare paid for the booking, taken from the flight when booked
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS price_cents BIGINT NOT NULL DEFAULT 0;
+UPDATE bookings b SET price_cents = f.price_cents FROM flights f WHERE f.id = b.flight_id AND b.price_cents = 0;
+
+-- voluntary flight changes; the booking keeps its token. Deleting a changed
+-- booking must fail rather than take its changes along.
+CREATE TABLE IF NOT EXISTS booking_changes (
+    id SERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id) ON DELETE RESTRICT,
+    from_flight_id INT NOT NULL,
+    to_flight_id INT NOT NULL,
+    from_seat_number INT NOT NULL,
+    to_seat_number INT NOT NULL,
+    previous_fare_cents BIGINT NOT NULL,
+    new_fare_cents BIGINT NOT NULL,
+    fare_difference_cents BIGINT NOT NULL,
+    change_fee_cents BIGINT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+ALTER TABLE booking_changes DROP CONSTRAINT IF EXISTS booking_changes_booking_id_fkey;
+ALTER TABLE booking_changes ADD CONSTRAINT booking_changes_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_booking_changes_booking ON booking_changes (booking_id, created_at);
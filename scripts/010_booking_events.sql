@@ -0,0 +1,28 @@
+-- Bookings are kept in every status, so that a cancelled booking can still be
+-- refunded and its history, payments and promotion use stay attached to it.
+-- Only live bookings hold their seat number; cancelled, expired and refunded
+-- ones free it for the next booking.
+DROP INDEX IF EXISTS idx_bookings_flight_seat;
+DROP INDEX IF EXISTS idx_bookings_flight_seat_active;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookings_flight_seat_live ON bookings (flight_id, seat_number)
+    WHERE status NOT IN ('CANCELLED', 'EXPIRED', 'REFUNDED');
+
+-- status history of bookings, written in the transaction that changes the status.
+CREATE TABLE IF NOT EXISTS booking_events (
+    id BIGSERIAL PRIMARY KEY,
+    booking_id INT NOT NULL REFERENCES bookings(id) ON DELETE RESTRICT,
+    token TEXT NOT NULL,
+    from_status TEXT,
+    to_status TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+-- NOT VALID: history of bookings deleted before they were kept stays.
+ALTER TABLE booking_events DROP CONSTRAINT IF EXISTS booking_events_booking_id_fkey;
+ALTER TABLE booking_events ADD CONSTRAINT booking_events_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE RESTRICT NOT VALID;
+
+CREATE INDEX IF NOT EXISTS idx_booking_events_token ON booking_events (token, created_at);
@@ -0,0 +1,18 @@
+-- API keys of partners. Only the SHA-256 hash of a key is stored; the prefix
+-- identifies a key in listings. rate_limit_per_minute overrides the default
+-- limit of API keys when set.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    rate_limit_per_minute INT CHECK (rate_limit_per_minute > 0),
+    created_at TIMESTAMPTZ DEFAULT now(),
+    revoked_at TIMESTAMPTZ
+);
+
+-- The API key a booking was made with. Keys see and manage the bookings they
+-- made only.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS api_key_id BIGINT REFERENCES api_keys(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_bookings_api_key ON bookings (api_key_id) WHERE api_key_id IS NOT NULL;
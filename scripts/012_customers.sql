@@ -0,0 +1,31 @@
+-- Customer accounts. Emails are stored lower-cased; a customer can log in once
+-- email_verified_at is set.
+CREATE TABLE IF NOT EXISTS customers (
+    id BIGSERIAL PRIMARY KEY,
+    email TEXT NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL,
+    email_verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+-- One-time codes emailed to prove ownership of an address: VERIFY_EMAIL for
+-- the account email, CLAIM_BOOKINGS to link the guest bookings made with an
+-- email to the account. Only the SHA-256 hash of a code is stored.
+CREATE TABLE IF NOT EXISTS email_verifications (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    purpose TEXT NOT NULL CHECK (purpose IN ('VERIFY_EMAIL', 'CLAIM_BOOKINGS')),
+    token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+-- Bookings made by a logged-in customer, or claimed later. Guest bookings
+-- have no customer.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS customer_id BIGINT REFERENCES customers(id);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_customer ON bookings (customer_id, id DESC) WHERE customer_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_bookings_guest_email ON bookings (lower(email)) WHERE customer_id IS NULL;
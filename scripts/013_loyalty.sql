@@ -0,0 +1,55 @@
+-- Airport coordinates in degrees, for the great-circle distance miles are
+-- accrued on.
+ALTER TABLE airports ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE airports ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+UPDATE airports SET latitude = v.latitude, longitude = v.longitude
+FROM (VALUES
+    ('SVO', 55.9726, 37.4146),
+    ('DME', 55.4088, 37.9063),
+    ('VKO', 55.5915, 37.2615),
+    ('LED', 59.8003, 30.2625),
+    ('KZN', 55.6062, 49.2787),
+    ('AER', 43.4499, 39.9566),
+    ('SVX', 56.7431, 60.8027),
+    ('OVB', 55.0126, 82.6507)
+) AS v(code, latitude, longitude)
+WHERE airports.code = v.code AND airports.latitude IS NULL;
+
+-- Frequent-flyer accounts of customers. The tier is held until
+-- tier_expires_at unless requalified for.
+CREATE TABLE IF NOT EXISTS loyalty_members (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL UNIQUE REFERENCES customers(id),
+    tier TEXT NOT NULL DEFAULT '',
+    tier_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+-- Miles ledger: the balance of a member is the sum of its entries. Entries
+-- are never changed or deleted; corrections are new entries. A booking
+-- accrues once.
+CREATE TABLE IF NOT EXISTS loyalty_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    member_id BIGINT NOT NULL REFERENCES loyalty_members(id),
+    kind TEXT NOT NULL CHECK (kind IN ('ACCRUAL')),
+    miles BIGINT NOT NULL,
+    qualifying_miles BIGINT NOT NULL DEFAULT 0,
+    booking_id BIGINT,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_loyalty_ledger_member ON loyalty_ledger (member_id, id DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loyalty_ledger_accrual ON loyalty_ledger (booking_id) WHERE kind = 'ACCRUAL';
+
+CREATE OR REPLACE FUNCTION loyalty_ledger_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'loyalty_ledger entries are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS loyalty_ledger_immutable ON loyalty_ledger;
+CREATE TRIGGER loyalty_ledger_immutable BEFORE UPDATE OR DELETE ON loyalty_ledger
+    FOR EACH ROW EXECUTE FUNCTION loyalty_ledger_immutable();
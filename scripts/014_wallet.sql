@@ -0,0 +1,54 @@
+-- Miles can pay for bookings and are given back when they are cancelled.
+ALTER TABLE loyalty_ledger DROP CONSTRAINT IF EXISTS loyalty_ledger_kind_check;
+ALTER TABLE loyalty_ledger ADD CONSTRAINT loyalty_ledger_kind_check CHECK (kind IN ('ACCRUAL', 'REDEMPTION', 'REFUND'));
+
+-- Travel credit of customers: the balance is the sum of the entries, which,
+-- like the miles ledger, are never changed or deleted.
+CREATE TABLE IF NOT EXISTS wallet_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customers(id),
+    kind TEXT NOT NULL CHECK (kind IN ('CREDIT', 'REDEMPTION', 'REFUND')),
+    amount_cents BIGINT NOT NULL,
+    booking_id BIGINT,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_wallet_ledger_customer ON wallet_ledger (customer_id, id DESC);
+-- A cancelled booking earns credit once.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wallet_ledger_credit ON wallet_ledger (booking_id) WHERE kind = 'CREDIT';
+
+CREATE OR REPLACE FUNCTION wallet_ledger_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'wallet_ledger entries are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS wallet_ledger_immutable ON wallet_ledger;
+CREATE TRIGGER wallet_ledger_immutable BEFORE UPDATE OR DELETE ON wallet_ledger
+    FOR EACH ROW EXECUTE FUNCTION wallet_ledger_immutable();
+
+-- Miles and credit a booking is paid with. HELD reservations count against
+-- the balance only while their booking is PENDING; they are captured into
+-- the ledgers when it is confirmed.
+CREATE TABLE IF NOT EXISTS payment_reservations (
+    id BIGSERIAL PRIMARY KEY,
+    booking_id BIGINT NOT NULL REFERENCES bookings(id) ON DELETE RESTRICT,
+    customer_id BIGINT NOT NULL REFERENCES customers(id),
+    miles BIGINT NOT NULL DEFAULT 0 CHECK (miles >= 0),
+    miles_value_cents BIGINT NOT NULL DEFAULT 0 CHECK (miles_value_cents >= 0),
+    credit_cents BIGINT NOT NULL DEFAULT 0 CHECK (credit_cents >= 0),
+    price_cents BIGINT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('HELD', 'CAPTURED', 'RELEASED', 'REFUNDED')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- NOT VALID: reservations of bookings deleted before they were kept stay.
+ALTER TABLE payment_reservations DROP CONSTRAINT IF EXISTS payment_reservations_booking_id_fkey;
+ALTER TABLE payment_reservations ADD CONSTRAINT payment_reservations_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE RESTRICT NOT VALID;
+
+CREATE INDEX IF NOT EXISTS idx_payment_reservations_customer ON payment_reservations (customer_id) WHERE status = 'HELD';
+-- At most one reservation of a booking is held or has paid for it.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_reservations_booking ON payment_reservations (booking_id) WHERE status IN ('HELD', 'CAPTURED');
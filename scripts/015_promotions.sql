@@ -0,0 +1,47 @@
+-- Promo code discount campaigns. Empty airports and NULL times do not
+-- restrict a promotion; zero usage limits are unlimited.
+CREATE TABLE IF NOT EXISTS promotions (
+    id BIGSERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    discount_type TEXT NOT NULL CHECK (discount_type IN ('PERCENT', 'FIXED')),
+    percent_off BIGINT NOT NULL DEFAULT 0 CHECK (percent_off BETWEEN 0 AND 100),
+    amount_off_cents BIGINT NOT NULL DEFAULT 0 CHECK (amount_off_cents >= 0),
+    valid_from TIMESTAMPTZ,
+    valid_until TIMESTAMPTZ,
+    from_airport TEXT NOT NULL DEFAULT '',
+    to_airport TEXT NOT NULL DEFAULT '',
+    travel_from TIMESTAMPTZ,
+    travel_until TIMESTAMPTZ,
+    max_uses INT NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+    max_uses_per_customer INT NOT NULL DEFAULT 0 CHECK (max_uses_per_customer >= 0),
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The promo code a booking was made with and the discount taken off its
+-- price_cents, locked in when it is booked.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS promo_code TEXT NOT NULL DEFAULT '';
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS discount_cents BIGINT NOT NULL DEFAULT 0;
+
+-- Bookings made with a promotion. A booking holds a use of the code while
+-- it is not expired or cancelled.
+CREATE TABLE IF NOT EXISTS promotion_redemptions (
+    id BIGSERIAL PRIMARY KEY,
+    promotion_id BIGINT NOT NULL REFERENCES promotions(id),
+    booking_id BIGINT NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE RESTRICT,
+    email TEXT NOT NULL,
+    customer_id BIGINT,
+    discount_cents BIGINT NOT NULL CHECK (discount_cents >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- NOT VALID: redemptions of bookings deleted before they were kept stay.
+ALTER TABLE promotion_redemptions DROP CONSTRAINT IF EXISTS promotion_redemptions_booking_id_fkey;
+ALTER TABLE promotion_redemptions ADD CONSTRAINT promotion_redemptions_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE RESTRICT NOT VALID;
+
+DROP INDEX IF EXISTS idx_promotion_redemptions_email;
+CREATE INDEX IF NOT EXISTS idx_promotion_redemptions_email ON promotion_redemptions (promotion_id, lower(btrim(email)));
+CREATE INDEX IF NOT EXISTS idx_promotion_redemptions_customer ON promotion_redemptions (promotion_id, customer_id) WHERE customer_id IS NOT NULL;
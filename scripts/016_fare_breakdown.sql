@@ -0,0 +1,7 @@
+-- Taxes and fees a booking pays on top of its fare (price_cents), priced
+-- when it is booked. taxes holds the applied tax lines as
+-- [{"code", "airport", "amount_cents"}].
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS taxes JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS fuel_surcharge_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS booking_fee_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS seat_fee_cents BIGINT NOT NULL DEFAULT 0;
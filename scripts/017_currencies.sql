@@ -0,0 +1,24 @@
+-- Prices were implicitly in roubles: existing rows get RUB. All _cents
+-- amounts are in the minor units of their currency (ISO 4217), e.g. whole
+-- yen for JPY.
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+-- Fixed discounts apply to flights priced in the currency of the promotion;
+-- percentage discounts have none.
+ALTER TABLE promotions ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT '';
+UPDATE promotions SET currency = 'RUB' WHERE discount_type = 'FIXED' AND currency = '';
+
+-- Settlement currency of the amounts of a payment reservation.
+ALTER TABLE payment_reservations ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+-- One unit of base_currency costs rate units of quote_currency. Rates are
+-- used both ways; a pair and its inverse may both be set.
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    base_currency CHAR(3) NOT NULL,
+    quote_currency CHAR(3) NOT NULL,
+    rate NUMERIC(24, 12) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (base_currency, quote_currency),
+    CHECK (base_currency <> quote_currency)
+);
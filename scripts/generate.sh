@@ -12,6 +12,13 @@ docker run --rm -v "${ROOT_DIR}":/defs -w /defs \
   -f api/models/flight.proto \
   -f api/flights_api/flights.proto \
   -f api/bookings_api/bookings.proto \
+  -f api/admin_flights_api/admin_flights.proto \
+  -f api/ops_api/ops.proto \
+  -f api/customers_api/customers.proto \
+  -f api/loyalty_api/loyalty.proto \
+  -f api/wallet_api/wallet.proto \
+  -f api/admin_promotions_api/admin_promotions.proto \
+  -f api/admin_exchange_rates_api/admin_exchange_rates.proto \
   -i api \
   -o internal/pb \
   -l go \